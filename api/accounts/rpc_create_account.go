@@ -13,8 +13,11 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// maxAccountNumberAttempts is how many account numbers are generated before giving up on finding a free one.
+const maxAccountNumberAttempts = 5
+
 func (h *AccountHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.Account, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
@@ -24,18 +27,41 @@ func (h *AccountHandler) CreateAccount(ctx context.Context, req *pb.CreateAccoun
 		return nil, core.InvalidArgumentError(violations)
 	}
 
-	arg := db.CreateAccountParams{
-		Owner:    authPayload.Username,
-		Currency: req.GetCurrency().String(),
-		Balance:  0,
+	user, err := h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
 	}
 
-	account, err := h.Server.Store.CreateAccount(ctx, arg)
+	err = h.Server.CheckKycAccountLimit(ctx, user)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create account")
+		return nil, err
 	}
 
-	return account.ToResponse(), nil
+	// Account numbers are random, a number that is already taken is replaced by a new one
+	for attempt := 1; ; attempt++ {
+		number, err := util.GenerateAccountNumber(h.Server.Config.BankCountryCode, h.Server.Config.BankCode)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate account number: %s", err)
+		}
+
+		arg := db.CreateAccountParams{
+			Owner:    authPayload.Username,
+			Currency: req.GetCurrency().String(),
+			Balance:  0,
+			Number:   number,
+		}
+
+		account, err := h.Server.Store.CreateAccount(ctx, arg)
+		if err != nil {
+			if db.ErrorCode(err) == db.UniqueViolation && db.ConstraintName(err) == db.AccountNumberConstraint && attempt < maxAccountNumberAttempts {
+				continue
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to create account")
+		}
+
+		return account.ToResponse(), nil
+	}
 }
 
 func validateCreateAccountRequest(req *pb.CreateAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
@@ -2,18 +2,51 @@ package accounts
 
 import (
 	"context"
+	"fmt"
 	"simplebank/api/testutil"
 	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
 	"simplebank/token"
+	"simplebank/util"
+	"simplebank/val"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+type eqCreateAccountParamsMatcher struct {
+	arg db.CreateAccountParams
+}
+
+func (expected eqCreateAccountParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.CreateAccountParams)
+	if !ok {
+		return false
+	}
+
+	if err := val.ValidateAccountNumber(actualArg.Number); err != nil {
+		return false
+	}
+
+	expected.arg.Number = actualArg.Number
+
+	return expected.arg == actualArg
+}
+
+func (expected eqCreateAccountParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v with a valid account number", expected.arg)
+}
+
+func EqCreateAccountParams(arg db.CreateAccountParams) gomock.Matcher {
+	return eqCreateAccountParamsMatcher{arg}
+}
+
 func TestAccountHandler_CreateAccount(t *testing.T) {
 	user, _ := testutil.RandomUser(t)
 	expectedCurrency := pb.Currency_GBP
@@ -47,7 +80,17 @@ func TestAccountHandler_CreateAccount(t *testing.T) {
 				}
 
 				store.EXPECT().
-					CreateAccount(gomock.Any(), gomock.Eq(arg)).
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CountOpenAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(int64(1), nil)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), EqCreateAccountParams(arg)).
 					Times(1).
 					Return(newAccount, nil)
 			},
@@ -61,6 +104,170 @@ func TestAccountHandler_CreateAccount(t *testing.T) {
 				require.Equal(t, expectedCurrency, res.Currency)
 			},
 		},
+		{
+			"EmailNotVerified",
+			&pb.CreateAccountRequest{
+				Currency: expectedCurrency,
+			},
+			func(store *mockdb.MockStore) {
+				unverifiedUser := user
+				unverifiedUser.IsEmailVerified = false
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(unverifiedUser, nil)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"KycNotVerified",
+			&pb.CreateAccountRequest{
+				Currency: expectedCurrency,
+			},
+			func(store *mockdb.MockStore) {
+				unidentifiedUser := user
+				unidentifiedUser.KycTier = 0
+				unidentifiedUser.KycStatus = util.KycStatusPendingReview
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(unidentifiedUser, nil)
+
+				store.EXPECT().
+					CountOpenAccountsByOwner(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"KycAccountLimit",
+			&pb.CreateAccountRequest{
+				Currency: expectedCurrency,
+			},
+			func(store *mockdb.MockStore) {
+				basicUser := user
+				basicUser.KycTier = 1
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(basicUser, nil)
+
+				store.EXPECT().
+					CountOpenAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.GetKycTier(1).MaxAccounts, nil)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"AccountNumberTaken",
+			&pb.CreateAccountRequest{
+				Currency: expectedCurrency,
+			},
+			func(store *mockdb.MockStore) {
+				numberTaken := &pgconn.PgError{Code: db.UniqueViolation, ConstraintName: db.AccountNumberConstraint}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CountOpenAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(int64(1), nil)
+
+				gomock.InOrder(
+					store.EXPECT().
+						CreateAccount(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.Account{}, numberTaken),
+					store.EXPECT().
+						CreateAccount(gomock.Any(), gomock.Any()).
+						Times(1).
+						Return(db.Account{ID: 1, Owner: user.Username, Currency: currencyPtr.String()}, nil),
+				)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), res.GetId())
+			},
+		},
+		{
+			"AccountNumbersExhausted",
+			&pb.CreateAccountRequest{
+				Currency: expectedCurrency,
+			},
+			func(store *mockdb.MockStore) {
+				numberTaken := &pgconn.PgError{Code: db.UniqueViolation, ConstraintName: db.AccountNumberConstraint}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CountOpenAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(int64(1), nil)
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(maxAccountNumberAttempts).
+					Return(db.Account{}, numberTaken)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
 	}
 
 	for _, tc := range testCases {
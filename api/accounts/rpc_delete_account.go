@@ -8,18 +8,24 @@ import (
 	"simplebank/pb"
 	"simplebank/util"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (h *AccountHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*emptypb.Empty, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
 
-	account, err := h.Server.Store.GetAccount(ctx, req.GetAccountId())
+	violations := validateDeleteAccountRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	account, err := core.GetAccount(ctx, h.Server.Store, req.GetAccountId(), req.GetAccountNumber())
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "account not found")
@@ -29,11 +35,15 @@ func (h *AccountHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccoun
 	}
 
 	// Only bankers can delete accounts they don't own
-	if account.Owner != authPayload.Username && !util.IsBanker(authPayload.Role) {
+	if account.Owner != authPayload.Username && !core.HasPermission(ctx, util.AccountsDeleteAnyPermission) {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot delete other user's account")
 	}
 
-	if err = h.Server.Store.DeleteAccount(ctx, req.GetAccountId()); err != nil {
+	if !core.CanAccessAccount(ctx, account.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", account.ID)
+	}
+
+	if err = h.Server.Store.DeleteAccount(ctx, account.ID); err != nil {
 		errCode := db.ErrorCode(err)
 
 		if errCode == db.ForeignKeyViolation {
@@ -45,3 +55,11 @@ func (h *AccountHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccoun
 
 	return &emptypb.Empty{}, nil
 }
+
+func validateDeleteAccountRequest(req *pb.DeleteAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("account_id", req.GetAccountId(), "account_number", req.GetAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
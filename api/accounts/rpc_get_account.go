@@ -8,17 +8,23 @@ import (
 	"simplebank/pb"
 	"simplebank/util"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (h *AccountHandler) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
 
-	account, err := h.Server.Store.GetAccount(ctx, req.GetAccountId())
+	violations := validateGetAccountRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	account, err := core.GetAccount(ctx, h.Server.Store, req.GetAccountId(), req.GetAccountNumber())
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "account not found")
@@ -27,9 +33,21 @@ func (h *AccountHandler) GetAccount(ctx context.Context, req *pb.GetAccountReque
 		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
 	}
 
-	if account.Owner != authPayload.Username {
+	if account.Owner != authPayload.Username && !core.HasPermission(ctx, util.AccountsReadAnyPermission) {
 		return nil, status.Errorf(codes.PermissionDenied, "invalid account owner")
 	}
 
+	if !core.CanAccessAccount(ctx, account.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", account.ID)
+	}
+
 	return account.ToResponse(), nil
 }
+
+func validateGetAccountRequest(req *pb.GetAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("account_id", req.GetAccountId(), "account_number", req.GetAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
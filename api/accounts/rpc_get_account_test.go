@@ -2,11 +2,13 @@ package accounts
 
 import (
 	"context"
+	"simplebank/api/core"
 	"simplebank/api/testutil"
 	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
 	"simplebank/token"
+	"simplebank/util"
 	"testing"
 	"time"
 
@@ -48,6 +50,88 @@ func TestGetAccount(t *testing.T) {
 				require.Equal(t, account.Owner, res.Owner)
 			},
 		},
+		{
+			"AuditorReadsAnyAccount",
+			&pb.GetAccountRequest{
+				AccountId: account.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.AuditorRole, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.Id)
+			},
+		},
+		{
+			"ServiceClientNotAllowedAccount",
+			&pb.GetAccountRequest{
+				AccountId: account.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithServiceClient(ctx, &db.ServiceClient{Owner: user.Username, AccountIds: []int64{account.ID + 1}})
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"OKByNumber",
+			&pb.GetAccountRequest{
+				AccountNumber: account.Number,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.Number)).
+					Times(1).
+					Return(account, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, account.Number, res.Number)
+			},
+		},
+		{
+			"InvalidAccountNumber",
+			&pb.GetAccountRequest{
+				AccountNumber: "GB00SMPL00000000000000",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Account, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
 		{
 			"NotFound",
 			&pb.GetAccountRequest{
@@ -5,8 +5,8 @@ import (
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
-	"simplebank/util"
 	"simplebank/val"
+	"slices"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -14,7 +14,7 @@ import (
 )
 
 func (h *AccountHandler) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
@@ -24,11 +24,6 @@ func (h *AccountHandler) ListAccounts(ctx context.Context, req *pb.ListAccountsR
 		return nil, core.InvalidArgumentError(violations)
 	}
 
-	// Only bankers can list accounts of other users
-	if req.Username != nil && !util.IsBanker(authPayload.Role) {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot list accounts of other users")
-	}
-
 	username := authPayload.Username
 	if req.Username != nil {
 		username = req.GetUsername()
@@ -44,6 +39,10 @@ func (h *AccountHandler) ListAccounts(ctx context.Context, req *pb.ListAccountsR
 		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
 	}
 
+	accounts = slices.DeleteFunc(accounts, func(account db.Account) bool {
+		return !core.CanAccessAccount(ctx, account.ID)
+	})
+
 	response := &pb.ListAccountsResponse{
 		Pagination: &pb.Pagination{
 			// TODO cursor
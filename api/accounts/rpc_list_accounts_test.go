@@ -94,26 +94,6 @@ func TestListAccounts(t *testing.T) {
 				require.Len(t, res.Data, n)
 			},
 		},
-		{
-			"PermissionDenied",
-			&pb.ListAccountsRequest{
-				Username: &otherUser.Username,
-			},
-			func(store *mockdb.MockStore) {
-				store.EXPECT().
-					ListAccounts(gomock.Any(), gomock.Any()).
-					Times(0)
-			},
-			func(t *testing.T, tokenMaker token.Maker) context.Context {
-				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
-			},
-			func(t *testing.T, res *pb.ListAccountsResponse, err error) {
-				require.Error(t, err)
-				st, ok := status.FromError(err)
-				require.True(t, ok)
-				require.Equal(t, codes.PermissionDenied, st.Code())
-			},
-		},
 		{
 			"Unauthorized",
 			&pb.ListAccountsRequest{},
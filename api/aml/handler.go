@@ -0,0 +1,74 @@
+package aml
+
+import (
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// maxExportSize is the most reports exported at once, larger exports have to be split by time range
+	maxExportSize = 10000
+)
+
+// AmlHandler serves the anti-money-laundering reports to compliance officers.
+type AmlHandler struct {
+	Server *core.Server
+}
+
+func NewAmlHandler(server *core.Server) *AmlHandler {
+	return &AmlHandler{
+		Server: server,
+	}
+}
+
+// listAmlReportsParams returns the filters shared by listing and exporting reports.
+func listAmlReportsParams(kind *string, username *string, startTime *timestamppb.Timestamp, endTime *timestamppb.Timestamp, pageSize int64) db.ListAmlReportsParams {
+	arg := db.ListAmlReportsParams{
+		PageSize: int32(pageSize),
+	}
+
+	if kind != nil {
+		arg.Kind = pgtype.Text{String: *kind, Valid: true}
+	}
+
+	if username != nil {
+		arg.Username = pgtype.Text{String: *username, Valid: true}
+	}
+
+	if startTime != nil {
+		arg.StartTime = pgtype.Timestamptz{Time: startTime.AsTime(), Valid: true}
+	}
+
+	if endTime != nil {
+		arg.EndTime = pgtype.Timestamptz{Time: endTime.AsTime(), Valid: true}
+	}
+
+	return arg
+}
+
+func validateReportFilters(kind *string, username *string, startTime *timestamppb.Timestamp, endTime *timestamppb.Timestamp) (violations []*errdetails.BadRequest_FieldViolation) {
+	if kind != nil && *kind != util.AmlReportLargeTransaction && *kind != util.AmlReportStructuring {
+		violations = append(violations, core.FieldViolation("kind", fmt.Errorf("must be %s or %s", util.AmlReportLargeTransaction, util.AmlReportStructuring)))
+	}
+
+	if username != nil {
+		if err := val.ValidateUsername(*username); err != nil {
+			violations = append(violations, core.FieldViolation("username", err))
+		}
+	}
+
+	if startTime != nil && endTime != nil && !startTime.AsTime().Before(endTime.AsTime()) {
+		violations = append(violations, core.FieldViolation("end_time", fmt.Errorf("must be after start_time")))
+	}
+
+	return violations
+}
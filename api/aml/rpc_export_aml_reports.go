@@ -0,0 +1,91 @@
+package aml
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var exportHeader = []string{"id", "kind", "username", "currency", "total_amount", "transfer_ids", "window_start", "window_end", "created_at"}
+
+func (h *AmlHandler) ExportAmlReports(ctx context.Context, req *pb.ExportAmlReportsRequest) (*pb.ExportAmlReportsResponse, error) {
+	violations := validateExportAmlReportsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// One more than the limit is listed to tell a full export from a truncated one
+	arg := listAmlReportsParams(req.Kind, req.Username, req.StartTime, req.EndTime, maxExportSize+1)
+
+	reports, err := h.Server.Store.ListAmlReports(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list aml reports: %v", err)
+	}
+
+	if len(reports) > maxExportSize {
+		return nil, status.Errorf(codes.FailedPrecondition, "more than %d reports match, narrow the time range", maxExportSize)
+	}
+
+	content, err := reportsToCSV(reports)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export aml reports: %v", err)
+	}
+
+	response := &pb.ExportAmlReportsResponse{
+		Content: content,
+	}
+
+	return response, nil
+}
+
+func reportsToCSV(reports []db.AmlReport) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(exportHeader); err != nil {
+		return nil, err
+	}
+
+	for _, report := range reports {
+		transferIDs := make([]string, len(report.TransferIds))
+		for i, id := range report.TransferIds {
+			transferIDs[i] = strconv.FormatInt(id, 10)
+		}
+
+		err := writer.Write([]string{
+			strconv.FormatInt(report.ID, 10),
+			report.Kind,
+			report.Username,
+			report.Currency,
+			strconv.FormatInt(report.TotalAmount, 10),
+			strings.Join(transferIDs, " "),
+			report.WindowStart.UTC().Format(time.RFC3339),
+			report.WindowEnd.UTC().Format(time.RFC3339),
+			report.CreatedAt.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func validateExportAmlReportsRequest(req *pb.ExportAmlReportsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateReportFilters(req.Kind, req.Username, req.StartTime, req.EndTime)
+}
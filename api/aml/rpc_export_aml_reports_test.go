@@ -0,0 +1,205 @@
+package aml
+
+import (
+	"bytes"
+	"encoding/csv"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExportAmlReports(t *testing.T) {
+	officer, _ := testutil.RandomUser(t)
+	officer.Role = util.ComplianceRole
+
+	customer, _ := testutil.RandomUser(t)
+
+	windowEnd := time.Now().Truncate(time.Second)
+	reports := []db.AmlReport{
+		{
+			ID:          2,
+			Kind:        util.AmlReportStructuring,
+			Username:    customer.Username,
+			Currency:    util.USD,
+			TotalAmount: 2700000,
+			TransferIds: []int64{11, 12, 13},
+			WindowStart: windowEnd.Add(-48 * time.Hour),
+			WindowEnd:   windowEnd,
+			CreatedAt:   windowEnd.Add(time.Hour),
+		},
+		{
+			ID:          1,
+			Kind:        util.AmlReportLargeTransaction,
+			Username:    customer.Username,
+			Currency:    util.USD,
+			TotalAmount: 1500000,
+			TransferIds: []int64{10},
+			WindowStart: windowEnd.Add(-72 * time.Hour),
+			WindowEnd:   windowEnd.Add(-72 * time.Hour),
+			CreatedAt:   windowEnd.Add(-71 * time.Hour),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ExportAmlReportsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ExportAmlReportsResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ExportAmlReportsRequest{},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Eq(db.ListAmlReportsParams{PageSize: maxExportSize + 1})).
+					Times(1).
+					Return(reports, nil)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.NoError(t, err)
+
+				records, err := csv.NewReader(bytes.NewReader(res.GetContent())).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(reports)+1)
+				require.Equal(t, exportHeader, records[0])
+
+				require.Equal(t, strconv.FormatInt(reports[0].ID, 10), records[1][0])
+				require.Equal(t, util.AmlReportStructuring, records[1][1])
+				require.Equal(t, customer.Username, records[1][2])
+				require.Equal(t, "2700000", records[1][4])
+				require.Equal(t, "11 12 13", records[1][5])
+				require.Equal(t, reports[0].WindowEnd.UTC().Format(time.RFC3339), records[1][7])
+
+				require.Equal(t, "10", records[2][5])
+			},
+		},
+		{
+			"Filters",
+			&pb.ExportAmlReportsRequest{
+				Kind:      proto.String(util.AmlReportStructuring),
+				Username:  proto.String(customer.Username),
+				StartTime: timestamppb.New(windowEnd.Add(-24 * time.Hour)),
+				EndTime:   timestamppb.New(windowEnd),
+			},
+			func(store *mockdb.MockStore) {
+				arg := db.ListAmlReportsParams{
+					Kind:      pgtype.Text{String: util.AmlReportStructuring, Valid: true},
+					Username:  pgtype.Text{String: customer.Username, Valid: true},
+					StartTime: pgtype.Timestamptz{Time: windowEnd.Add(-24 * time.Hour).UTC(), Valid: true},
+					EndTime:   pgtype.Timestamptz{Time: windowEnd.UTC(), Valid: true},
+					PageSize:  maxExportSize + 1,
+				}
+
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(reports[:1], nil)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.NoError(t, err)
+
+				records, err := csv.NewReader(bytes.NewReader(res.GetContent())).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+			},
+		},
+		{
+			"NoReports",
+			&pb.ExportAmlReportsRequest{},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.AmlReport{}, nil)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.NoError(t, err)
+
+				records, err := csv.NewReader(bytes.NewReader(res.GetContent())).ReadAll()
+				require.NoError(t, err)
+				require.Equal(t, [][]string{exportHeader}, records)
+			},
+		},
+		{
+			"TooManyReports",
+			&pb.ExportAmlReportsRequest{},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(make([]db.AmlReport, maxExportSize+1), nil)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"InvalidKind",
+			&pb.ExportAmlReportsRequest{
+				Kind: proto.String("cash"),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"InvalidTimeRange",
+			&pb.ExportAmlReportsRequest{
+				StartTime: timestamppb.New(windowEnd),
+				EndTime:   timestamppb.New(windowEnd.Add(-time.Hour)),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAmlReports(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ExportAmlReportsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewAmlHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, officer.Username, officer.Role, time.Minute)
+			res, err := handler.ExportAmlReports(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
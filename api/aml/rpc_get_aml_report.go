@@ -0,0 +1,54 @@
+package aml
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *AmlHandler) GetAmlReport(ctx context.Context, req *pb.GetAmlReportRequest) (*pb.GetAmlReportResponse, error) {
+	violations := validateGetAmlReportRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	report, err := h.Server.Store.GetAmlReport(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "report not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get report: %v", err)
+	}
+
+	transfers, err := h.Server.Store.ListTransfersByIDs(ctx, report.TransferIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %v", err)
+	}
+
+	response := &pb.GetAmlReportResponse{
+		Report:    report.ToResponse(),
+		Transfers: make([]*pb.AmlTransfer, len(transfers)),
+	}
+
+	for i, transfer := range transfers {
+		response.Transfers[i] = transfer.ToAmlResponse()
+	}
+
+	return response, nil
+}
+
+func validateGetAmlReportRequest(req *pb.GetAmlReportRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
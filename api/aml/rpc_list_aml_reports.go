@@ -0,0 +1,73 @@
+package aml
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	"simplebank/pb"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *AmlHandler) ListAmlReports(ctx context.Context, req *pb.ListAmlReportsRequest) (*pb.ListAmlReportsResponse, error) {
+	violations := validateListAmlReportsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := listAmlReportsParams(req.Kind, req.Username, req.StartTime, req.EndTime, pageSize)
+
+	// The cursor is the id of the last report of the previous page, reports are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	reports, err := h.Server.Store.ListAmlReports(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list aml reports: %v", err)
+	}
+
+	response := &pb.ListAmlReportsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(reports)),
+		},
+		Data: make([]*pb.AmlReport, len(reports)),
+	}
+
+	for i, report := range reports {
+		response.Data[i] = report.ToResponse()
+	}
+
+	if int64(len(reports)) == pageSize {
+		next := strconv.FormatInt(reports[len(reports)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListAmlReportsRequest(req *pb.ListAmlReportsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateReportFilters(req.Kind, req.Username, req.StartTime, req.EndTime)
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
@@ -0,0 +1,18 @@
+package audit
+
+import "simplebank/api/core"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type AuditHandler struct {
+	Server *core.Server
+}
+
+func NewAuditHandler(server *core.Server) *AuditHandler {
+	return &AuditHandler{
+		Server: server,
+	}
+}
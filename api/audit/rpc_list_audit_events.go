@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	violations := validateListAuditEventsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListAuditEventsParams{
+		PageSize: int32(pageSize),
+	}
+
+	if req.Actor != nil {
+		arg.Actor = pgtype.Text{String: req.GetActor(), Valid: true}
+	}
+
+	if req.Resource != nil {
+		arg.Resource = pgtype.Text{String: req.GetResource(), Valid: true}
+	}
+
+	if req.StartTime != nil {
+		arg.StartTime = pgtype.Timestamptz{Time: req.GetStartTime().AsTime(), Valid: true}
+	}
+
+	if req.EndTime != nil {
+		arg.EndTime = pgtype.Timestamptz{Time: req.GetEndTime().AsTime(), Valid: true}
+	}
+
+	// The cursor is the id of the last event of the previous page, events are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	events, err := h.Server.Store.ListAuditEvents(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %s", err)
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(events)),
+		},
+		Data: make([]*pb.AuditEvent, len(events)),
+	}
+
+	for i, event := range events {
+		response.Data[i] = event.ToResponse()
+	}
+
+	if int64(len(events)) == pageSize {
+		next := strconv.FormatInt(events[len(events)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListAuditEventsRequest(req *pb.ListAuditEventsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Actor != nil {
+		if err := val.ValidateUsername(req.GetActor()); err != nil {
+			violations = append(violations, core.FieldViolation("actor", err))
+		}
+	}
+
+	if req.Resource != nil {
+		if resourceType, id, ok := strings.Cut(req.GetResource(), "/"); !ok || resourceType == "" || id == "" {
+			violations = append(violations, core.FieldViolation("resource", fmt.Errorf("must be in the format type/id, e.g. accounts/42")))
+		}
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()) {
+		violations = append(violations, core.FieldViolation("end_time", fmt.Errorf("must be after start_time")))
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
@@ -0,0 +1,153 @@
+package audit
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func randomAuditEvent(id int64, username string) db.AuditEvent {
+	return db.AuditEvent{
+		ID:         id,
+		Username:   pgtype.Text{String: username, Valid: true},
+		Role:       util.DepositorRole,
+		Method:     "/pb.Simplebank/CreateTransfer",
+		Resources:  []string{"accounts/1", "transfers/2"},
+		Request:    []byte(`{"amount":"10","mfa_code":"[REDACTED]"}`),
+		StatusCode: "OK",
+		CreatedAt:  time.Now(),
+	}
+}
+
+func TestListAuditEventsAPI(t *testing.T) {
+	username := util.RandomOwner()
+	startTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAuditEventsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ListAuditEventsResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ListAuditEventsRequest{
+				Actor:     proto.String(username),
+				Resource:  proto.String("accounts/1"),
+				StartTime: timestamppb.New(startTime),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(db.ListAuditEventsParams{
+						Actor:     pgtype.Text{String: username, Valid: true},
+						Resource:  pgtype.Text{String: "accounts/1", Valid: true},
+						StartTime: pgtype.Timestamptz{Time: startTime.UTC(), Valid: true},
+						PageSize:  defaultPageSize,
+					})).
+					Times(1).
+					Return([]db.AuditEvent{randomAuditEvent(2, username), randomAuditEvent(1, username)}, nil)
+			},
+			func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetData(), 2)
+				require.Nil(t, res.GetPagination().Next)
+
+				event := res.GetData()[0]
+				require.Equal(t, username, event.GetUsername())
+				require.Equal(t, []string{"accounts/1", "transfers/2"}, event.GetResources())
+				require.Equal(t, "[REDACTED]", event.GetRequest().GetFields()["mfa_code"].GetStringValue())
+			},
+		},
+		{
+			"NextPage",
+			&pb.ListAuditEventsRequest{
+				Cursor: "10",
+				Limit:  2,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(db.ListAuditEventsParams{
+						BeforeID: pgtype.Int8{Int64: 10, Valid: true},
+						PageSize: 2,
+					})).
+					Times(1).
+					Return([]db.AuditEvent{randomAuditEvent(9, username), randomAuditEvent(8, username)}, nil)
+			},
+			func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "8", res.GetPagination().GetNext())
+			},
+		},
+		{
+			"InvalidResource",
+			&pb.ListAuditEventsRequest{
+				Resource: proto.String("accounts"),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"InvalidCursor",
+			&pb.ListAuditEventsRequest{
+				Cursor: "abc",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"EndBeforeStart",
+			&pb.ListAuditEventsRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(startTime.Add(-time.Minute)),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ListAuditEventsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := testutil.NewTestServer(t, store, nil)
+			handler := NewAuditHandler(server)
+
+			ctx := testutil.NewContextWithBearerToken(t, server.TokenMaker, util.RandomOwner(), util.AuditorRole, time.Minute)
+			res, err := handler.ListAuditEvents(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// clientCredentialsGrant is the only OAuth 2.0 grant type service clients can use
+	clientCredentialsGrant = "client_credentials"
+	maxClientAccounts      = 20
+)
+
+type ServiceClientHandler struct {
+	Server *core.Server
+}
+
+func NewServiceClientHandler(server *core.Server) *ServiceClientHandler {
+	return &ServiceClientHandler{
+		Server: server,
+	}
+}
+
+// getOwnServiceClient returns the service client if it belongs to the owner, clients of other users are reported as not found.
+func (h *ServiceClientHandler) getOwnServiceClient(ctx context.Context, clientID string, owner string) (db.ServiceClient, error) {
+	client, err := h.Server.Store.GetServiceClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return client, status.Errorf(codes.NotFound, "service client not found")
+		}
+
+		return client, status.Errorf(codes.Internal, "failed to get service client: %s", err)
+	}
+
+	if client.Owner != owner {
+		return client, status.Errorf(codes.NotFound, "service client not found")
+	}
+
+	return client, nil
+}
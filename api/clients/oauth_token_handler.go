@@ -0,0 +1,99 @@
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OAuthTokenPath is the token endpoint for OAuth 2.0 client libraries.
+const OAuthTokenPath = "/oauth/token"
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+type oauthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// OAuthTokenHandler serves IssueClientToken the way OAuth 2.0 client libraries expect: form encoded requests with the
+// credentials in the body or as basic authorization, and OAuth error responses. The JSON endpoint of the gateway does neither.
+func OAuthTokenHandler(client pb.SimplebankClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeOAuthError(w, http.StatusMethodNotAllowed, "invalid_request", "only POST is supported")
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			writeOAuthError(w, http.StatusBadRequest, "invalid_request", "body must be form encoded")
+			return
+		}
+
+		req := &pb.IssueClientTokenRequest{
+			GrantType:    r.PostForm.Get("grant_type"),
+			ClientId:     r.PostForm.Get("client_id"),
+			ClientSecret: r.PostForm.Get("client_secret"),
+			Scope:        r.PostForm.Get("scope"),
+		}
+
+		// Credentials in basic authorization are form encoded first, see RFC 6749 section 2.3.1
+		if clientID, clientSecret, ok := r.BasicAuth(); ok {
+			req.ClientId, _ = url.QueryUnescape(clientID)
+			req.ClientSecret, _ = url.QueryUnescape(clientSecret)
+		}
+
+		if req.GetGrantType() != clientCredentialsGrant {
+			writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+			return
+		}
+
+		res, err := client.IssueClientToken(r.Context(), req)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				writeOAuthError(w, http.StatusBadRequest, "invalid_request", status.Convert(err).Message())
+			case codes.Unauthenticated:
+				w.Header().Set("WWW-Authenticate", `Basic realm="simplebank"`)
+				writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "")
+			case codes.PermissionDenied:
+				writeOAuthError(w, http.StatusBadRequest, "invalid_scope", status.Convert(err).Message())
+			default:
+				writeOAuthError(w, http.StatusInternalServerError, "server_error", "")
+			}
+
+			return
+		}
+
+		writeOAuthResponse(w, http.StatusOK, oauthTokenResponse{
+			AccessToken: res.GetAccessToken(),
+			TokenType:   res.GetTokenType(),
+			ExpiresIn:   res.GetExpiresIn(),
+			Scope:       res.GetScope(),
+		})
+	})
+}
+
+func writeOAuthError(w http.ResponseWriter, statusCode int, code string, description string) {
+	writeOAuthResponse(w, statusCode, oauthErrorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
+
+func writeOAuthResponse(w http.ResponseWriter, statusCode int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
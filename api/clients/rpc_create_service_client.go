@@ -0,0 +1,103 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *ServiceClientHandler) CreateServiceClient(ctx context.Context, req *pb.CreateServiceClientRequest) (*pb.CreateServiceClientResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateCreateServiceClientRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Clients act for the caller, so they cannot be given anything the caller cannot do themselves
+	for _, scope := range req.GetScopes() {
+		if scope == util.ServiceClientsManagePermission || !core.HasPermission(ctx, scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "scope %s cannot be granted", scope)
+		}
+	}
+
+	for _, accountID := range req.GetAccountIds() {
+		account, err := h.Server.Store.GetAccount(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "account %d not found", accountID)
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+		}
+
+		if account.Owner != authPayload.Username {
+			return nil, status.Errorf(codes.PermissionDenied, "account %d does not belong to the authenticated user", accountID)
+		}
+	}
+
+	secret, err := util.GenerateSecureToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate client secret: %s", err)
+	}
+
+	accountIDs := req.GetAccountIds()
+	if accountIDs == nil {
+		accountIDs = []int64{}
+	}
+
+	txResult, err := h.Server.Store.CreateServiceClientTx(ctx, db.CreateServiceClientTxParams{
+		CreateServiceClientParams: db.CreateServiceClientParams{
+			ID:         uuid.NewString(),
+			Owner:      authPayload.Username,
+			Name:       req.GetName(),
+			Scopes:     req.GetScopes(),
+			AccountIds: accountIDs,
+		},
+		HashedSecret: util.HashToken(secret),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create service client: %s", err)
+	}
+
+	return &pb.CreateServiceClientResponse{
+		Client:       txResult.ServiceClient.ToResponse([]db.ServiceClientKey{txResult.ServiceClientKey}),
+		ClientSecret: secret,
+	}, nil
+}
+
+func validateCreateServiceClientRequest(req *pb.CreateServiceClientRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetName(), 1, 100); err != nil {
+		violations = append(violations, core.FieldViolation("name", err))
+	}
+
+	if len(req.GetScopes()) == 0 {
+		violations = append(violations, core.FieldViolation("scopes", fmt.Errorf("at least one scope is required")))
+	}
+
+	if len(req.GetAccountIds()) > maxClientAccounts {
+		violations = append(violations, core.FieldViolation("account_ids", fmt.Errorf("at most %d accounts are allowed", maxClientAccounts)))
+	}
+
+	for _, accountID := range req.GetAccountIds() {
+		if err := val.ValidateAccountId(accountID); err != nil {
+			violations = append(violations, core.FieldViolation("account_ids", err))
+			break
+		}
+	}
+
+	return violations
+}
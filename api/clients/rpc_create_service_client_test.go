@@ -0,0 +1,202 @@
+package clients
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateServiceClientAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	account := testutil.RandomAccount(user.Username)
+	otherAccount := testutil.RandomAccount(util.RandomOwner())
+	permissions := []string{"accounts:read", "transfers:create", util.ServiceClientsManagePermission}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateServiceClientRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateServiceClientResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.CreateServiceClientRequest{
+				Name:       "bookkeeping",
+				Scopes:     []string{"accounts:read"},
+				AccountIds: []int64{account.ID},
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateServiceClientTxParams) (db.CreateServiceClientTxResult, error) {
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, []string{"accounts:read"}, arg.Scopes)
+						require.Equal(t, []int64{account.ID}, arg.AccountIds)
+						require.NotEmpty(t, arg.HashedSecret)
+
+						return db.CreateServiceClientTxResult{
+							ServiceClient: db.ServiceClient{
+								ID:         arg.ID,
+								Owner:      arg.Owner,
+								Name:       arg.Name,
+								Scopes:     arg.Scopes,
+								AccountIds: arg.AccountIds,
+								CreatedAt:  time.Now(),
+							},
+							ServiceClientKey: db.ServiceClientKey{
+								ID:           1,
+								ClientID:     arg.ID,
+								HashedSecret: arg.HashedSecret,
+								CreatedAt:    time.Now(),
+							},
+						}, nil
+					})
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithPermissions(ctx, permissions)
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetClientSecret())
+				require.NotEmpty(t, res.GetClient().GetId())
+				require.Equal(t, []int64{account.ID}, res.GetClient().GetAccountIds())
+				require.Len(t, res.GetClient().GetKeys(), 1)
+			},
+		},
+		{
+			"ScopeNotHeld",
+			&pb.CreateServiceClientRequest{
+				Name:   "bookkeeping",
+				Scopes: []string{util.AccountsReadAnyPermission},
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithPermissions(ctx, permissions)
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"ManageScope",
+			&pb.CreateServiceClientRequest{
+				Name:   "bookkeeping",
+				Scopes: []string{util.ServiceClientsManagePermission},
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithPermissions(ctx, permissions)
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"AccountOfOtherUser",
+			&pb.CreateServiceClientRequest{
+				Name:       "bookkeeping",
+				Scopes:     []string{"accounts:read"},
+				AccountIds: []int64{otherAccount.ID},
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).
+					Times(1).
+					Return(otherAccount, nil)
+
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithPermissions(ctx, permissions)
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"MissingScopes",
+			&pb.CreateServiceClientRequest{
+				Name: "bookkeeping",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithPermissions(ctx, permissions)
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"NoAuthorization",
+			&pb.CreateServiceClientRequest{
+				Name:   "bookkeeping",
+				Scopes: []string{"accounts:read"},
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateServiceClientTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			func(t *testing.T, res *pb.CreateServiceClientResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := testutil.NewTestServer(t, store, nil)
+			handler := NewServiceClientHandler(server)
+
+			ctx := tc.buildContext(t, server.TokenMaker)
+			res, err := handler.CreateServiceClient(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *ServiceClientHandler) DisableServiceClient(ctx context.Context, req *pb.DisableServiceClientRequest) (*pb.ServiceClient, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateDisableServiceClientRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Tokens are checked against their client on every request, so they stop working right away
+	client, err := h.Server.Store.DisableServiceClient(ctx, db.DisableServiceClientParams{
+		ID:    req.GetClientId(),
+		Owner: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service client not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to disable service client: %s", err)
+	}
+
+	return client.ToResponse(nil), nil
+}
+
+func validateDisableServiceClientRequest(req *pb.DisableServiceClientRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetClientId(), 1, 100); err != nil {
+		violations = append(violations, core.FieldViolation("client_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,107 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/val"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errInvalidClient does not tell whether the client or the secret was wrong
+var errInvalidClient = status.Errorf(codes.Unauthenticated, "invalid client credentials")
+
+func (h *ServiceClientHandler) IssueClientToken(ctx context.Context, req *pb.IssueClientTokenRequest) (*pb.IssueClientTokenResponse, error) {
+	violations := validateIssueClientTokenRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	client, err := h.Server.Store.GetServiceClient(ctx, req.GetClientId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, errInvalidClient
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get service client: %s", err)
+	}
+
+	key, err := h.Server.Store.GetServiceClientKey(ctx, db.GetServiceClientKeyParams{
+		ClientID:     client.ID,
+		HashedSecret: util.HashToken(req.GetClientSecret()),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, errInvalidClient
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get service client key: %s", err)
+	}
+
+	if client.DisabledAt.Valid {
+		return nil, errInvalidClient
+	}
+
+	scopes := client.Scopes
+	if req.GetScope() != "" {
+		scopes = strings.Fields(req.GetScope())
+		for _, scope := range scopes {
+			if !slices.Contains(client.Scopes, scope) {
+				return nil, status.Errorf(codes.PermissionDenied, "scope %s was not granted to the client", scope)
+			}
+		}
+	}
+
+	owner, err := h.Server.Store.GetUser(ctx, client.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get service client owner: %s", err)
+	}
+
+	err = h.Server.Store.TouchServiceClientKey(ctx, key.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record key usage: %s", err)
+	}
+
+	payload, err := token.NewClientPayload(client.ID, owner.Username, owner.Role, scopes, h.Server.Config.ClientTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create token payload: %s", err)
+	}
+
+	accessToken, err := h.Server.TokenMaker.IssueToken(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue access token: %s", err)
+	}
+
+	return &pb.IssueClientTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(h.Server.Config.ClientTokenDuration.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+func validateIssueClientTokenRequest(req *pb.IssueClientTokenRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetGrantType() != clientCredentialsGrant {
+		violations = append(violations, core.FieldViolation("grant_type", fmt.Errorf("must be %s", clientCredentialsGrant)))
+	}
+
+	if err := val.ValidateString(req.GetClientId(), 1, 100); err != nil {
+		violations = append(violations, core.FieldViolation("client_id", err))
+	}
+
+	if err := val.ValidateString(req.GetClientSecret(), 1, 100); err != nil {
+		violations = append(violations, core.FieldViolation("client_secret", err))
+	}
+
+	return violations
+}
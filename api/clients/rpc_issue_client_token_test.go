@@ -0,0 +1,224 @@
+package clients
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomServiceClient(owner string, scopes ...string) db.ServiceClient {
+	return db.ServiceClient{
+		ID:         uuid.NewString(),
+		Owner:      owner,
+		Name:       util.RandomString(8),
+		Scopes:     scopes,
+		AccountIds: []int64{},
+		CreatedAt:  time.Now(),
+	}
+}
+
+func TestIssueClientTokenAPI(t *testing.T) {
+	owner, _ := testutil.RandomUser(t)
+	client := randomServiceClient(owner.Username, "accounts:read", "transfers:create")
+	secret := util.RandomString(32)
+	key := db.ServiceClientKey{ID: util.RandomInt(1, 1000), ClientID: client.ID, HashedSecret: util.HashToken(secret)}
+
+	disabledClient := client
+	disabledClient.DisabledAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	validRequest := func() *pb.IssueClientTokenRequest {
+		return &pb.IssueClientTokenRequest{
+			GrantType:    "client_credentials",
+			ClientId:     client.ID,
+			ClientSecret: secret,
+		}
+	}
+
+	expectCredentials := func(store *mockdb.MockStore, client db.ServiceClient) {
+		store.EXPECT().
+			GetServiceClient(gomock.Any(), gomock.Eq(client.ID)).
+			Times(1).
+			Return(client, nil)
+
+		store.EXPECT().
+			GetServiceClientKey(gomock.Any(), gomock.Eq(db.GetServiceClientKeyParams{
+				ClientID:     client.ID,
+				HashedSecret: util.HashToken(secret),
+			})).
+			Times(1).
+			Return(key, nil)
+	}
+
+	expectIssued := func(store *mockdb.MockStore) {
+		store.EXPECT().
+			GetUser(gomock.Any(), gomock.Eq(owner.Username)).
+			Times(1).
+			Return(owner, nil)
+
+		store.EXPECT().
+			TouchServiceClientKey(gomock.Any(), gomock.Eq(key.ID)).
+			Times(1).
+			Return(nil)
+	}
+
+	testCases := []struct {
+		name          string
+		buildRequest  func() *pb.IssueClientTokenRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error)
+	}{
+		{
+			"OK",
+			validRequest,
+			func(store *mockdb.MockStore) {
+				expectCredentials(store, client)
+				expectIssued(store)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Bearer", res.GetTokenType())
+				require.Equal(t, "accounts:read transfers:create", res.GetScope())
+				require.Equal(t, int64(time.Minute.Seconds()), res.GetExpiresIn())
+
+				payload, err := server.TokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.Equal(t, client.ID, payload.ClientID)
+				require.Equal(t, owner.Username, payload.Username)
+				require.Equal(t, owner.Role, payload.Role)
+				require.Equal(t, client.Scopes, payload.Scopes)
+				require.Equal(t, uuid.Nil, payload.SessionID)
+			},
+		},
+		{
+			"NarrowedScope",
+			func() *pb.IssueClientTokenRequest {
+				req := validRequest()
+				req.Scope = "accounts:read"
+				return req
+			},
+			func(store *mockdb.MockStore) {
+				expectCredentials(store, client)
+				expectIssued(store)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "accounts:read", res.GetScope())
+
+				payload, err := server.TokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.Equal(t, []string{"accounts:read"}, payload.Scopes)
+			},
+		},
+		{
+			"ScopeNotGranted",
+			func() *pb.IssueClientTokenRequest {
+				req := validRequest()
+				req.Scope = "accounts:read accounts:delete"
+				return req
+			},
+			func(store *mockdb.MockStore) {
+				expectCredentials(store, client)
+
+				store.EXPECT().
+					TouchServiceClientKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"WrongSecret",
+			validRequest,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Eq(client.ID)).
+					Times(1).
+					Return(client, nil)
+
+				store.EXPECT().
+					GetServiceClientKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ServiceClientKey{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			"UnknownClient",
+			validRequest,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Eq(client.ID)).
+					Times(1).
+					Return(db.ServiceClient{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					GetServiceClientKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			"DisabledClient",
+			validRequest,
+			func(store *mockdb.MockStore) {
+				expectCredentials(store, disabledClient)
+
+				store.EXPECT().
+					TouchServiceClientKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			"UnsupportedGrantType",
+			func() *pb.IssueClientTokenRequest {
+				req := validRequest()
+				req.GrantType = "password"
+				return req
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, server *core.Server, res *pb.IssueClientTokenResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := testutil.NewTestServer(t, store, nil)
+			handler := NewServiceClientHandler(server)
+
+			res, err := handler.IssueClientToken(context.Background(), tc.buildRequest())
+			tc.checkResponse(t, server, res, err)
+		})
+	}
+}
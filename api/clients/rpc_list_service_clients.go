@@ -0,0 +1,36 @@
+package clients
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *ServiceClientHandler) ListServiceClients(ctx context.Context, req *pb.ListServiceClientsRequest) (*pb.ListServiceClientsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	clients, err := h.Server.Store.ListServiceClients(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list service clients: %s", err)
+	}
+
+	data := make([]*pb.ServiceClient, len(clients))
+	for i, client := range clients {
+		keys, err := h.Server.Store.ListServiceClientKeys(ctx, client.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list service client keys: %s", err)
+		}
+
+		data[i] = client.ToResponse(keys)
+	}
+
+	return &pb.ListServiceClientsResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package clients
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *ServiceClientHandler) RotateServiceClientKey(ctx context.Context, req *pb.RotateServiceClientKeyRequest) (*pb.RotateServiceClientKeyResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateRotateServiceClientKeyRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	client, err := h.getOwnServiceClient(ctx, req.GetClientId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.DisabledAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "service client is disabled")
+	}
+
+	secret, err := util.GenerateSecureToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate client secret: %s", err)
+	}
+
+	txResult, err := h.Server.Store.RotateServiceClientKeyTx(ctx, db.RotateServiceClientKeyTxParams{
+		ClientID:             client.ID,
+		HashedSecret:         util.HashToken(secret),
+		PreviousKeysExpireAt: time.Now().Add(h.Server.Config.ClientKeyRotationOverlap),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate service client key: %s", err)
+	}
+
+	return &pb.RotateServiceClientKeyResponse{
+		Key:          txResult.ServiceClientKey.ToResponse(),
+		ClientSecret: secret,
+	}, nil
+}
+
+func validateRotateServiceClientKeyRequest(req *pb.RotateServiceClientKeyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetClientId(), 1, 100); err != nil {
+		violations = append(violations, core.FieldViolation("client_id", err))
+	}
+
+	return violations
+}
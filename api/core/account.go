@@ -0,0 +1,35 @@
+package core
+
+import (
+	"context"
+	db "simplebank/db/sqlc"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// GetAccount fetches an account by its public number when one is provided, falling back to the internal id otherwise.
+func GetAccount(ctx context.Context, store db.Store, accountId int64, accountNumber string) (db.Account, error) {
+	if accountNumber != "" {
+		return store.GetAccountByNumber(ctx, accountNumber)
+	}
+
+	return store.GetAccount(ctx, accountId)
+}
+
+// ValidateAccountReference validates whichever of the account number or id is going to be used to look up an account.
+func ValidateAccountReference(idField string, accountId int64, numberField string, accountNumber string) *errdetails.BadRequest_FieldViolation {
+	if accountNumber != "" {
+		if err := val.ValidateAccountNumber(accountNumber); err != nil {
+			return FieldViolation(numberField, err)
+		}
+
+		return nil
+	}
+
+	if err := val.ValidateAccountId(accountId); err != nil {
+		return FieldViolation(idField, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "[REDACTED]"
+
+// audit records the calls that can change something in the append-only audit log, calls made under
+// impersonation are recorded even if they only read. Calls rejected for lacking an access token are not,
+// they do not tell who made them.
+func (interceptor *AuthInterceptor) audit(ctx context.Context, fullMethod string, req interface{}, res interface{}, err error) {
+	policy, ok := interceptor.policies[fullMethod]
+	if !ok {
+		return
+	}
+
+	payload, authErr := AuthPayloadFromContext(ctx)
+	if authErr != nil {
+		payload = nil
+	}
+
+	impersonated := payload != nil && payload.Impersonator != ""
+	if (policy.GetReadOnly() && !impersonated) || (payload == nil && !policy.GetPublic()) {
+		return
+	}
+
+	mtdt := ExtractMetadata(ctx)
+	arg := db.CreateAuditEventParams{
+		Method:     fullMethod,
+		Resources:  auditResources(req, res),
+		Request:    auditRequest(req),
+		StatusCode: status.Code(err).String(),
+		ClientIp:   mtdt.ClientIP,
+		UserAgent:  mtdt.UserAgent,
+	}
+
+	if payload != nil {
+		arg.Username = pgtype.Text{String: payload.Username, Valid: true}
+		arg.Role = payload.Role
+
+		if impersonated {
+			arg.Impersonator = pgtype.Text{String: payload.Impersonator, Valid: true}
+			arg.ImpersonationID = pgtype.UUID{Bytes: payload.SessionID, Valid: true}
+		}
+
+		if payload.ClientID != "" {
+			arg.ClientID = pgtype.Text{String: payload.ClientID, Valid: true}
+		}
+	}
+
+	// Erasing a user pseudonymizes the events about them, the call that requested it is recorded the same way
+	if erasure, ok := res.(*pb.RequestAccountErasureResponse); ok && err == nil {
+		arg.Username = pgtype.Text{String: erasure.GetDataRequest().GetUsername(), Valid: true}
+		arg.Resources = auditResources(res)
+		arg.Request = []byte("{}")
+		arg.ClientIp = ""
+		arg.UserAgent = ""
+	}
+
+	// The call has already been made, so a failure to record it is logged instead of failing the call
+	_, auditErr := interceptor.store.CreateAuditEvent(context.WithoutCancel(ctx), arg)
+	if auditErr != nil {
+		log.Error().Err(auditErr).
+			Str("method", fullMethod).
+			Str("username", arg.Username.String).
+			Msg("failed to record audit event")
+	}
+}
+
+// auditRequest returns the request as JSON with the fields marked as sensitive redacted.
+func auditRequest(req interface{}) []byte {
+	message, ok := req.(proto.Message)
+	if !ok || !message.ProtoReflect().IsValid() {
+		return []byte("{}")
+	}
+
+	message = proto.Clone(message)
+	redactSensitiveFields(message.ProtoReflect())
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return []byte("{}")
+	}
+
+	return data
+}
+
+func redactSensitiveFields(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if proto.GetExtension(field.Options(), pb.E_Sensitive).(bool) {
+			if field.Kind() == protoreflect.StringKind && field.Cardinality() != protoreflect.Repeated {
+				message.Set(field, protoreflect.ValueOfString(redacted))
+			} else {
+				message.Clear(field)
+			}
+
+			return true
+		}
+
+		forEachMessage(field, value, redactSensitiveFields)
+
+		return true
+	})
+}
+
+// auditResources returns the resources identified by the fields marked with their resource type in the
+// request and the response, e.g. accounts/42 for the id of an account. They are sorted, as fields are visited in
+// no particular order.
+func auditResources(messages ...interface{}) []string {
+	resources := []string{}
+
+	var collect func(message protoreflect.Message)
+	collect = func(message protoreflect.Message) {
+		message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			resourceType := proto.GetExtension(field.Options(), pb.E_Resource).(string)
+			if resourceType == "" {
+				forEachMessage(field, value, collect)
+
+				return true
+			}
+
+			values := []protoreflect.Value{value}
+			if field.IsList() {
+				values = values[:0]
+				for i := 0; i < value.List().Len(); i++ {
+					values = append(values, value.List().Get(i))
+				}
+			}
+
+			for _, v := range values {
+				resource := resourceType + "/" + v.String()
+				if !slices.Contains(resources, resource) {
+					resources = append(resources, resource)
+				}
+			}
+
+			return true
+		})
+	}
+
+	for _, m := range messages {
+		if message, ok := m.(proto.Message); ok && message.ProtoReflect().IsValid() {
+			collect(message.ProtoReflect())
+		}
+	}
+
+	slices.Sort(resources)
+
+	return resources
+}
+
+// forEachMessage calls fn with the message value of the field, or each of them for lists and maps of messages.
+func forEachMessage(field protoreflect.FieldDescriptor, value protoreflect.Value, fn func(message protoreflect.Message)) {
+	switch {
+	case field.IsList() && field.Message() != nil:
+		for i := 0; i < value.List().Len(); i++ {
+			fn(value.List().Get(i).Message())
+		}
+	case field.IsMap() && field.MapValue().Message() != nil:
+		value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			fn(v.Message())
+			return true
+		})
+	case !field.IsList() && !field.IsMap() && field.Message() != nil:
+		fn(value.Message())
+	}
+}
@@ -0,0 +1,355 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestSensitiveFieldsMarked makes sure secrets sent to the API cannot end up in the audit log unredacted.
+func TestSensitiveFieldsMarked(t *testing.T) {
+	sensitiveNames := []string{"password", "secret", "token", "code"}
+
+	var check func(message protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool)
+	check = func(message protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) {
+		if seen[message.FullName()] {
+			return
+		}
+		seen[message.FullName()] = true
+
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if field.Message() != nil {
+				check(field.Message(), seen)
+				continue
+			}
+
+			for _, name := range sensitiveNames {
+				if strings.Contains(string(field.Name()), name) {
+					sensitive := proto.GetExtension(field.Options(), pb.E_Sensitive).(bool)
+					require.True(t, sensitive, "%s must be marked as sensitive", field.FullName())
+				}
+			}
+		}
+	}
+
+	methods := pb.File_service_simplebank_proto.Services().ByName("Simplebank").Methods()
+	seen := make(map[protoreflect.FullName]bool)
+	for i := 0; i < methods.Len(); i++ {
+		check(methods.Get(i).Input(), seen)
+	}
+}
+
+func TestAuditRequest(t *testing.T) {
+	req := &pb.CreateTransferRequest{
+		FromAccountId: 1,
+		ToAccountId:   2,
+		Amount:        100,
+		MfaCode:       proto.String("123456"),
+	}
+
+	var request map[string]interface{}
+	require.NoError(t, json.Unmarshal(auditRequest(req), &request))
+	require.Equal(t, redacted, request["mfa_code"])
+	require.Equal(t, "1", request["from_account_id"])
+
+	// the request itself is left alone
+	require.Equal(t, "123456", req.GetMfaCode())
+
+	require.JSONEq(t, "{}", string(auditRequest(nil)))
+}
+
+func TestAuditResources(t *testing.T) {
+	req := &pb.CreateTransferRequest{FromAccountId: 1, ToAccountId: 2}
+	res := &pb.CreateTransferResponse{
+		Transfer:    &pb.Transfer{Id: 3},
+		FromAccount: &pb.Account{Id: 1},
+		ToAccount:   &pb.Account{Id: 2},
+	}
+
+	require.Equal(t, []string{"accounts/1", "accounts/2", "transfers/3"}, auditResources(req, res))
+
+	var failed *pb.CreateTransferResponse
+	require.Equal(t, []string{"accounts/1", "accounts/2"}, auditResources(req, failed))
+}
+
+func TestAuthInterceptorAudit(t *testing.T) {
+	username := util.RandomOwner()
+
+	testCases := []struct {
+		name       string
+		method     string
+		req        interface{}
+		anonymous  bool
+		buildStubs func(store *mockdb.MockStore, payload *token.Payload)
+		handlerErr error
+		checkEvent func(t *testing.T, arg db.CreateAuditEventParams)
+	}{
+		{
+			"Mutating",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: username, Password: proto.String("secret123")},
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"users:update"}, nil)
+			},
+			nil,
+			func(t *testing.T, arg db.CreateAuditEventParams) {
+				require.Equal(t, username, arg.Username.String)
+				require.Equal(t, util.DepositorRole, arg.Role)
+				require.False(t, arg.Impersonator.Valid)
+				require.Equal(t, "/pb.Simplebank/UpdateUser", arg.Method)
+				require.Equal(t, []string{"users/" + username}, arg.Resources)
+				require.NotContains(t, string(arg.Request), "secret123")
+				require.Equal(t, codes.OK.String(), arg.StatusCode)
+				require.Equal(t, "203.0.113.7", arg.ClientIp)
+				require.Equal(t, "simplebank-test", arg.UserAgent)
+			},
+		},
+		{
+			"Failed",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: username},
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"users:update"}, nil)
+			},
+			status.Error(codes.NotFound, "user not found"),
+			func(t *testing.T, arg db.CreateAuditEventParams) {
+				require.Equal(t, codes.NotFound.String(), arg.StatusCode)
+			},
+		},
+		{
+			"Denied",
+			"/pb.Simplebank/UnlockUser",
+			&pb.UnlockUserRequest{Username: util.RandomOwner()},
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"users:update"}, nil)
+			},
+			nil,
+			func(t *testing.T, arg db.CreateAuditEventParams) {
+				require.Equal(t, username, arg.Username.String)
+				require.Equal(t, codes.PermissionDenied.String(), arg.StatusCode)
+			},
+		},
+		{
+			"Public",
+			"/pb.Simplebank/LoginUser",
+			&pb.LoginUserRequest{Username: username, Password: "secret123"},
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			nil,
+			func(t *testing.T, arg db.CreateAuditEventParams) {
+				require.False(t, arg.Username.Valid)
+				require.Equal(t, []string{"users/" + username}, arg.Resources)
+				require.NotContains(t, string(arg.Request), "secret123")
+			},
+		},
+		{
+			"ReadOnly",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{"accounts:read"}, nil)
+			},
+			nil,
+			nil,
+		},
+		{
+			"Unauthenticated",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: username},
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+			require.NoError(t, err)
+
+			interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+			require.NoError(t, err)
+
+			md := metadata.Pairs(grpcGatewayUserAgentKey, "simplebank-test")
+
+			var payload *token.Payload
+			if !tc.anonymous {
+				accessToken, tokenPayload, err := tokenMaker.CreateToken(username, util.DepositorRole, uuid.New(), time.Minute)
+				require.NoError(t, err)
+
+				payload = tokenPayload
+				md.Set(AuthorizationHeader, fmt.Sprintf("%s %s", BearerPrefix, accessToken))
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(203, 0, 113, 7), Port: 50000}})
+
+			tc.buildStubs(store, payload)
+
+			times := 0
+			if tc.checkEvent != nil {
+				times = 1
+			}
+
+			store.EXPECT().
+				CreateAuditEvent(gomock.Any(), gomock.Any()).
+				Times(times).
+				DoAndReturn(func(_ context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+					tc.checkEvent(t, arg)
+					return db.AuditEvent{}, nil
+				})
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, _ = interceptor.Unary()(ctx, tc.req, info, handler)
+		})
+	}
+}
+
+func TestAuthInterceptorAuditErasure(t *testing.T) {
+	username := util.RandomOwner()
+	pseudonym := "erased-1"
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+	require.NoError(t, err)
+
+	accessToken, payload, err := tokenMaker.CreateToken(username, util.DepositorRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	md := metadata.Pairs(grpcGatewayUserAgentKey, "simplebank-test")
+	md.Set(AuthorizationHeader, fmt.Sprintf("%s %s", BearerPrefix, accessToken))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(203, 0, 113, 7), Port: 50000}})
+
+	store.EXPECT().
+		GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+		Times(1).
+		Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+	store.EXPECT().
+		GetUserStatus(gomock.Any(), gomock.Eq(username)).
+		Times(1).
+		Return(util.UserStatusActive, nil)
+
+	store.EXPECT().
+		ListRolePermissions(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]string{}, nil)
+
+	// The event of the erasure must not bring back what it removed
+	store.EXPECT().
+		CreateAuditEvent(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+			require.Equal(t, pseudonym, arg.Username.String)
+			require.Equal(t, []string{"users/" + pseudonym}, arg.Resources)
+			require.NotContains(t, string(arg.Request), username)
+			require.Empty(t, arg.ClientIp)
+			require.Empty(t, arg.UserAgent)
+			return db.AuditEvent{}, nil
+		})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.RequestAccountErasureResponse{
+			DataRequest: &pb.DataRequest{Id: 1, Username: pseudonym, Kind: util.DataRequestErasure},
+		}, nil
+	}
+
+	req := &pb.RequestAccountErasureRequest{Username: username, Password: "secret123"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.Simplebank/RequestAccountErasure"}
+	_, err = interceptor.Unary()(ctx, req, info, handler)
+	require.NoError(t, err)
+}
@@ -0,0 +1,256 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// infrastructureServices are served without an access token although they have no auth policies.
+var infrastructureServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// impersonationExcludedPermissions are never granted to impersonation tokens, the credentials of a user
+// stay out of reach of the employee acting as them.
+var impersonationExcludedPermissions = []string{
+	util.UsersUpdatePermission,
+	util.ServiceClientsManagePermission,
+}
+
+// AuthInterceptor authenticates and authorizes every call according to the auth policy of its method,
+// declared with the auth_policy option in service_simplebank.proto.
+type AuthInterceptor struct {
+	tokenMaker token.Maker
+	store      db.Store
+	policies   map[string]*pb.AuthPolicy
+}
+
+// NewAuthInterceptor reads the auth policies of the methods of the services.
+func NewAuthInterceptor(tokenMaker token.Maker, store db.Store, services protoreflect.ServiceDescriptors) (*AuthInterceptor, error) {
+	policies, err := LoadAuthPolicies(services)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthInterceptor{
+		tokenMaker: tokenMaker,
+		store:      store,
+		policies:   policies,
+	}, nil
+}
+
+// LoadAuthPolicies returns the auth policies of the methods of the services keyed by their full gRPC method name.
+func LoadAuthPolicies(services protoreflect.ServiceDescriptors) (map[string]*pb.AuthPolicy, error) {
+	policies := make(map[string]*pb.AuthPolicy)
+
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		methods := service.Methods()
+
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+
+			policy, ok := proto.GetExtension(method.Options(), pb.E_AuthPolicy).(*pb.AuthPolicy)
+			if !ok || policy == nil {
+				continue
+			}
+
+			if policy.GetOwnerField() != "" {
+				field := method.Input().Fields().ByName(protoreflect.Name(policy.GetOwnerField()))
+				if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+					return nil, fmt.Errorf("owner field %q of %s is not a string field of its request", policy.GetOwnerField(), fullMethod)
+				}
+			}
+
+			policies[fullMethod] = policy
+		}
+	}
+
+	return policies, nil
+}
+
+// Unary returns the interceptor for unary calls.
+func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		policy, authCtx, err := interceptor.authorize(ctx, info.FullMethod)
+		if authCtx != nil {
+			ctx = authCtx
+		}
+
+		var res interface{}
+		if err == nil {
+			if err = checkOwner(ctx, policy, req); err == nil {
+				res, err = handler(ctx, req)
+			}
+		}
+
+		interceptor.audit(ctx, info.FullMethod, req, res, err)
+
+		return res, err
+	}
+}
+
+// Stream returns the interceptor for streaming calls, which checks the owner of every received message.
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+
+		policy, authCtx, err := interceptor.authorize(ctx, info.FullMethod)
+		if authCtx != nil {
+			ctx = authCtx
+		}
+
+		if err == nil {
+			err = handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx, policy: policy})
+		}
+
+		interceptor.audit(ctx, info.FullMethod, nil, nil, err)
+
+		return err
+	}
+}
+
+// authorize enforces the policy of the method, except for the owner requirement which needs the request.
+// For authenticated methods the returned context carries the payload of the access token, also when the
+// caller is denied, so that the rejected call can be audited.
+func (interceptor *AuthInterceptor) authorize(ctx context.Context, fullMethod string) (*pb.AuthPolicy, context.Context, error) {
+	policy, ok := interceptor.policies[fullMethod]
+	if !ok {
+		for _, prefix := range infrastructureServices {
+			if strings.HasPrefix(fullMethod, prefix) {
+				return &pb.AuthPolicy{Public: true}, ctx, nil
+			}
+		}
+
+		// Fail closed, a method nobody declared a policy for must not become public by accident
+		return nil, nil, status.Errorf(codes.PermissionDenied, "method %s has no auth policy", fullMethod)
+	}
+
+	if policy.GetPublic() {
+		return policy, ctx, nil
+	}
+
+	payload, client, err := authenticate(interceptor.tokenMaker, interceptor.store, ctx)
+	if err != nil {
+		return nil, nil, UnauthenticatedError(err)
+	}
+
+	// Permissions are resolved on every call, so changes to the grants of a role apply to tokens already issued
+	permissions, err := interceptor.store.ListRolePermissions(ctx, payload.Role)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to resolve permissions: %s", err)
+	}
+
+	ctx = ContextWithAuthPayload(ctx, payload)
+
+	if client != nil {
+		// Methods without a permission manage the session and account security of users, which clients have no business with
+		if policy.GetPermission() == "" {
+			return nil, ctx, status.Errorf(codes.PermissionDenied, "service clients cannot call %s", fullMethod)
+		}
+
+		permissions = scopePermissions(permissions, client.Scopes, payload.Scopes)
+		ctx = ContextWithServiceClient(ctx, client)
+	}
+
+	if payload.Impersonator != "" {
+		if payload.ReadOnly && !policy.GetReadOnly() {
+			return nil, ctx, status.Errorf(codes.PermissionDenied, "read only impersonation cannot call %s", fullMethod)
+		}
+
+		// Methods without a permission manage the session and account security of users, like for service clients
+		if policy.GetPermission() == "" && !policy.GetReadOnly() {
+			return nil, ctx, status.Errorf(codes.PermissionDenied, "impersonation cannot call %s", fullMethod)
+		}
+
+		permissions = slices.DeleteFunc(slices.Clone(permissions), func(permission string) bool {
+			return slices.Contains(impersonationExcludedPermissions, permission)
+		})
+	}
+
+	ctx = ContextWithPermissions(ctx, permissions)
+
+	if policy.GetPermission() != "" && !HasPermission(ctx, policy.GetPermission()) {
+		return nil, ctx, status.Errorf(codes.PermissionDenied, "missing permission %s", policy.GetPermission())
+	}
+
+	return policy, ctx, nil
+}
+
+// scopePermissions returns the permissions of the owner of a service client that are within the scopes of both
+// the client and the token, so narrowing the scopes of a client applies to the tokens it already has.
+func scopePermissions(permissions []string, clientScopes []string, tokenScopes []string) []string {
+	var scoped []string
+	for _, permission := range permissions {
+		if slices.Contains(clientScopes, permission) && slices.Contains(tokenScopes, permission) {
+			scoped = append(scoped, permission)
+		}
+	}
+
+	return scoped
+}
+
+// checkOwner makes sure the caller only acts on themselves, unless they have the permission to act on other users.
+func checkOwner(ctx context.Context, policy *pb.AuthPolicy, req interface{}) error {
+	if policy.GetOwnerField() == "" {
+		return nil
+	}
+
+	payload, err := AuthPayloadFromContext(ctx)
+	if err != nil {
+		return UnauthenticatedError(err)
+	}
+
+	message, ok := req.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "request is not a protobuf message")
+	}
+
+	reflection := message.ProtoReflect()
+	field := reflection.Descriptor().Fields().ByName(protoreflect.Name(policy.GetOwnerField()))
+	if field == nil {
+		return status.Errorf(codes.Internal, "request has no owner field %s", policy.GetOwnerField())
+	}
+
+	if field.HasPresence() && !reflection.Has(field) {
+		return nil
+	}
+
+	if reflection.Get(field).String() == payload.Username || (policy.GetOwnerOverridePermission() != "" && HasPermission(ctx, policy.GetOwnerOverridePermission())) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "cannot act on behalf of other users")
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	policy *pb.AuthPolicy
+}
+
+func (stream *authorizedStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *authorizedStream) RecvMsg(m interface{}) error {
+	if err := stream.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return checkOwner(stream.ctx, stream.policy, m)
+}
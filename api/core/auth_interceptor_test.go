@@ -0,0 +1,820 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthPoliciesDeclared(t *testing.T) {
+	services := pb.File_service_simplebank_proto.Services()
+
+	policies, err := LoadAuthPolicies(services)
+	require.NoError(t, err)
+
+	methods := services.ByName("Simplebank").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		fullMethod := fmt.Sprintf("/pb.Simplebank/%s", methods.Get(i).Name())
+		require.Contains(t, policies, fullMethod, "every method needs an auth policy")
+	}
+}
+
+func newContextWithToken(t *testing.T, tokenMaker token.Maker, username string, role string) (context.Context, *token.Payload) {
+	accessToken, payload, err := tokenMaker.CreateToken(username, role, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	md := metadata.MD{
+		AuthorizationHeader: []string{fmt.Sprintf("%s %s", BearerPrefix, accessToken)},
+	}
+
+	return metadata.NewIncomingContext(context.Background(), md), payload
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	username := util.RandomOwner()
+	otherUsername := util.RandomOwner()
+
+	activeSession := func(permissions ...string) func(store *mockdb.MockStore, payload *token.Payload) {
+		return func(store *mockdb.MockStore, payload *token.Payload) {
+			store.EXPECT().
+				GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+				Times(1).
+				Return(db.Session{ID: payload.SessionID, Username: payload.Username}, nil)
+
+			store.EXPECT().
+				GetUserStatus(gomock.Any(), gomock.Eq(payload.Username)).
+				Times(1).
+				Return(util.UserStatusActive, nil)
+
+			store.EXPECT().
+				ListRolePermissions(gomock.Any(), gomock.Eq(payload.Role)).
+				Times(1).
+				Return(permissions, nil)
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		req        interface{}
+		role       string
+		anonymous  bool
+		buildStubs func(store *mockdb.MockStore, payload *token.Payload)
+		code       codes.Code
+	}{
+		{
+			"OK",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			activeSession(),
+			codes.OK,
+		},
+		{
+			"Public",
+			"/pb.Simplebank/LoginUser",
+			&pb.LoginUserRequest{},
+			"",
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			codes.OK,
+		},
+		{
+			"HealthCheck",
+			"/grpc.health.v1.Health/Check",
+			nil,
+			"",
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			codes.OK,
+		},
+		{
+			"NoPolicy",
+			"/pb.Simplebank/Undeclared",
+			nil,
+			util.BankerRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			codes.PermissionDenied,
+		},
+		{
+			"MissingToken",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			"",
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {},
+			codes.Unauthenticated,
+		},
+		{
+			"SessionRevoked",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: payload.Username, IsBlocked: true}, nil)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"SessionOfOtherUser",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: otherUsername}, nil)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"UserSuspended",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: payload.Username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(payload.Username)).
+					Times(1).
+					Return(util.UserStatusSuspended, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"SessionNotFound",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{}, db.ErrRecordNotFound)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"MissingPermission",
+			"/pb.Simplebank/UnlockUser",
+			&pb.UnlockUserRequest{Username: otherUsername},
+			util.DepositorRole,
+			false,
+			activeSession("users:update"),
+			codes.PermissionDenied,
+		},
+		{
+			"PermissionGranted",
+			"/pb.Simplebank/UnlockUser",
+			&pb.UnlockUserRequest{Username: otherUsername},
+			util.SupportRole,
+			false,
+			activeSession("users:unlock"),
+			codes.OK,
+		},
+		{
+			"PermissionsNotResolved",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			util.DepositorRole,
+			false,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(db.Session{ID: payload.SessionID, Username: payload.Username}, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(payload.Username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			codes.Internal,
+		},
+		{
+			"OwnerMismatch",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: otherUsername},
+			util.DepositorRole,
+			false,
+			activeSession("users:update"),
+			codes.PermissionDenied,
+		},
+		{
+			"OwnerMatch",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: username},
+			util.DepositorRole,
+			false,
+			activeSession("users:update"),
+			codes.OK,
+		},
+		{
+			"OwnerOverride",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: otherUsername},
+			util.AdminRole,
+			false,
+			activeSession("users:update", "users:update:any"),
+			codes.OK,
+		},
+		{
+			"OptionalOwnerUnset",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			util.DepositorRole,
+			false,
+			activeSession("accounts:read"),
+			codes.OK,
+		},
+		{
+			"OptionalOwnerMismatch",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{Username: &otherUsername},
+			util.DepositorRole,
+			false,
+			activeSession("accounts:read"),
+			codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+			require.NoError(t, err)
+
+			interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			var payload *token.Payload
+			if !tc.anonymous {
+				ctx, payload = newContextWithToken(t, tokenMaker, username, tc.role)
+			}
+
+			tc.buildStubs(store, payload)
+
+			// Audit events have their own test
+			store.EXPECT().
+				CreateAuditEvent(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+
+				if !tc.anonymous {
+					authPayload, err := AuthPayloadFromContext(ctx)
+					require.NoError(t, err)
+					require.Equal(t, payload.SessionID, authPayload.SessionID)
+				}
+
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err = interceptor.Unary()(ctx, tc.req, info, handler)
+
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.code == codes.OK, called)
+		})
+	}
+}
+
+func TestAuthInterceptorRejectsRefreshToken(t *testing.T) {
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+	require.NoError(t, err)
+
+	// the session is still active, but a refresh token is no access token whether or not it has been rotated
+	refreshToken, _, err := token.CreateRefreshToken(tokenMaker, util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	store.EXPECT().
+		GetSession(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	store.EXPECT().
+		CreateAuditEvent(gomock.Any(), gomock.Any()).
+		AnyTimes()
+
+	md := metadata.MD{
+		AuthorizationHeader: []string{fmt.Sprintf("%s %s", BearerPrefix, refreshToken)},
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.Fail(t, "handler must not be called")
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.Simplebank/ListSessions"}
+	_, err = interceptor.Unary()(ctx, &pb.ListSessionsRequest{}, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*pb.UpdateUserRequest
+}
+
+func (stream *testServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testServerStream) RecvMsg(m interface{}) error {
+	next := stream.messages[0]
+	stream.messages = stream.messages[1:]
+	m.(*pb.UpdateUserRequest).Username = next.Username
+
+	return nil
+}
+
+func TestAuthInterceptorStreamChecksEveryMessage(t *testing.T) {
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	ctx, payload := newContextWithToken(t, tokenMaker, username, util.DepositorRole)
+
+	store.EXPECT().
+		GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+		Times(1).
+		Return(db.Session{ID: payload.SessionID, Username: username}, nil)
+
+	store.EXPECT().
+		GetUserStatus(gomock.Any(), gomock.Eq(username)).
+		Times(1).
+		Return(util.UserStatusActive, nil)
+
+	store.EXPECT().
+		ListRolePermissions(gomock.Any(), gomock.Eq(util.DepositorRole)).
+		Times(1).
+		Return([]string{"users:update"}, nil)
+
+	store.EXPECT().
+		CreateAuditEvent(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+			require.Equal(t, codes.PermissionDenied.String(), arg.StatusCode)
+			return db.AuditEvent{}, nil
+		})
+
+	stream := &testServerStream{
+		ctx: ctx,
+		messages: []*pb.UpdateUserRequest{
+			{Username: username},
+			{Username: util.RandomOwner()},
+		},
+	}
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		_, err := AuthPayloadFromContext(stream.Context())
+		require.NoError(t, err)
+
+		require.NoError(t, stream.RecvMsg(&pb.UpdateUserRequest{}))
+		return stream.RecvMsg(&pb.UpdateUserRequest{})
+	}
+
+	// the stream pretends to be UpdateUser to reuse its owner policy
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.Simplebank/UpdateUser"}
+	err = interceptor.Stream()(nil, stream, info, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthInterceptorServiceClient(t *testing.T) {
+	username := util.RandomOwner()
+	clientID := uuid.NewString()
+
+	// Tokens are issued to a depositor, role is the current role of the owner
+	existingClient := func(client db.ServiceClient, role string, permissions ...string) func(store *mockdb.MockStore) {
+		return func(store *mockdb.MockStore) {
+			store.EXPECT().
+				GetServiceClient(gomock.Any(), gomock.Eq(clientID)).
+				Times(1).
+				Return(client, nil)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(client.Owner)).
+				Times(1).
+				Return(db.User{Username: client.Owner, Role: role, Status: util.UserStatusActive}, nil)
+
+			store.EXPECT().
+				GetSession(gomock.Any(), gomock.Any()).
+				Times(0)
+
+			store.EXPECT().
+				ListRolePermissions(gomock.Any(), gomock.Eq(role)).
+				Times(1).
+				Return(permissions, nil)
+		}
+	}
+
+	client := db.ServiceClient{
+		ID:     clientID,
+		Owner:  username,
+		Scopes: []string{"accounts:read", "transfers:create"},
+	}
+
+	disabledClient := client
+	disabledClient.DisabledAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name        string
+		method      string
+		req         interface{}
+		tokenScopes []string
+		buildStubs  func(store *mockdb.MockStore)
+		code        codes.Code
+	}{
+		{
+			"ScopeGranted",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			existingClient(client, util.DepositorRole, "accounts:read", "accounts:create"),
+			codes.OK,
+		},
+		{
+			"TokenScopeNarrowed",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"transfers:create"},
+			existingClient(client, util.DepositorRole, "accounts:read", "transfers:create"),
+			codes.PermissionDenied,
+		},
+		{
+			"OwnerLostPermission",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			existingClient(client, util.DepositorRole, "transfers:create"),
+			codes.PermissionDenied,
+		},
+		{
+			"OwnerRoleChanged",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			existingClient(client, util.BankerRole, "accounts:read"),
+			codes.OK,
+		},
+		{
+			"OwnerDemoted",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			existingClient(client, util.SupportRole),
+			codes.PermissionDenied,
+		},
+		{
+			"UserOnlyMethod",
+			"/pb.Simplebank/ListSessions",
+			&pb.ListSessionsRequest{},
+			[]string{"accounts:read"},
+			existingClient(client, util.DepositorRole, "accounts:read"),
+			codes.PermissionDenied,
+		},
+		{
+			"ClientDisabled",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Eq(clientID)).
+					Times(1).
+					Return(disabledClient, nil)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"OwnerDeactivated",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Eq(clientID)).
+					Times(1).
+					Return(client, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(username)).
+					Times(1).
+					Return(db.User{Username: username, Role: util.DepositorRole, Status: util.UserStatusDeactivated}, nil)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"ClientNotFound",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			[]string{"accounts:read"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetServiceClient(gomock.Any(), gomock.Eq(clientID)).
+					Times(1).
+					Return(db.ServiceClient{}, db.ErrRecordNotFound)
+			},
+			codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+			require.NoError(t, err)
+
+			interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+			require.NoError(t, err)
+
+			payload, err := token.NewClientPayload(clientID, username, util.DepositorRole, tc.tokenScopes, time.Minute)
+			require.NoError(t, err)
+
+			accessToken, err := tokenMaker.IssueToken(payload)
+			require.NoError(t, err)
+
+			md := metadata.MD{
+				AuthorizationHeader: []string{fmt.Sprintf("%s %s", BearerPrefix, accessToken)},
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			tc.buildStubs(store)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+
+				serviceClient, ok := ServiceClientFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, clientID, serviceClient.ID)
+				require.False(t, HasPermission(ctx, "accounts:create"))
+
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err = interceptor.Unary()(ctx, tc.req, info, handler)
+
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.code == codes.OK, called)
+		})
+	}
+}
+
+func TestCanAccessAccount(t *testing.T) {
+	ctx := context.Background()
+	require.True(t, CanAccessAccount(ctx, 1))
+
+	unrestricted := ContextWithServiceClient(ctx, &db.ServiceClient{AccountIds: []int64{}})
+	require.True(t, CanAccessAccount(unrestricted, 1))
+
+	restricted := ContextWithServiceClient(ctx, &db.ServiceClient{AccountIds: []int64{2, 3}})
+	require.False(t, CanAccessAccount(restricted, 1))
+	require.True(t, CanAccessAccount(restricted, 3))
+}
+
+func TestAuthInterceptorImpersonation(t *testing.T) {
+	username := util.RandomOwner()
+	impersonator := util.RandomOwner()
+	impersonatorSessionID := uuid.New()
+	depositorPermissions := []string{"accounts:read", "accounts:create", "users:update"}
+
+	impersonation := func(payload *token.Payload) db.Impersonation {
+		return db.Impersonation{
+			ID:                    payload.SessionID,
+			Impersonator:          impersonator,
+			ImpersonatorSessionID: impersonatorSessionID,
+			Username:              username,
+			ReadOnly:              payload.ReadOnly,
+			ExpiresAt:             payload.ExpiredAt,
+		}
+	}
+
+	activeImpersonation := func(code codes.Code) func(store *mockdb.MockStore, payload *token.Payload) {
+		return func(store *mockdb.MockStore, payload *token.Payload) {
+			store.EXPECT().
+				GetImpersonation(gomock.Any(), gomock.Eq(payload.SessionID)).
+				Times(1).
+				Return(impersonation(payload), nil)
+
+			store.EXPECT().
+				GetSession(gomock.Any(), gomock.Eq(impersonatorSessionID)).
+				Times(1).
+				Return(db.Session{ID: impersonatorSessionID, Username: impersonator}, nil)
+
+			store.EXPECT().
+				GetUserStatus(gomock.Any(), gomock.Eq(impersonator)).
+				Times(1).
+				Return(util.UserStatusActive, nil)
+
+			store.EXPECT().
+				ListRolePermissions(gomock.Any(), gomock.Eq(util.DepositorRole)).
+				Times(1).
+				Return(depositorPermissions, nil)
+
+			store.EXPECT().
+				CreateAuditEvent(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(_ context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+					require.Equal(t, username, arg.Username.String)
+					require.Equal(t, impersonator, arg.Impersonator.String)
+					require.Equal(t, payload.SessionID, uuid.UUID(arg.ImpersonationID.Bytes))
+					require.Equal(t, code.String(), arg.StatusCode)
+
+					return db.AuditEvent{}, nil
+				})
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		req        interface{}
+		readOnly   bool
+		buildStubs func(store *mockdb.MockStore, payload *token.Payload)
+		code       codes.Code
+	}{
+		{
+			"ReadOnlyRead",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			true,
+			activeImpersonation(codes.OK),
+			codes.OK,
+		},
+		{
+			"ReadOnlyWrite",
+			"/pb.Simplebank/CreateAccount",
+			&pb.CreateAccountRequest{},
+			true,
+			activeImpersonation(codes.PermissionDenied),
+			codes.PermissionDenied,
+		},
+		{
+			"Write",
+			"/pb.Simplebank/CreateAccount",
+			&pb.CreateAccountRequest{},
+			false,
+			activeImpersonation(codes.OK),
+			codes.OK,
+		},
+		{
+			"CredentialsOutOfReach",
+			"/pb.Simplebank/UpdateUser",
+			&pb.UpdateUserRequest{Username: username},
+			false,
+			activeImpersonation(codes.PermissionDenied),
+			codes.PermissionDenied,
+		},
+		{
+			"SessionManagement",
+			"/pb.Simplebank/RevokeAllOtherSessions",
+			&pb.RevokeAllOtherSessionsRequest{},
+			false,
+			activeImpersonation(codes.PermissionDenied),
+			codes.PermissionDenied,
+		},
+		{
+			"ImpersonatorLoggedOut",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				store.EXPECT().
+					GetImpersonation(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(impersonation(payload), nil)
+
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(impersonatorSessionID)).
+					Times(1).
+					Return(db.Session{ID: impersonatorSessionID, Username: impersonator, IsBlocked: true}, nil)
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"ImpersonationMismatch",
+			"/pb.Simplebank/ListAccounts",
+			&pb.ListAccountsRequest{},
+			true,
+			func(store *mockdb.MockStore, payload *token.Payload) {
+				other := impersonation(payload)
+				other.Username = util.RandomOwner()
+
+				store.EXPECT().
+					GetImpersonation(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(other, nil)
+
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tokenMaker, err := token.NewPasetoMaker([]byte(util.RandomString(32)))
+			require.NoError(t, err)
+
+			interceptor, err := NewAuthInterceptor(tokenMaker, store, pb.File_service_simplebank_proto.Services())
+			require.NoError(t, err)
+
+			payload, err := token.NewImpersonationPayload(uuid.New(), impersonator, username, util.DepositorRole, tc.readOnly, time.Minute)
+			require.NoError(t, err)
+
+			accessToken, err := tokenMaker.IssueToken(payload)
+			require.NoError(t, err)
+
+			md := metadata.MD{
+				AuthorizationHeader: []string{fmt.Sprintf("%s %s", BearerPrefix, accessToken)},
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			tc.buildStubs(store, payload)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				require.False(t, HasPermission(ctx, "users:update"))
+
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err = interceptor.Unary()(ctx, tc.req, info, handler)
+
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.code == codes.OK, called)
+		})
+	}
+}
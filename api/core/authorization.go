@@ -2,9 +2,13 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"google.golang.org/grpc/metadata"
+	db "simplebank/db/sqlc"
 	"simplebank/token"
+	"simplebank/util"
+	"slices"
 	"strings"
 )
 
@@ -13,44 +17,196 @@ const (
 	BearerPrefix        = "bearer"
 )
 
-func AuthorizeUser(tokenMaker token.Maker, ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
+type authPayloadKey struct{}
+
+type permissionsKey struct{}
+
+type serviceClientKey struct{}
+
+// ContextWithAuthPayload returns a copy of the context carrying the payload of the verified access token.
+func ContextWithAuthPayload(ctx context.Context, payload *token.Payload) context.Context {
+	return context.WithValue(ctx, authPayloadKey{}, payload)
+}
+
+// AuthPayloadFromContext returns the payload the auth interceptor verified for the request.
+func AuthPayloadFromContext(ctx context.Context) (*token.Payload, error) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+	if !ok || payload == nil {
+		return nil, fmt.Errorf("missing auth payload")
+	}
+
+	return payload, nil
+}
+
+// ContextWithPermissions returns a copy of the context carrying the permissions granted to the role of the caller.
+func ContextWithPermissions(ctx context.Context, permissions []string) context.Context {
+	return context.WithValue(ctx, permissionsKey{}, permissions)
+}
+
+// ContextWithServiceClient returns a copy of the context carrying the service client the request was made by.
+func ContextWithServiceClient(ctx context.Context, client *db.ServiceClient) context.Context {
+	return context.WithValue(ctx, serviceClientKey{}, client)
+}
+
+// ServiceClientFromContext returns the service client the request was made by, if it was not made by a user.
+func ServiceClientFromContext(ctx context.Context) (*db.ServiceClient, bool) {
+	client, ok := ctx.Value(serviceClientKey{}).(*db.ServiceClient)
+
+	return client, ok && client != nil
+}
+
+// CanAccessAccount reports whether the caller can use the account, service clients can be restricted to some
+// accounts of their owner. Whether the account belongs to the caller is up to the handler.
+func CanAccessAccount(ctx context.Context, accountID int64) bool {
+	client, ok := ServiceClientFromContext(ctx)
+	if !ok || len(client.AccountIds) == 0 {
+		return true
+	}
+
+	return slices.Contains(client.AccountIds, accountID)
+}
+
+// AccessibleAccountIDs returns the accounts a service client is restricted to, or nil when the caller can use
+// all accounts of the user. Handlers listing across accounts filter by it.
+func AccessibleAccountIDs(ctx context.Context) []int64 {
+	client, ok := ServiceClientFromContext(ctx)
+	if !ok || len(client.AccountIds) == 0 {
+		return nil
+	}
+
+	return client.AccountIds
+}
+
+// authenticate verifies the bearer token of the request and checks that its session, or for tokens of
+// service clients the client, is still active. The service client is nil for tokens of users.
+// Tokens of impersonations need the impersonation and the session of the impersonator.
+func authenticate(tokenMaker token.Maker, store db.Store, ctx context.Context) (*token.Payload, *db.ServiceClient, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, fmt.Errorf("missing metadata")
+		return nil, nil, fmt.Errorf("missing metadata")
 	}
 
 	values := md.Get(AuthorizationHeader)
 	if len(values) == 0 {
-		return nil, fmt.Errorf("missing authorization header")
+		return nil, nil, fmt.Errorf("missing authorization header")
 	}
 
 	authHeader := values[0]
 	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
-		return nil, fmt.Errorf("invalid authorization format")
+		return nil, nil, fmt.Errorf("invalid authorization format")
 	}
 
 	authType := strings.ToLower(fields[0])
 	if authType != BearerPrefix {
-		return nil, fmt.Errorf("only bearer authorization is supported")
+		return nil, nil, fmt.Errorf("only bearer authorization is supported")
 	}
 
 	accessToken := fields[1]
 	payload, err := tokenMaker.VerifyToken(accessToken)
 	if err != nil {
-		return nil, fmt.Errorf("invalid access token: %w", err)
+		return nil, nil, fmt.Errorf("invalid access token: %w", err)
 	}
 
-	if !HasPermission(payload.Role, accessibleRoles) {
-		return nil, fmt.Errorf("access denied")
+	// Refresh tokens outlive access tokens and stay valid for their session after they have been rotated
+	if payload.Type != token.TypeAccess {
+		return nil, nil, fmt.Errorf("not an access token")
 	}
 
-	return payload, nil
+	if payload.ClientID != "" {
+		client, err := store.GetServiceClient(ctx, payload.ClientID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, nil, fmt.Errorf("service client not found")
+			}
+
+			return nil, nil, fmt.Errorf("failed to get service client: %w", err)
+		}
+
+		if client.DisabledAt.Valid || client.Owner != payload.Username {
+			return nil, nil, fmt.Errorf("service client has been disabled")
+		}
+
+		owner, err := store.GetUser(ctx, client.Owner)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get service client owner: %w", err)
+		}
+
+		if owner.Status != util.UserStatusActive {
+			return nil, nil, fmt.Errorf("user has been %s", owner.Status)
+		}
+
+		// Client tokens have no session for a role change to block, they act with the current role of the owner
+		clientPayload := *payload
+		clientPayload.Role = owner.Role
+
+		return &clientPayload, &client, nil
+	}
+
+	sessionID := payload.SessionID
+	sessionUsername := payload.Username
+
+	if payload.Impersonator != "" {
+		impersonation, err := store.GetImpersonation(ctx, payload.SessionID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, nil, fmt.Errorf("impersonation not found")
+			}
+
+			return nil, nil, fmt.Errorf("failed to get impersonation: %w", err)
+		}
+
+		if impersonation.Username != payload.Username || impersonation.Impersonator != payload.Impersonator {
+			return nil, nil, fmt.Errorf("impersonation does not match the access token")
+		}
+
+		// Impersonation tokens are only as good as the session of the impersonator they were issued to
+		sessionID = impersonation.ImpersonatorSessionID
+		sessionUsername = impersonation.Impersonator
+	}
+
+	// Access tokens are stateless, checking their session lets logout and revocation take effect immediately
+	session, err := store.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil, fmt.Errorf("session not found")
+		}
+
+		return nil, nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	if session.IsBlocked || session.Username != sessionUsername {
+		return nil, nil, fmt.Errorf("session has been revoked")
+	}
+
+	// Suspending a user blocks their sessions, this also covers a session created by a login racing the suspension
+	err = ensureUserActive(ctx, store, sessionUsername)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payload, nil, nil
+}
+
+// ensureUserActive fails for users who have been suspended or deactivated.
+func ensureUserActive(ctx context.Context, store db.Store, username string) error {
+	userStatus, err := store.GetUserStatus(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to get user status: %w", err)
+	}
+
+	if userStatus != util.UserStatusActive {
+		return fmt.Errorf("user has been %s", userStatus)
+	}
+
+	return nil
 }
 
-func HasPermission(userRole string, accessibleRoles []string) bool {
-	for _, role := range accessibleRoles {
-		if userRole == role {
+// HasPermission reports whether the role of the caller has been granted the permission.
+func HasPermission(ctx context.Context, permission string) bool {
+	permissions, _ := ctx.Value(permissionsKey{}).([]string)
+	for _, granted := range permissions {
+		if granted == permission {
 			return true
 		}
 	}
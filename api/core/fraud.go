@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+	"errors"
+	db "simplebank/db/sqlc"
+	"simplebank/fraud"
+	"simplebank/token"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TransferFeatures describes a payment and the history of its sender for the fraud rules. The auth payload is
+// that of the sender, or nil for payments they do not make themselves, such as the collection of a direct debit.
+func (server *Server) TransferFeatures(ctx context.Context, authPayload *token.Payload, user db.User, fromAccount, toAccount *db.Account, amount int64) (fraud.Features, error) {
+	history, err := server.Store.GetTransferHistory(ctx, db.GetTransferHistoryParams{
+		Owner:       fromAccount.Owner,
+		ToAccountID: toAccount.ID,
+	})
+	if err != nil {
+		return fraud.Features{}, err
+	}
+
+	features := fraud.Features{
+		Amount:            amount,
+		AverageAmount:     history.AverageAmount,
+		TransfersLastHour: history.TransfersLastHour,
+		AmountLastDay:     history.AmountLastDay,
+		NewPayee:          history.TransfersToPayee == 0,
+		OwnAccount:        toAccount.Owner == fromAccount.Owner,
+		AccountAgeDays:    time.Since(fromAccount.CreatedAt).Hours() / 24,
+		UserAgeDays:       time.Since(user.CreatedAt).Hours() / 24,
+	}
+
+	if authPayload == nil {
+		return features, nil
+	}
+
+	// Service clients and impersonations have no login session, so there is no device to compare
+	newDevice, err := server.Store.IsNewDeviceSession(ctx, authPayload.SessionID)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return fraud.Features{}, err
+	}
+
+	features.NewDevice = newDevice.Valid && newDevice.Bool
+
+	return features, nil
+}
+
+// CheckFraudRules evaluates the fraud rules for a payment that cannot be held for review. Fraud cases are released
+// as plain transfers, which would not settle a payment request or a direct debit, so payments the rules would
+// hold are declined like blocked ones.
+func (server *Server) CheckFraudRules(features fraud.Features, fromAccount, toAccount *db.Account) error {
+	decision := server.FraudEngine.Evaluate(features)
+	if decision.Action != fraud.ActionBlock && decision.Action != fraud.ActionReview {
+		return nil
+	}
+
+	log.Warn().
+		Str("username", fromAccount.Owner).
+		Int64("from_account_id", fromAccount.ID).
+		Int64("to_account_id", toAccount.ID).
+		Int64("amount", features.Amount).
+		Str("rule", decision.Rule).
+		Str("action", string(decision.Action)).
+		Msg("payment declined by fraud rule")
+
+	// The rule is not disclosed, so it cannot be worked around
+	return status.Errorf(codes.PermissionDenied, "payment has been declined")
+}
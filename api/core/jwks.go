@@ -0,0 +1,22 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"simplebank/token"
+)
+
+// JWKSPath is where the gateway publishes the public keys tokens are verified with.
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler serves the public keys of the keyring as a JSON Web Key Set.
+func JWKSHandler(keyring *token.Keyring) http.Handler {
+	keySet, _ := json.Marshal(keyring.JSONWebKeySet())
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Verifiers refetch the keys regularly, a new key is added for verification well before it signs tokens
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Write(keySet)
+	})
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckKycAccountLimit rejects opening another account when the user holds as many as their KYC tier allows.
+func (server *Server) CheckKycAccountLimit(ctx context.Context, user db.User) error {
+	tier := util.GetKycTier(user.KycTier)
+	if tier.MaxAccounts == 0 {
+		return status.Errorf(codes.FailedPrecondition, "identity must be verified before opening an account")
+	}
+
+	count, err := server.Store.CountOpenAccountsByOwner(ctx, user.Username)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count accounts: %v", err)
+	}
+
+	if count >= tier.MaxAccounts {
+		return status.Errorf(codes.FailedPrecondition, "KYC tier %d allows at most %d accounts", user.KycTier, tier.MaxAccounts)
+	}
+
+	return nil
+}
+
+// CheckKycTransferLimit rejects a transfer above the per-transfer limit of the KYC tier of the user, or one that
+// would take what they sent in the last 24 hours over the daily limit. It lets a transfer fail before anything
+// else is done for it, the store enforces the limits again when the money moves.
+func (server *Server) CheckKycTransferLimit(ctx context.Context, user db.User, amount int64) error {
+	sent, err := server.Store.SumTransfersFromOwnerSince(ctx, db.SumTransfersFromOwnerSinceParams{
+		Owner: user.Username,
+		Since: time.Now().Add(-24 * time.Hour),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to sum transfers: %v", err)
+	}
+
+	err = db.CheckKycTransferLimit(user, amount, sent)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+	"errors"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"simplebank/worker"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons of failed login attempts, checks of a password or second factor after login are recorded as attempts too
+const (
+	LoginFailureUnknownUser   = "unknown_user"
+	LoginFailureWrongPassword = "wrong_password"
+	LoginFailureWrongMfaCode  = "wrong_mfa_code"
+	LoginFailureLocked        = "locked"
+	LoginFailureThrottled     = "throttled"
+	LoginFailureIPThrottled   = "ip_throttled"
+	LoginFailureInactive      = "inactive"
+
+	// maxLoginRetryDelayShift keeps the doubling of the retry delay from overflowing
+	maxLoginRetryDelayShift = 16
+)
+
+var ErrInvalidPassword = errors.New("invalid password")
+
+// CheckLoginThrottle rejects the attempt while its client IP has too many recent failures
+// or while the retry delay after the last failure of the username has not passed yet.
+// It returns the number of recent failures of the username.
+func (server *Server) CheckLoginThrottle(ctx context.Context, attempt db.CreateLoginAttemptParams) (int64, error) {
+	now := time.Now()
+	since := now.Add(-server.Config.LoginFailureWindow)
+
+	ipFailures, err := server.Store.CountLoginFailuresByIP(ctx, db.CountLoginFailuresByIPParams{
+		ClientIp: attempt.ClientIp,
+		Since:    since,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "cannot count login failures: %v", err)
+	}
+
+	if ipFailures >= server.Config.LoginMaxFailuresPerIP {
+		if err = server.RecordLoginFailure(ctx, attempt, LoginFailureIPThrottled); err != nil {
+			return 0, err
+		}
+
+		return 0, status.Errorf(codes.ResourceExhausted, "too many failed login attempts, try again later")
+	}
+
+	stats, err := server.Store.GetLoginFailureStats(ctx, db.GetLoginFailureStatsParams{
+		Username: attempt.Username,
+		Since:    since,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "cannot get login failures: %v", err)
+	}
+
+	if stats.Failures > 0 {
+		retryAt := stats.LastFailedAt.Add(loginRetryDelay(server.Config, stats.Failures))
+		if now.Before(retryAt) {
+			if err = server.RecordLoginFailure(ctx, attempt, LoginFailureThrottled); err != nil {
+				return 0, err
+			}
+
+			return 0, status.Errorf(codes.ResourceExhausted, "too many failed login attempts, try again in %s", retryAt.Sub(now).Round(time.Second))
+		}
+	}
+
+	return stats.Failures, nil
+}
+
+// loginRetryDelay doubles the time to wait before the next attempt with every failure, up to the lockout duration.
+func loginRetryDelay(config util.Config, failures int64) time.Duration {
+	shift := min(failures-1, maxLoginRetryDelayShift)
+
+	return min(config.LoginRetryDelay<<shift, config.LoginLockoutDuration)
+}
+
+func (server *Server) RecordLoginFailure(ctx context.Context, attempt db.CreateLoginAttemptParams, reason string) error {
+	attempt.Succeeded = false
+	attempt.FailureReason = pgtype.Text{
+		String: reason,
+		Valid:  true,
+	}
+
+	_, err := server.Store.CreateLoginAttempt(ctx, attempt)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot record login attempt: %v", err)
+	}
+
+	return nil
+}
+
+// LockUser blocks logins of the user for the lockout duration and lets them know by email.
+func (server *Server) LockUser(ctx context.Context, user db.User, clientIP string) error {
+	user, err := server.Store.LockUser(ctx, db.LockUserParams{
+		Username: user.Username,
+		LockedUntil: pgtype.Timestamptz{
+			Time:  time.Now().Add(server.Config.LoginLockoutDuration),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot lock user: %v", err)
+	}
+
+	log.Warn().
+		Str("username", user.Username).
+		Str("client_ip", clientIP).
+		Time("locked_until", user.LockedUntil.Time).
+		Msg("user locked after repeated failed logins")
+
+	taskPayload := &worker.PayloadSendAccountLockedEmail{
+		Username:    user.Username,
+		ClientIP:    clientIP,
+		LockedUntil: user.LockedUntil.Time,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	err = server.TaskDistributor.DistributeSendAccountLockedEmailTask(ctx, taskPayload, opts...)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to send account locked email: %v", err)
+	}
+
+	return nil
+}
+
+// VerifyCredential checks a password or second factor of the user with verify, throttled and locked out like
+// logins, so that neither can be guessed by repeating a call instead of logging in. Wrong credentials, reported by
+// verify as ErrInvalidPassword or ErrInvalidMfaCode, are recorded as failed login attempts. The error of verify is
+// returned as is.
+func (server *Server) VerifyCredential(ctx context.Context, user db.User, reason string, verify func() error) error {
+	metadata := ExtractMetadata(ctx)
+	attempt := db.CreateLoginAttemptParams{
+		Username:  user.Username,
+		ClientIp:  metadata.ClientIP,
+		UserAgent: metadata.UserAgent,
+	}
+
+	failures, err := server.CheckLoginThrottle(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	if user.LockedUntil.Valid && time.Now().Before(user.LockedUntil.Time) {
+		if err = server.RecordLoginFailure(ctx, attempt, LoginFailureLocked); err != nil {
+			return err
+		}
+
+		return status.Errorf(codes.ResourceExhausted, "too many failed attempts, try again later")
+	}
+
+	verifyErr := verify()
+	if !errors.Is(verifyErr, ErrInvalidPassword) && !errors.Is(verifyErr, ErrInvalidMfaCode) {
+		return verifyErr
+	}
+
+	if err = server.RecordLoginFailure(ctx, attempt, reason); err != nil {
+		return err
+	}
+
+	if failures+1 >= server.Config.LoginMaxFailures {
+		if err = server.LockUser(ctx, user, metadata.ClientIP); err != nil {
+			return err
+		}
+	}
+
+	return verifyErr
+}
+
+// CheckStepUp requires a fresh second factor of the user for payments above the step-up amount on top of the
+// access token, so that a stolen token alone cannot move large amounts.
+func (server *Server) CheckStepUp(ctx context.Context, user db.User, amount int64, mfaCode *string) error {
+	if server.Config.MfaStepUpAmount <= 0 || amount <= server.Config.MfaStepUpAmount {
+		return nil
+	}
+
+	if mfaCode == nil {
+		return status.Errorf(codes.PermissionDenied, "two-factor code required for payments above %d", server.Config.MfaStepUpAmount)
+	}
+
+	err := server.VerifyCredential(ctx, user, LoginFailureWrongMfaCode, func() error {
+		return VerifySecondFactor(ctx, server.Store, user.Username, *mfaCode, "")
+	})
+	if err != nil {
+		return MfaError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package core
+
+import (
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginRetryDelay(t *testing.T) {
+	config := util.Config{
+		LoginRetryDelay:      time.Second,
+		LoginLockoutDuration: time.Minute,
+	}
+
+	require.Equal(t, time.Second, loginRetryDelay(config, 1))
+	require.Equal(t, 2*time.Second, loginRetryDelay(config, 2))
+	require.Equal(t, 16*time.Second, loginRetryDelay(config, 5))
+	require.Equal(t, time.Minute, loginRetryDelay(config, 7))
+	require.Equal(t, time.Minute, loginRetryDelay(config, 1000))
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"net"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -21,24 +23,41 @@ type Metadata struct {
 // ExtractMetadata retrieves metadata from the provided context, including user-agent and client IP information.
 func ExtractMetadata(ctx context.Context) *Metadata {
 	mtdt := &Metadata{}
+	var forwardedFor []string
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if userAgents := md.Get(grpcGatewayUserAgentKey); len(userAgents) > 0 {
+		if userAgents := md.Get(userAgentKey); len(userAgents) > 0 {
 			mtdt.UserAgent = userAgents[0]
 		}
 
-		if userAgents := md.Get(userAgentKey); len(userAgents) > 0 {
+		// Behind the gateway the user agent is that of its gRPC client, the one of the HTTP client is forwarded
+		if userAgents := md.Get(grpcGatewayUserAgentKey); len(userAgents) > 0 {
 			mtdt.UserAgent = userAgents[0]
 		}
 
-		if clientIPs := md.Get(xForwardedForKey); len(clientIPs) > 0 {
-			mtdt.ClientIP = clientIPs[0]
-		}
+		forwardedFor = md.Get(xForwardedForKey)
 	}
 
 	if p, ok := peer.FromContext(ctx); ok {
 		mtdt.ClientIP = p.Addr.String()
+
+		// Drop the ephemeral port so that requests from the same address can be grouped
+		if host, _, err := net.SplitHostPort(mtdt.ClientIP); err == nil {
+			mtdt.ClientIP = host
+		}
+	}
+
+	// Requests proxied by the HTTP gateway come from the loopback interface, the gateway appends the
+	// address of the HTTP client to x-forwarded-for. Other clients cannot spoof it that way.
+	if len(forwardedFor) > 0 && (mtdt.ClientIP == "" || isLoopback(mtdt.ClientIP)) {
+		addresses := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+		mtdt.ClientIP = strings.TrimSpace(addresses[len(addresses)-1])
 	}
 
 	return mtdt
 }
+
+func isLoopback(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
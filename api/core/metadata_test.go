@@ -0,0 +1,31 @@
+package core
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestExtractMetadata(t *testing.T) {
+	md := metadata.Pairs(
+		userAgentKey, "grpc-go/1.0",
+		grpcGatewayUserAgentKey, "Mozilla/5.0",
+		xForwardedForKey, "10.0.0.1, 203.0.113.7",
+	)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	// proxied by the gateway
+	gatewayCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000}})
+	mtdt := ExtractMetadata(gatewayCtx)
+	require.Equal(t, "Mozilla/5.0", mtdt.UserAgent)
+	require.Equal(t, "203.0.113.7", mtdt.ClientIP)
+
+	// a direct client cannot claim another address
+	directCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(198, 51, 100, 2), Port: 50000}})
+	mtdt = ExtractMetadata(directCtx)
+	require.Equal(t, "198.51.100.2", mtdt.ClientIP)
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"errors"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrMfaNotEnabled  = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMfaCode = errors.New("invalid two-factor code")
+)
+
+// VerifyTOTP checks the code against the TOTP credential. Each code is accepted at most once,
+// so a code observed by someone else cannot be replayed within its validity window.
+func VerifyTOTP(ctx context.Context, store db.Store, credential db.TotpCredential, code string) error {
+	step, ok := util.ValidateTOTP(credential.Secret, code, time.Now())
+	if !ok {
+		return ErrInvalidMfaCode
+	}
+
+	_, err := store.UseTotpStep(ctx, db.UseTotpStepParams{
+		Username: credential.Username,
+		Step:     step,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrInvalidMfaCode
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// VerifySecondFactor checks a TOTP code, or a single-use recovery code when no TOTP code is given,
+// for a user with two-factor authentication enabled.
+func VerifySecondFactor(ctx context.Context, store db.Store, username string, code string, recoveryCode string) error {
+	credential, err := store.GetTotpCredential(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrMfaNotEnabled
+		}
+
+		return err
+	}
+
+	if !credential.ConfirmedAt.Valid {
+		return ErrMfaNotEnabled
+	}
+
+	if code != "" {
+		return VerifyTOTP(ctx, store, credential, code)
+	}
+
+	_, err = store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		Username:   username,
+		HashedCode: util.HashToken(strings.ToLower(strings.TrimSpace(recoveryCode))),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrInvalidMfaCode
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// MfaError converts an error from verifying a second factor to a gRPC error, leaving gRPC errors such as those of
+// throttling alone.
+func MfaError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, ErrMfaNotEnabled):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	case errors.Is(err, ErrInvalidMfaCode):
+		return status.Errorf(codes.PermissionDenied, "%s", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to verify two-factor code: %v", err)
+	}
+}
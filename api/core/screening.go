@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"errors"
+	db "simplebank/db/sqlc"
+	"simplebank/fraud"
+	"simplebank/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScreenName matches a name against the sanctions watchlist, returning the hits to record for review.
+func (server *Server) ScreenName(name string) []db.ScreeningHit {
+	var hits []db.ScreeningHit
+
+	for _, match := range server.Screener.Screen(name) {
+		hits = append(hits, db.ScreeningHit{
+			EntryID:   match.EntryID,
+			EntryName: match.Name,
+			Programs:  match.Programs,
+			Score:     match.Score,
+		})
+	}
+
+	return hits
+}
+
+// CheckPayee rejects payments to users restricted by sanctions screening. Payees the sender has not paid before
+// are screened again, as the watchlist may have changed since they signed up.
+func (server *Server) CheckPayee(ctx context.Context, toAccount *db.Account, features fraud.Features) error {
+	// The sender was checked already
+	if features.OwnAccount {
+		return nil
+	}
+
+	payee, err := server.Store.GetUser(ctx, toAccount.Owner)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get payee: %v", err)
+	}
+
+	if !payee.RestrictedAt.Valid && features.NewPayee {
+		hits := server.ScreenName(payee.FullName)
+		if len(hits) > 0 {
+			result, err := server.Store.FlagScreeningHitsTx(ctx, db.FlagScreeningHitsTxParams{
+				Username:     payee.Username,
+				Trigger:      util.ScreeningTriggerPayee,
+				ScreenedName: payee.FullName,
+				Hits:         hits,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to flag screening hits: %v", err)
+			}
+
+			payee = result.User
+		}
+	}
+
+	// The sender is not told why, so that the payee is not tipped off
+	if payee.RestrictedAt.Valid {
+		return status.Errorf(codes.FailedPrecondition, "account [%s] cannot receive transfers", toAccount.Number)
+	}
+
+	return nil
+}
+
+// GetPayer returns a user asked to pay by someone else, such as the payer of a payment request or the debtor of
+// a direct debit, rejecting users restricted by sanctions screening.
+func (server *Server) GetPayer(ctx context.Context, username string) (db.User, error) {
+	payer, err := server.Store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return payer, status.Errorf(codes.NotFound, "payer not found")
+		}
+
+		return payer, status.Errorf(codes.Internal, "failed to get payer: %v", err)
+	}
+
+	// The caller is not told why, so that the payer is not tipped off
+	if payer.RestrictedAt.Valid {
+		return payer, status.Errorf(codes.FailedPrecondition, "payer [%s] cannot make payments", payer.Username)
+	}
+
+	return payer, nil
+}
@@ -1,8 +1,11 @@
 package core
 
 import (
+	"simplebank/blob"
 	db "simplebank/db/sqlc"
+	"simplebank/fraud"
 	"simplebank/pb"
+	"simplebank/screening"
 	"simplebank/token"
 	"simplebank/util"
 	"simplebank/worker"
@@ -13,5 +16,10 @@ type Server struct {
 	Config          util.Config
 	Store           db.Store
 	TokenMaker      token.Maker
+	Signer          *token.Signer
+	PasswordHasher  util.PasswordHasher
 	TaskDistributor worker.TaskDistributor
+	BlobStore       blob.Store
+	FraudEngine     *fraud.Engine
+	Screener        *screening.Screener
 }
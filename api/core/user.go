@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"errors"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetVerifiedUser returns the user moving money, rejecting users who have not verified their email address yet
+// unless the check is turned off in the configuration, and users restricted by sanctions screening.
+func (server *Server) GetVerifiedUser(ctx context.Context, username string) (db.User, error) {
+	user, err := server.Store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return user, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return user, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	if server.Config.RequireVerifiedEmail && !user.IsEmailVerified {
+		return user, status.Errorf(codes.FailedPrecondition, "email address must be verified first")
+	}
+
+	if user.RestrictedAt.Valid {
+		return user, status.Errorf(codes.FailedPrecondition, "user is restricted pending a compliance review")
+	}
+
+	return user, nil
+}
+
+// CheckUserActive rejects logins and calls of users who have been suspended or deactivated.
+func CheckUserActive(userStatus string) error {
+	if userStatus != util.UserStatusActive {
+		return status.Errorf(codes.PermissionDenied, "user has been %s", userStatus)
+	}
+
+	return nil
+}
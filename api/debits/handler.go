@@ -0,0 +1,39 @@
+package debits
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type DirectDebitHandler struct {
+	Server *core.Server
+}
+
+func NewDirectDebitHandler(server *core.Server) *DirectDebitHandler {
+	return &DirectDebitHandler{
+		Server: server,
+	}
+}
+
+func (h *DirectDebitHandler) getMandate(ctx context.Context, id int64) (db.Mandate, error) {
+	mandate, err := h.Server.Store.GetMandate(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return mandate, status.Errorf(codes.NotFound, "mandate not found")
+		}
+
+		return mandate, status.Errorf(codes.Internal, "failed to get mandate: %v", err)
+	}
+
+	return mandate, nil
+}
@@ -0,0 +1,77 @@
+package debits
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ChargebackDirectDebit lets the debtor claim a collected direct debit back within the configured window.
+func (h *DirectDebitHandler) ChargebackDirectDebit(ctx context.Context, req *pb.ChargebackDirectDebitRequest) (*pb.ChargebackDirectDebitResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateChargebackDirectDebitRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	directDebit, err := h.Server.Store.GetDirectDebit(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "direct debit not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get direct debit: %v", err)
+	}
+
+	mandate, err := h.getMandate(ctx, directDebit.MandateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if mandate.Debtor != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "direct debit was not collected from the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, mandate.DebtorAccountID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", mandate.DebtorAccountID)
+	}
+
+	result, err := h.Server.Store.ChargebackDirectDebitTx(ctx, db.ChargebackDirectDebitTxParams{
+		DirectDebitID: directDebit.ID,
+		Window:        h.Server.Config.ChargebackWindow,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrAlreadyChargedBack) || errors.Is(err, db.ErrChargebackWindowClosed) ||
+			errors.Is(err, db.ErrAccountClosed) || errors.Is(err, db.ErrAccountFrozen) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to charge back direct debit: %v", err)
+	}
+
+	response := &pb.ChargebackDirectDebitResponse{
+		DirectDebit: result.DirectDebit.ToResponse(),
+		Transfer:    result.Transfer.ToResponse(),
+	}
+
+	return response, nil
+}
+
+func validateChargebackDirectDebitRequest(req *pb.ChargebackDirectDebitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
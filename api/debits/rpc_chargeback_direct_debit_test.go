@@ -0,0 +1,178 @@
+package debits
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChargebackDirectDebit(t *testing.T) {
+	debtor, _ := testutil.RandomUser(t)
+	creditor, _ := testutil.RandomUser(t)
+	debtorAccount := testutil.RandomAccount(debtor.Username)
+	creditorAccount := testutil.RandomAccount(creditor.Username)
+	mandate := randomMandate(debtorAccount, creditorAccount)
+
+	directDebit := db.DirectDebit{
+		ID:          util.RandomInt(1, 1000),
+		MandateID:   mandate.ID,
+		TransferID:  util.RandomInt(1, 1000),
+		Amount:      50,
+		Status:      util.DirectDebitCollected,
+		CollectedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ChargebackDirectDebitResponse, err error)
+	}{
+		{
+			"OK",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetDirectDebit(gomock.Any(), gomock.Eq(directDebit.ID)).
+					Times(1).
+					Return(directDebit, nil)
+
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				arg := db.ChargebackDirectDebitTxParams{
+					DirectDebitID: directDebit.ID,
+					Window:        time.Hour,
+				}
+
+				chargedBack := directDebit
+				chargedBack.Status = util.DirectDebitChargedBack
+
+				store.EXPECT().
+					ChargebackDirectDebitTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ChargebackDirectDebitTxResult{
+						DirectDebit:      chargedBack,
+						TransferTxResult: db.TransferTxResult{Transfer: testutil.RandomTransfer(creditorAccount.ID, debtorAccount.ID)},
+					}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, debtor.Username, debtor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ChargebackDirectDebitResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pb.DirectDebitStatus_DIRECT_DEBIT_STATUS_CHARGED_BACK, res.DirectDebit.Status)
+			},
+		},
+		{
+			"ServiceClientNotAllowedAccount",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetDirectDebit(gomock.Any(), gomock.Eq(directDebit.ID)).
+					Times(1).
+					Return(directDebit, nil)
+
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					ChargebackDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, debtor.Username, debtor.Role, time.Minute)
+				return core.ContextWithServiceClient(ctx, &db.ServiceClient{Owner: debtor.Username, AccountIds: []int64{debtorAccount.ID + 1}})
+			},
+			func(t *testing.T, res *pb.ChargebackDirectDebitResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"NotDebtor",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetDirectDebit(gomock.Any(), gomock.Eq(directDebit.ID)).
+					Times(1).
+					Return(directDebit, nil)
+
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					ChargebackDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ChargebackDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"WindowClosed",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetDirectDebit(gomock.Any(), gomock.Eq(directDebit.ID)).
+					Times(1).
+					Return(directDebit, nil)
+
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					ChargebackDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChargebackDirectDebitTxResult{}, db.ErrChargebackWindowClosed)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, debtor.Username, debtor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ChargebackDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewDirectDebitHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.ChargebackDirectDebit(ctx, &pb.ChargebackDirectDebitRequest{Id: directDebit.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
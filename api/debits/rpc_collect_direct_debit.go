@@ -0,0 +1,135 @@
+package debits
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultCollectionDescription = "Direct debit"
+
+func (h *DirectDebitHandler) CollectDirectDebit(ctx context.Context, req *pb.CollectDirectDebitRequest) (*pb.CollectDirectDebitResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateCollectDirectDebitRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	mandate, err := h.getMandate(ctx, req.GetMandateId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the owner of the creditor account can collect
+	if mandate.Creditor != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "mandate does not allow the authenticated user to collect")
+	}
+
+	if !core.CanAccessAccount(ctx, mandate.CreditorAccountID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", mandate.CreditorAccountID)
+	}
+
+	creditor, err := h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.Server.CheckStepUp(ctx, creditor, req.GetAmount(), req.MfaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	debtor, err := h.Server.GetPayer(ctx, mandate.Debtor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.checkCollection(ctx, debtor, mandate, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	description := defaultCollectionDescription
+	if mandate.Reference.Valid {
+		description = mandate.Reference.String
+	}
+
+	result, err := h.Server.Store.CollectDirectDebitTx(ctx, db.CollectDirectDebitTxParams{
+		MandateID:   mandate.ID,
+		Amount:      req.GetAmount(),
+		Description: description,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrMandateAmountExceeded):
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		case errors.Is(err, db.ErrMandateNotActive), errors.Is(err, db.ErrCollectionTooSoon),
+			errors.Is(err, db.ErrAccountClosed), errors.Is(err, db.ErrAccountFrozen), errors.Is(err, db.ErrKycLimitExceeded):
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to collect direct debit: %v", err)
+	}
+
+	response := &pb.CollectDirectDebitResponse{
+		DirectDebit: result.DirectDebit.ToResponse(),
+		Transfer:    result.Transfer.ToResponse(),
+	}
+
+	return response, nil
+}
+
+// checkCollection screens the creditor and runs the fraud rules on the collection as a payment of the debtor. The
+// creditor collects, so there is no device of the debtor to compare.
+func (h *DirectDebitHandler) checkCollection(ctx context.Context, debtor db.User, mandate db.Mandate, amount int64) error {
+	debtorAccount, err := h.Server.Store.GetAccount(ctx, mandate.DebtorAccountID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch account details: %v", err)
+	}
+
+	creditorAccount, err := h.Server.Store.GetAccount(ctx, mandate.CreditorAccountID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch account details: %v", err)
+	}
+
+	features, err := h.Server.TransferFeatures(ctx, nil, debtor, &debtorAccount, &creditorAccount, amount)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get transfer history: %v", err)
+	}
+
+	err = h.Server.CheckPayee(ctx, &creditorAccount, features)
+	if err != nil {
+		return err
+	}
+
+	return h.Server.CheckFraudRules(features, &debtorAccount, &creditorAccount)
+}
+
+func validateCollectDirectDebitRequest(req *pb.CollectDirectDebitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetMandateId()); err != nil {
+		violations = append(violations, core.FieldViolation("mandate_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, core.FieldViolation("amount", err))
+	}
+
+	if req.MfaCode != nil {
+		if err := val.ValidateTOTPCode(req.GetMfaCode()); err != nil {
+			violations = append(violations, core.FieldViolation("mfa_code", err))
+		}
+	}
+
+	return violations
+}
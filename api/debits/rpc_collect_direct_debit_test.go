@@ -0,0 +1,426 @@
+package debits
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomMandate(debtorAccount, creditorAccount db.Account) db.Mandate {
+	return db.Mandate{
+		ID:                util.RandomInt(1, 1000),
+		Debtor:            debtorAccount.Owner,
+		DebtorAccountID:   debtorAccount.ID,
+		Creditor:          creditorAccount.Owner,
+		CreditorAccountID: creditorAccount.ID,
+		MaxAmount:         100,
+		Currency:          debtorAccount.Currency,
+		Frequency:         util.FrequencyMonthly,
+		Reference:         pgtype.Text{String: util.RandomString(8), Valid: true},
+		Status:            util.MandateActive,
+		CreatedAt:         time.Now(),
+	}
+}
+
+func TestCollectDirectDebit(t *testing.T) {
+	debtor, _ := testutil.RandomUser(t)
+	creditor, _ := testutil.RandomUser(t)
+	debtorAccount := testutil.RandomAccount(debtor.Username)
+	creditorAccount := testutil.RandomAccount(creditor.Username)
+	mandate := randomMandate(debtorAccount, creditorAccount)
+
+	amount := int64(50)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CollectDirectDebitRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CollectDirectDebitResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				arg := db.CollectDirectDebitTxParams{
+					MandateID:   mandate.ID,
+					Amount:      amount,
+					Description: mandate.Reference.String,
+				}
+
+				transfer := testutil.RandomTransfer(debtorAccount.ID, creditorAccount.ID)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CollectDirectDebitTxResult{
+						Mandate:          mandate,
+						DirectDebit:      db.DirectDebit{ID: 1, MandateID: mandate.ID, TransferID: transfer.ID, Amount: amount, Status: util.DirectDebitCollected},
+						TransferTxResult: db.TransferTxResult{Transfer: transfer},
+					}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, amount, res.DirectDebit.Amount)
+				require.Equal(t, pb.DirectDebitStatus_DIRECT_DEBIT_STATUS_COLLECTED, res.DirectDebit.Status)
+			},
+		},
+		{
+			"NotCreditor",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, debtor.Username, debtor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"ServiceClientNotAllowedAccount",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+				return core.ContextWithServiceClient(ctx, &db.ServiceClient{Owner: creditor.Username, AccountIds: []int64{creditorAccount.ID + 1}})
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"DebtorRestricted",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				restrictedDebtor := debtor
+				restrictedDebtor.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+					Times(1).
+					Return(restrictedDebtor, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"CreditorRestricted",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				restrictedCreditor := creditor
+				restrictedCreditor.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(creditor.Username)).
+					Times(1).
+					Return(restrictedCreditor, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"FraudRuleBlock",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Eq(db.GetTransferHistoryParams{Owner: debtor.Username, ToAccountID: creditorAccount.ID})).
+					Times(1).
+					Return(db.GetTransferHistoryRow{TransfersLastHour: 10, TransfersToPayee: 1}, nil)
+
+				store.EXPECT().
+					IsNewDeviceSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"AccountClosed",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectDirectDebitTxResult{}, fmt.Errorf("%w: %s", db.ErrAccountClosed, debtorAccount.Number))
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"AmountExceeded",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    mandate.MaxAmount + 1,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectDirectDebitTxResult{}, db.ErrMandateAmountExceeded)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"StepUpMissingCode",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    5000,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"CollectionTooSoon",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(mandate, nil)
+
+				store.EXPECT().
+					CollectDirectDebitTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectDirectDebitTxResult{}, db.ErrCollectionTooSoon)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"MandateNotFound",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    amount,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).
+					Times(1).
+					Return(db.Mandate{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			"InvalidAmount",
+			&pb.CollectDirectDebitRequest{
+				MandateId: mandate.ID,
+				Amount:    0,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetMandate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, creditor.Username, creditor.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CollectDirectDebitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// the debtor has paid the creditor before
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+				AnyTimes().
+				Return(debtor, nil)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(creditor.Username)).
+				AnyTimes().
+				Return(creditor, nil)
+
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(debtorAccount.ID)).
+				AnyTimes().
+				Return(debtorAccount, nil)
+
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(creditorAccount.ID)).
+				AnyTimes().
+				Return(creditorAccount, nil)
+
+			store.EXPECT().
+				GetTransferHistory(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(db.GetTransferHistoryRow{TransfersToPayee: 1}, nil)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewDirectDebitHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.CollectDirectDebit(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
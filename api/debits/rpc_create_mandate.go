@@ -0,0 +1,130 @@
+package debits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *DirectDebitHandler) CreateMandate(ctx context.Context, req *pb.CreateMandateRequest) (*pb.Mandate, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateCreateMandateRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	debtorAccount, err := h.getAccount(ctx, req.GetDebtorAccountId(), req.GetDebtorAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the owner can let others collect from an account
+	if debtorAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "debtor account does not belong to the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, debtorAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", debtorAccount.ID)
+	}
+
+	debtor, err := h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// The creditor can collect up to the maximum amount without the debtor, so granting it is a large payment
+	err = h.Server.CheckStepUp(ctx, debtor, req.GetMaxAmount(), req.MfaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	creditorAccount, err := h.getAccount(ctx, req.GetCreditorAccountId(), req.GetCreditorAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if debtorAccount.ID == creditorAccount.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "debtor and creditor accounts must be different")
+	}
+
+	if debtorAccount.Currency != creditorAccount.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "account [%s] currency mismatch: %s vs %s", creditorAccount.Number, creditorAccount.Currency, debtorAccount.Currency)
+	}
+
+	mandate, err := h.Server.Store.CreateMandate(ctx, db.CreateMandateParams{
+		Debtor:            authPayload.Username,
+		DebtorAccountID:   debtorAccount.ID,
+		Creditor:          creditorAccount.Owner,
+		CreditorAccountID: creditorAccount.ID,
+		MaxAmount:         req.GetMaxAmount(),
+		Currency:          debtorAccount.Currency,
+		Frequency:         db.MandateFrequencyFromRequest(req.GetFrequency()),
+		Reference: pgtype.Text{
+			String: req.GetReference(),
+			Valid:  req.Reference != nil,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create mandate: %v", err)
+	}
+
+	return mandate.ToResponse(), nil
+}
+
+func (h *DirectDebitHandler) getAccount(ctx context.Context, accountId int64, accountNumber string) (db.Account, error) {
+	account, err := core.GetAccount(ctx, h.Server.Store, accountId, accountNumber)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return account, status.Errorf(codes.NotFound, "account not found")
+		}
+
+		return account, status.Errorf(codes.Internal, "failed to fetch account details")
+	}
+
+	return account, nil
+}
+
+func validateCreateMandateRequest(req *pb.CreateMandateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("debtor_account_id", req.GetDebtorAccountId(), "debtor_account_number", req.GetDebtorAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if violation := core.ValidateAccountReference("creditor_account_id", req.GetCreditorAccountId(), "creditor_account_number", req.GetCreditorAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if err := val.ValidateAmount(req.GetMaxAmount()); err != nil {
+		violations = append(violations, core.FieldViolation("max_amount", err))
+	}
+
+	if req.GetFrequency() == pb.MandateFrequency_MANDATE_FREQUENCY_UNSPECIFIED {
+		violations = append(violations, core.FieldViolation("frequency", fmt.Errorf("must be specified")))
+	}
+
+	if req.Reference != nil {
+		if err := val.ValidateDescription(req.GetReference()); err != nil {
+			violations = append(violations, core.FieldViolation("reference", err))
+		}
+	}
+
+	if req.MfaCode != nil {
+		if err := val.ValidateTOTPCode(req.GetMfaCode()); err != nil {
+			violations = append(violations, core.FieldViolation("mfa_code", err))
+		}
+	}
+
+	return violations
+}
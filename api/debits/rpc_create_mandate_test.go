@@ -0,0 +1,148 @@
+package debits
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	"simplebank/pb"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateMandate(t *testing.T) {
+	debtor, _ := testutil.RandomUser(t)
+	creditor, _ := testutil.RandomUser(t)
+	debtorAccount := testutil.RandomAccount(debtor.Username)
+	creditorAccount := testutil.RandomAccount(creditor.Username)
+	creditorAccount.Currency = debtorAccount.Currency
+
+	req := &pb.CreateMandateRequest{
+		DebtorAccountId:   debtorAccount.ID,
+		CreditorAccountId: creditorAccount.ID,
+		MaxAmount:         100,
+		Frequency:         pb.MandateFrequency_MANDATE_FREQUENCY_MONTHLY,
+	}
+
+	largeReq := &pb.CreateMandateRequest{
+		DebtorAccountId:   debtorAccount.ID,
+		CreditorAccountId: creditorAccount.ID,
+		MaxAmount:         5000,
+		Frequency:         pb.MandateFrequency_MANDATE_FREQUENCY_MONTHLY,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateMandateRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.Mandate, err error)
+	}{
+		{
+			"OK",
+			req,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+					Times(1).
+					Return(debtor, nil)
+
+				store.EXPECT().
+					CreateMandate(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(randomMandate(debtorAccount, creditorAccount), nil)
+			},
+			func(t *testing.T, res *pb.Mandate, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"DebtorRestricted",
+			req,
+			func(store *mockdb.MockStore) {
+				restrictedDebtor := debtor
+				restrictedDebtor.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+					Times(1).
+					Return(restrictedDebtor, nil)
+
+				store.EXPECT().
+					CreateMandate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.Mandate, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"EmailNotVerified",
+			req,
+			func(store *mockdb.MockStore) {
+				unverifiedDebtor := debtor
+				unverifiedDebtor.IsEmailVerified = false
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+					Times(1).
+					Return(unverifiedDebtor, nil)
+
+				store.EXPECT().
+					CreateMandate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.Mandate, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"StepUpMissingCode",
+			largeReq,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(debtor.Username)).
+					Times(1).
+					Return(debtor, nil)
+
+				store.EXPECT().
+					CreateMandate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.Mandate, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(debtorAccount.ID)).
+				AnyTimes().
+				Return(debtorAccount, nil)
+
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(creditorAccount.ID)).
+				AnyTimes().
+				Return(creditorAccount, nil)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewDirectDebitHandler(coreServer)
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, debtor.Username, debtor.Role, time.Minute)
+
+			res, err := handler.CreateMandate(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package debits
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *DirectDebitHandler) ListDirectDebits(ctx context.Context, req *pb.ListDirectDebitsRequest) (*pb.ListDirectDebitsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateListDirectDebitsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	mandate, err := h.getMandate(ctx, req.GetMandateId())
+	if err != nil {
+		return nil, err
+	}
+
+	if mandate.Debtor != authPayload.Username && mandate.Creditor != authPayload.Username && !core.HasPermission(ctx, util.DebitsReadAnyPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "mandate does not belong to the authenticated user")
+	}
+
+	// A restricted client needs the account of the side of the mandate its owner is on
+	if !core.CanAccessAccount(ctx, mandate.DebtorAccountID) && !core.CanAccessAccount(ctx, mandate.CreditorAccountID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use the accounts of mandate %d", mandate.ID)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListDirectDebitsParams{
+		MandateID: mandate.ID,
+		PageSize:  int32(pageSize),
+	}
+
+	// The cursor is the id of the last direct debit of the previous page, direct debits are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	directDebits, err := h.Server.Store.ListDirectDebits(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list direct debits: %v", err)
+	}
+
+	response := &pb.ListDirectDebitsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(directDebits)),
+		},
+		Data: make([]*pb.DirectDebit, len(directDebits)),
+	}
+
+	for i, directDebit := range directDebits {
+		response.Data[i] = directDebit.ToResponse()
+	}
+
+	if int64(len(directDebits)) == pageSize {
+		next := strconv.FormatInt(directDebits[len(directDebits)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListDirectDebitsRequest(req *pb.ListDirectDebitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetMandateId()); err != nil {
+		violations = append(violations, core.FieldViolation("mandate_id", err))
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
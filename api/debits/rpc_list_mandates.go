@@ -0,0 +1,95 @@
+package debits
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *DirectDebitHandler) ListMandates(ctx context.Context, req *pb.ListMandatesRequest) (*pb.ListMandatesResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateListMandatesRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	username := authPayload.Username
+	if req.Username != nil {
+		username = req.GetUsername()
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// A client restricted to some accounts of the user only sees the mandates on those
+	arg := db.ListMandatesParams{
+		Username:   username,
+		AccountIds: core.AccessibleAccountIDs(ctx),
+		PageSize:   int32(pageSize),
+	}
+
+	// The cursor is the id of the last mandate of the previous page, mandates are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	// Find mandates the user has granted or can collect on
+	mandates, err := h.Server.Store.ListMandates(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list mandates: %v", err)
+	}
+
+	response := &pb.ListMandatesResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(mandates)),
+		},
+		Data: make([]*pb.Mandate, len(mandates)),
+	}
+
+	for i, mandate := range mandates {
+		response.Data[i] = mandate.ToResponse()
+	}
+
+	if int64(len(mandates)) == pageSize {
+		next := strconv.FormatInt(mandates[len(mandates)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListMandatesRequest(req *pb.ListMandatesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Username != nil {
+		if err := val.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, core.FieldViolation("username", err))
+		}
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
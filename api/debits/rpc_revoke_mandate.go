@@ -0,0 +1,59 @@
+package debits
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *DirectDebitHandler) RevokeMandate(ctx context.Context, req *pb.RevokeMandateRequest) (*pb.Mandate, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateRevokeMandateRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	mandate, err := h.getMandate(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the customer who granted the mandate can revoke it
+	if mandate.Debtor != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "mandate was not granted by the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, mandate.DebtorAccountID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", mandate.DebtorAccountID)
+	}
+
+	mandate, err = h.Server.Store.RevokeMandate(ctx, mandate.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrMandateNotActive)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to revoke mandate: %v", err)
+	}
+
+	return mandate.ToResponse(), nil
+}
+
+func validateRevokeMandateRequest(req *pb.RevokeMandateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
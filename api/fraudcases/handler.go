@@ -0,0 +1,19 @@
+package fraudcases
+
+import "simplebank/api/core"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// FraudCaseHandler serves the queue of transfers held back by the fraud rules.
+type FraudCaseHandler struct {
+	Server *core.Server
+}
+
+func NewFraudCaseHandler(server *core.Server) *FraudCaseHandler {
+	return &FraudCaseHandler{
+		Server: server,
+	}
+}
@@ -0,0 +1,88 @@
+package fraudcases
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *FraudCaseHandler) ListFraudCases(ctx context.Context, req *pb.ListFraudCasesRequest) (*pb.ListFraudCasesResponse, error) {
+	violations := validateListFraudCasesRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListFraudCasesParams{
+		Status:   util.FraudCaseOpen,
+		PageSize: int32(pageSize),
+	}
+
+	if req.Status != nil {
+		arg.Status = req.GetStatus()
+	}
+
+	// The cursor is the id of the last case of the previous page, cases are listed oldest first
+	if req.GetCursor() != "" {
+		afterID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.AfterID = pgtype.Int8{Int64: afterID, Valid: true}
+	}
+
+	fraudCases, err := h.Server.Store.ListFraudCases(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fraud cases: %v", err)
+	}
+
+	response := &pb.ListFraudCasesResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(fraudCases)),
+		},
+		Data: make([]*pb.FraudCase, len(fraudCases)),
+	}
+
+	for i, fraudCase := range fraudCases {
+		response.Data[i] = fraudCase.ToResponse()
+	}
+
+	if int64(len(fraudCases)) == pageSize {
+		next := strconv.FormatInt(fraudCases[len(fraudCases)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListFraudCasesRequest(req *pb.ListFraudCasesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Status != nil {
+		switch req.GetStatus() {
+		case util.FraudCaseOpen, util.FraudCaseApproved, util.FraudCaseRejected:
+		default:
+			violations = append(violations, core.FieldViolation("status", fmt.Errorf("must be %s, %s or %s", util.FraudCaseOpen, util.FraudCaseApproved, util.FraudCaseRejected)))
+		}
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
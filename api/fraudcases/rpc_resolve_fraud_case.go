@@ -0,0 +1,117 @@
+package fraudcases
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveFraudCase approves or rejects a transfer held back by the fraud rules, approving it makes the transfer.
+func (h *FraudCaseHandler) ResolveFraudCase(ctx context.Context, req *pb.ResolveFraudCaseRequest) (*pb.ResolveFraudCaseResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateResolveFraudCaseRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	fraudCase, err := h.Server.Store.GetFraudCase(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "fraud case not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get fraud case: %v", err)
+	}
+
+	if fraudCase.Username == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot resolve your own fraud case")
+	}
+
+	if fraudCase.Status != util.FraudCaseOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "fraud case is %s", fraudCase.Status)
+	}
+
+	// The accounts may have been closed or frozen while the transfer was held back
+	if req.GetApprove() {
+		for _, accountID := range []int64{fraudCase.FromAccountID, fraudCase.ToAccountID} {
+			err = h.checkAccountUsable(ctx, accountID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	arg := db.ResolveFraudCaseTxParams{
+		ID:         fraudCase.ID,
+		Approve:    req.GetApprove(),
+		ResolvedBy: authPayload.Username,
+	}
+
+	if req.Note != nil {
+		arg.Note = pgtype.Text{String: req.GetNote(), Valid: true}
+	}
+
+	result, err := h.Server.Store.ResolveFraudCaseTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrFraudCaseNotOpen) || errors.Is(err, db.ErrAccountClosed) || errors.Is(err, db.ErrAccountFrozen) ||
+			errors.Is(err, db.ErrKycLimitExceeded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to resolve fraud case: %v", err)
+	}
+
+	response := &pb.ResolveFraudCaseResponse{
+		FraudCase: result.FraudCase.ToResponse(),
+	}
+
+	if req.GetApprove() {
+		response.Transfer = result.Transfer.ToResponse()
+	}
+
+	return response, nil
+}
+
+func (h *FraudCaseHandler) checkAccountUsable(ctx context.Context, accountID int64) error {
+	account, err := h.Server.Store.GetAccount(ctx, accountID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch account details: %v", err)
+	}
+
+	if account.ClosedAt.Valid {
+		return status.Errorf(codes.FailedPrecondition, "account [%s] is closed", account.Number)
+	}
+
+	if account.FrozenAt.Valid {
+		return status.Errorf(codes.FailedPrecondition, "account [%s] is frozen", account.Number)
+	}
+
+	return nil
+}
+
+func validateResolveFraudCaseRequest(req *pb.ResolveFraudCaseRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	if req.Note != nil {
+		if err := val.ValidateString(req.GetNote(), 1, 200); err != nil {
+			violations = append(violations, core.FieldViolation("note", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,277 @@
+package fraudcases
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResolveFraudCase(t *testing.T) {
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	customer, _ := testutil.RandomUser(t)
+	fromAccount := testutil.RandomAccount(customer.Username)
+	toAccount := testutil.RandomAccount(util.RandomOwner())
+
+	frozenAccount := toAccount
+	frozenAccount.FrozenAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	fraudCase := db.FraudCase{
+		ID:            util.RandomInt(1, 1000),
+		Username:      customer.Username,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        util.RandomMoney(),
+		Rule:          "unusual_amount_new_payee",
+		Features:      []byte(`{"amount":100,"new_payee":true}`),
+		Status:        util.FraudCaseOpen,
+		CreatedAt:     time.Now(),
+	}
+
+	resolvedCase := func(arg db.ResolveFraudCaseTxParams) db.FraudCase {
+		resolved := fraudCase
+		resolved.Status = util.FraudCaseRejected
+		resolved.ResolvedBy = pgtype.Text{String: arg.ResolvedBy, Valid: true}
+		resolved.ResolutionNote = arg.Note
+		resolved.ResolvedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		return resolved
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ResolveFraudCaseRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error)
+	}{
+		{
+			"Approve",
+			&pb.ResolveFraudCaseRequest{
+				Id:      fraudCase.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(fraudCase, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+
+				store.EXPECT().
+					ResolveFraudCaseTx(gomock.Any(), gomock.Eq(db.ResolveFraudCaseTxParams{
+						ID:         fraudCase.ID,
+						Approve:    true,
+						ResolvedBy: banker.Username,
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ResolveFraudCaseTxParams) (db.ResolveFraudCaseTxResult, error) {
+						resolved := resolvedCase(arg)
+						resolved.Status = util.FraudCaseApproved
+						resolved.TransferID = pgtype.Int8{Int64: 42, Valid: true}
+
+						return db.ResolveFraudCaseTxResult{
+							FraudCase: resolved,
+							TransferTxResult: db.TransferTxResult{
+								Transfer: db.Transfer{ID: 42, FromAccountID: fromAccount.ID, ToAccountID: toAccount.ID, Amount: fraudCase.Amount},
+							},
+						}, nil
+					})
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.FraudCaseApproved, res.GetFraudCase().GetStatus())
+				require.Equal(t, banker.Username, res.GetFraudCase().GetResolvedBy())
+				require.Equal(t, int64(42), res.GetFraudCase().GetTransferId())
+				require.Equal(t, int64(42), res.GetTransfer().GetId())
+				require.True(t, res.GetFraudCase().GetFeatures().GetFields()["new_payee"].GetBoolValue())
+			},
+		},
+		{
+			"Reject",
+			&pb.ResolveFraudCaseRequest{
+				Id:   fraudCase.ID,
+				Note: proto.String("confirmed with the customer that they did not send it"),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(fraudCase, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					ResolveFraudCaseTx(gomock.Any(), gomock.Cond(func(arg db.ResolveFraudCaseTxParams) bool {
+						return !arg.Approve && arg.Note.String == "confirmed with the customer that they did not send it"
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ResolveFraudCaseTxParams) (db.ResolveFraudCaseTxResult, error) {
+						return db.ResolveFraudCaseTxResult{FraudCase: resolvedCase(arg)}, nil
+					})
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.FraudCaseRejected, res.GetFraudCase().GetStatus())
+				require.Nil(t, res.GetTransfer())
+			},
+		},
+		{
+			"OwnCase",
+			&pb.ResolveFraudCaseRequest{
+				Id:      fraudCase.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(fraudCase, nil)
+
+				store.EXPECT().
+					ResolveFraudCaseTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, customer.Username, util.BankerRole, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"AccountFrozen",
+			&pb.ResolveFraudCaseRequest{
+				Id:      fraudCase.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(fraudCase, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					ResolveFraudCaseTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"AlreadyResolved",
+			&pb.ResolveFraudCaseRequest{
+				Id: fraudCase.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(fraudCase, nil)
+
+				store.EXPECT().
+					ResolveFraudCaseTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveFraudCaseTxResult{}, db.ErrFraudCaseNotOpen)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"NotFound",
+			&pb.ResolveFraudCaseRequest{
+				Id: fraudCase.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetFraudCase(gomock.Any(), gomock.Eq(fraudCase.ID)).
+					Times(1).
+					Return(db.FraudCase{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveFraudCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewFraudCaseHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.ResolveFraudCase(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package kyc
+
+import "simplebank/api/core"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// maxDocumentSize keeps uploads well below the default gRPC message size limit of 4 MiB
+	maxDocumentSize = 3 << 20
+)
+
+// documentContentTypes are the kinds of files accepted as documents, detected from their content.
+var documentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": true,
+}
+
+type KycHandler struct {
+	Server *core.Server
+}
+
+func NewKycHandler(server *core.Server) *KycHandler {
+	return &KycHandler{
+		Server: server,
+	}
+}
@@ -0,0 +1,55 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	"simplebank/blob"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *KycHandler) DownloadKycDocument(ctx context.Context, req *pb.DownloadKycDocumentRequest) (*pb.DownloadKycDocumentResponse, error) {
+	violations := validateDownloadKycDocumentRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	document, err := h.Server.Store.GetKycDocument(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "document not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get document: %v", err)
+	}
+
+	content, err := h.Server.BlobStore.Get(ctx, document.BlobKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "document content not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to read document: %v", err)
+	}
+
+	response := &pb.DownloadKycDocumentResponse{
+		Document: document.ToResponse(),
+		Content:  content,
+	}
+
+	return response, nil
+}
+
+func validateDownloadKycDocumentRequest(req *pb.DownloadKycDocumentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
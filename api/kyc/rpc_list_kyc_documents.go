@@ -0,0 +1,42 @@
+package kyc
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *KycHandler) ListKycDocuments(ctx context.Context, req *pb.ListKycDocumentsRequest) (*pb.ListKycDocumentsResponse, error) {
+	violations := validateListKycDocumentsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	documents, err := h.Server.Store.ListKycDocumentsByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list documents: %v", err)
+	}
+
+	response := &pb.ListKycDocumentsResponse{
+		Data: make([]*pb.KycDocument, len(documents)),
+	}
+
+	for i, document := range documents {
+		response.Data[i] = document.ToResponse()
+	}
+
+	return response, nil
+}
+
+func validateListKycDocumentsRequest(req *pb.ListKycDocumentsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
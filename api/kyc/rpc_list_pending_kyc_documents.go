@@ -0,0 +1,74 @@
+package kyc
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *KycHandler) ListPendingKycDocuments(ctx context.Context, req *pb.ListPendingKycDocumentsRequest) (*pb.ListPendingKycDocumentsResponse, error) {
+	violations := validateListPendingKycDocumentsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListPendingKycDocumentsParams{
+		PageSize: int32(pageSize),
+	}
+
+	// The cursor is the id of the last document of the previous page, documents are listed oldest first
+	if req.GetCursor() != "" {
+		afterID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.AfterID = pgtype.Int8{Int64: afterID, Valid: true}
+	}
+
+	documents, err := h.Server.Store.ListPendingKycDocuments(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list documents: %v", err)
+	}
+
+	response := &pb.ListPendingKycDocumentsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(documents)),
+		},
+		Data: make([]*pb.KycDocument, len(documents)),
+	}
+
+	for i, document := range documents {
+		response.Data[i] = document.ToResponse()
+	}
+
+	if int64(len(documents)) == pageSize {
+		next := strconv.FormatInt(documents[len(documents)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListPendingKycDocumentsRequest(req *pb.ListPendingKycDocumentsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
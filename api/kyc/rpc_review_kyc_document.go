@@ -0,0 +1,103 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"simplebank/worker"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReviewKycDocument approves or rejects a pending document, updating the KYC tier of its owner and emailing them the outcome.
+func (h *KycHandler) ReviewKycDocument(ctx context.Context, req *pb.ReviewKycDocumentRequest) (*pb.ReviewKycDocumentResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateReviewKycDocumentRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	document, err := h.Server.Store.GetKycDocument(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "document not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get document: %v", err)
+	}
+
+	if document.Username == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot review your own document")
+	}
+
+	arg := db.ReviewKycDocumentTxParams{
+		ReviewKycDocumentParams: db.ReviewKycDocumentParams{
+			ID:         document.ID,
+			Status:     util.KycDocumentApproved,
+			ReviewedBy: pgtype.Text{String: authPayload.Username, Valid: true},
+		},
+		AfterReview: func(document db.KycDocument) error {
+			taskPayload := &worker.PayloadSendKycStatusEmail{
+				DocumentID: document.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.ProcessIn(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+
+			return h.Server.TaskDistributor.DistributeSendKycStatusEmailTask(ctx, taskPayload, opts...)
+		},
+	}
+
+	if !req.GetApprove() {
+		arg.Status = util.KycDocumentRejected
+		arg.RejectionReason = pgtype.Text{String: req.GetReason(), Valid: true}
+	}
+
+	result, err := h.Server.Store.ReviewKycDocumentTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrKycDocumentNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "document has already been reviewed")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to review document: %v", err)
+	}
+
+	response := &pb.ReviewKycDocumentResponse{
+		Document: result.Document.ToResponse(),
+		User:     result.User.ToResponse(),
+	}
+
+	return response, nil
+}
+
+func validateReviewKycDocumentRequest(req *pb.ReviewKycDocumentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	if !req.GetApprove() {
+		if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+			violations = append(violations, core.FieldViolation("reason", fmt.Errorf("is required when rejecting: %w", err)))
+		}
+	} else if req.Reason != nil {
+		violations = append(violations, core.FieldViolation("reason", fmt.Errorf("can only be given when rejecting")))
+	}
+
+	return violations
+}
@@ -0,0 +1,255 @@
+package kyc
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReviewKycDocument(t *testing.T) {
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	customer, _ := testutil.RandomUser(t)
+	customer.KycTier = 0
+	customer.KycStatus = util.KycStatusPendingReview
+
+	document := db.KycDocument{
+		ID:          util.RandomInt(1, 1000),
+		Username:    customer.Username,
+		Kind:        util.KycDocumentIdentity,
+		BlobKey:     "kyc/" + customer.Username + "/passport",
+		ContentType: "image/png",
+		Size:        1024,
+		Status:      util.KycDocumentPending,
+		CreatedAt:   time.Now(),
+	}
+
+	// reviewTx runs the AfterReview callback like the store does and returns the reviewed document
+	reviewTx := func(_ context.Context, arg db.ReviewKycDocumentTxParams) (db.ReviewKycDocumentTxResult, error) {
+		reviewed := document
+		reviewed.Status = arg.Status
+		reviewed.RejectionReason = arg.RejectionReason
+		reviewed.ReviewedBy = arg.ReviewedBy
+		reviewed.ReviewedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		user := customer
+		user.KycTier, user.KycStatus = db.KycState([]db.KycDocument{reviewed})
+
+		return db.ReviewKycDocumentTxResult{Document: reviewed, User: user}, arg.AfterReview(reviewed)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReviewKycDocumentRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error)
+	}{
+		{
+			"Approve",
+			&pb.ReviewKycDocumentRequest{
+				Id:      document.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetKycDocument(gomock.Any(), gomock.Eq(document.ID)).
+					Times(1).
+					Return(document, nil)
+
+				store.EXPECT().
+					ReviewKycDocumentTx(gomock.Any(), gomock.Cond(func(arg db.ReviewKycDocumentTxParams) bool {
+						return arg.ID == document.ID &&
+							arg.Status == util.KycDocumentApproved &&
+							!arg.RejectionReason.Valid &&
+							arg.ReviewedBy.String == banker.Username
+					})).
+					Times(1).
+					DoAndReturn(reviewTx)
+
+				distributor.EXPECT().
+					DistributeSendKycStatusEmailTask(gomock.Any(), gomock.Eq(&worker.PayloadSendKycStatusEmail{DocumentID: document.ID}), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KycDocumentApproved, res.GetDocument().GetStatus())
+				require.Equal(t, banker.Username, res.GetDocument().GetReviewedBy())
+				require.Equal(t, int32(1), res.GetUser().GetKycTier())
+				require.Equal(t, util.KycStatusApproved, res.GetUser().GetKycStatus())
+			},
+		},
+		{
+			"Reject",
+			&pb.ReviewKycDocumentRequest{
+				Id:     document.ID,
+				Reason: proto.String("the photo is blurry"),
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetKycDocument(gomock.Any(), gomock.Eq(document.ID)).
+					Times(1).
+					Return(document, nil)
+
+				store.EXPECT().
+					ReviewKycDocumentTx(gomock.Any(), gomock.Cond(func(arg db.ReviewKycDocumentTxParams) bool {
+						return arg.Status == util.KycDocumentRejected && arg.RejectionReason.String == "the photo is blurry"
+					})).
+					Times(1).
+					DoAndReturn(reviewTx)
+
+				distributor.EXPECT().
+					DistributeSendKycStatusEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KycDocumentRejected, res.GetDocument().GetStatus())
+				require.Equal(t, "the photo is blurry", res.GetDocument().GetRejectionReason())
+				require.Equal(t, int32(0), res.GetUser().GetKycTier())
+				require.Equal(t, util.KycStatusRejected, res.GetUser().GetKycStatus())
+			},
+		},
+		{
+			"RejectWithoutReason",
+			&pb.ReviewKycDocumentRequest{
+				Id: document.ID,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ReviewKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"OwnDocument",
+			&pb.ReviewKycDocumentRequest{
+				Id:      document.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetKycDocument(gomock.Any(), gomock.Eq(document.ID)).
+					Times(1).
+					Return(document, nil)
+
+				store.EXPECT().
+					ReviewKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, customer.Username, util.BankerRole, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"AlreadyReviewed",
+			&pb.ReviewKycDocumentRequest{
+				Id:      document.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetKycDocument(gomock.Any(), gomock.Eq(document.ID)).
+					Times(1).
+					Return(document, nil)
+
+				store.EXPECT().
+					ReviewKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReviewKycDocumentTxResult{}, db.ErrKycDocumentNotPending)
+
+				distributor.EXPECT().
+					DistributeSendKycStatusEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"NotFound",
+			&pb.ReviewKycDocumentRequest{
+				Id:      document.ID,
+				Approve: true,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetKycDocument(gomock.Any(), gomock.Eq(document.ID)).
+					Times(1).
+					Return(db.KycDocument{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ReviewKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			distributor := mockwk.NewMockTaskDistributor(ctrl)
+			tc.buildStubs(store, distributor)
+
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			handler := NewKycHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.ReviewKycDocument(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
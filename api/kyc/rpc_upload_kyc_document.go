@@ -0,0 +1,90 @@
+package kyc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *KycHandler) UploadKycDocument(ctx context.Context, req *pb.UploadKycDocumentRequest) (*pb.UploadKycDocumentResponse, error) {
+	violations := validateUploadKycDocumentRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	content := req.GetContent()
+	checksum := sha256.Sum256(content)
+	key := fmt.Sprintf("kyc/%s/%s", req.GetUsername(), uuid.New())
+
+	err := h.Server.BlobStore.Put(ctx, key, content)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store document: %v", err)
+	}
+
+	arg := db.UploadKycDocumentTxParams{
+		CreateKycDocumentParams: db.CreateKycDocumentParams{
+			Username:    req.GetUsername(),
+			Kind:        req.GetKind(),
+			BlobKey:     key,
+			ContentType: http.DetectContentType(content),
+			Size:        int64(len(content)),
+			Sha256:      hex.EncodeToString(checksum[:]),
+		},
+	}
+
+	result, err := h.Server.Store.UploadKycDocumentTx(ctx, arg)
+	if err != nil {
+		if deleteErr := h.Server.BlobStore.Delete(ctx, key); deleteErr != nil {
+			log.Error().Err(deleteErr).Str("key", key).Msg("failed to delete document of failed upload")
+		}
+
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "a %s document is already waiting for review", req.GetKind())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to upload document: %v", err)
+	}
+
+	response := &pb.UploadKycDocumentResponse{
+		Document: result.Document.ToResponse(),
+		User:     result.User.ToResponse(),
+	}
+
+	return response, nil
+}
+
+func validateUploadKycDocumentRequest(req *pb.UploadKycDocumentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if !util.IsSupportedKycDocumentKind(req.GetKind()) {
+		violations = append(violations, core.FieldViolation("kind", fmt.Errorf("must be %s or %s", util.KycDocumentIdentity, util.KycDocumentProofOfAddress)))
+	}
+
+	content := req.GetContent()
+	switch {
+	case len(content) == 0:
+		violations = append(violations, core.FieldViolation("content", fmt.Errorf("must not be empty")))
+	case len(content) > maxDocumentSize:
+		violations = append(violations, core.FieldViolation("content", fmt.Errorf("must be at most %d MiB", maxDocumentSize>>20)))
+	case !documentContentTypes[http.DetectContentType(content)]:
+		violations = append(violations, core.FieldViolation("content", fmt.Errorf("must be a JPEG, PNG or PDF file")))
+	}
+
+	return violations
+}
@@ -0,0 +1,188 @@
+package kyc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"simplebank/api/testutil"
+	"simplebank/blob"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadKycDocument(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	content := []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj")
+	checksum := sha256.Sum256(content)
+
+	// the key the handler stored the content under, as passed to the store
+	var blobKey string
+
+	testCases := []struct {
+		name          string
+		req           *pb.UploadKycDocumentRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, server *KycHandler, res *pb.UploadKycDocumentResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.UploadKycDocumentRequest{
+				Username: user.Username,
+				Kind:     util.KycDocumentIdentity,
+				Content:  content,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UploadKycDocumentTx(gomock.Any(), gomock.Cond(func(arg db.UploadKycDocumentTxParams) bool {
+						return arg.Username == user.Username &&
+							arg.Kind == util.KycDocumentIdentity &&
+							arg.ContentType == "application/pdf" &&
+							arg.Size == int64(len(content)) &&
+							arg.Sha256 == hex.EncodeToString(checksum[:]) &&
+							strings.HasPrefix(arg.BlobKey, "kyc/"+user.Username+"/")
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.UploadKycDocumentTxParams) (db.UploadKycDocumentTxResult, error) {
+						blobKey = arg.BlobKey
+						reviewedUser := user
+						reviewedUser.KycStatus = util.KycStatusPendingReview
+
+						return db.UploadKycDocumentTxResult{
+							Document: db.KycDocument{
+								ID:          1,
+								Username:    arg.Username,
+								Kind:        arg.Kind,
+								BlobKey:     arg.BlobKey,
+								ContentType: arg.ContentType,
+								Size:        arg.Size,
+								Sha256:      arg.Sha256,
+								Status:      util.KycDocumentPending,
+								CreatedAt:   time.Now(),
+							},
+							User: reviewedUser,
+						}, nil
+					})
+			},
+			func(t *testing.T, handler *KycHandler, res *pb.UploadKycDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KycDocumentPending, res.GetDocument().GetStatus())
+				require.Equal(t, util.KycStatusPendingReview, res.GetUser().GetKycStatus())
+
+				stored, err := handler.Server.BlobStore.Get(context.Background(), blobKey)
+				require.NoError(t, err)
+				require.Equal(t, content, stored)
+			},
+		},
+		{
+			"AlreadyPending",
+			&pb.UploadKycDocumentRequest{
+				Username: user.Username,
+				Kind:     util.KycDocumentProofOfAddress,
+				Content:  content,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UploadKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.UploadKycDocumentTxParams) (db.UploadKycDocumentTxResult, error) {
+						blobKey = arg.BlobKey
+
+						return db.UploadKycDocumentTxResult{}, db.ErrRecordNotFound
+					})
+			},
+			func(t *testing.T, handler *KycHandler, res *pb.UploadKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+
+				// the stored content is removed when the upload is rejected
+				_, err = handler.Server.BlobStore.Get(context.Background(), blobKey)
+				require.ErrorIs(t, err, blob.ErrNotFound)
+			},
+		},
+		{
+			"UnsupportedContent",
+			&pb.UploadKycDocumentRequest{
+				Username: user.Username,
+				Kind:     util.KycDocumentIdentity,
+				Content:  []byte("#!/bin/sh\necho hello"),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UploadKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, handler *KycHandler, res *pb.UploadKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"TooLarge",
+			&pb.UploadKycDocumentRequest{
+				Username: user.Username,
+				Kind:     util.KycDocumentIdentity,
+				Content:  append(content, make([]byte, maxDocumentSize)...),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UploadKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, handler *KycHandler, res *pb.UploadKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"InvalidKind",
+			&pb.UploadKycDocumentRequest{
+				Username: user.Username,
+				Kind:     "selfie",
+				Content:  content,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UploadKycDocumentTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, handler *KycHandler, res *pb.UploadKycDocumentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewKycHandler(coreServer)
+
+			res, err := handler.UploadKycDocument(context.Background(), tc.req)
+			tc.checkResponse(t, handler, res, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package payments
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/worker"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// paymentLinkPurpose scopes signed link tokens to payment requests
+	paymentLinkPurpose = "payment_request"
+)
+
+type PaymentHandler struct {
+	Server *core.Server
+}
+
+func NewPaymentHandler(server *core.Server) *PaymentHandler {
+	return &PaymentHandler{
+		Server: server,
+	}
+}
+
+// distributePaymentRequestEmail notifies the payer of a payment request by email.
+func (h *PaymentHandler) distributePaymentRequestEmail(ctx context.Context, paymentRequestId int64, reminder bool) error {
+	taskPayload := &worker.PayloadSendPaymentRequestEmail{
+		PaymentRequestID: paymentRequestId,
+		Reminder:         reminder,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.ProcessIn(10 * time.Second),
+		asynq.Queue(worker.QueueDefault),
+	}
+
+	return h.Server.TaskDistributor.DistributeSendPaymentRequestEmailTask(ctx, taskPayload, opts...)
+}
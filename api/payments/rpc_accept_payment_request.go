@@ -0,0 +1,157 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) AcceptPaymentRequest(ctx context.Context, req *pb.AcceptPaymentRequestRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateAcceptPaymentRequestRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	paymentRequestId, err := h.paymentRequestId(req.GetId(), req.GetLinkToken())
+	if err != nil {
+		return nil, err
+	}
+
+	paymentRequest, err := h.Server.Store.GetPaymentRequest(ctx, paymentRequestId)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "payment request not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get payment request: %v", err)
+	}
+
+	// Links can be paid by anyone holding them, direct requests only by the payer
+	if paymentRequest.IsLink {
+		if req.LinkToken == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "payment link token is required")
+		}
+	} else if paymentRequest.Payer.String != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "payment request is not addressed to the authenticated user")
+	}
+
+	if paymentRequest.Requester == authPayload.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot pay your own payment request")
+	}
+
+	if paymentRequest.EffectiveStatus() != util.PaymentRequestPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "payment request is %s", paymentRequest.EffectiveStatus())
+	}
+
+	fromAccount, err := core.GetAccount(ctx, h.Server.Store, req.GetFromAccountId(), req.GetFromAccountNumber())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account does not belong to the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, fromAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", fromAccount.ID)
+	}
+
+	if fromAccount.Currency != paymentRequest.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "account [%s] currency mismatch: %s vs %s", fromAccount.Number, fromAccount.Currency, paymentRequest.Currency)
+	}
+
+	payer, err := h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.Server.CheckStepUp(ctx, payer, paymentRequest.Amount, req.MfaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.checkPayment(ctx, authPayload, payer, &fromAccount, paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.Server.Store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		ID:            paymentRequest.ID,
+		Payer:         authPayload.Username,
+		FromAccountID: fromAccount.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrPaymentRequestNotPending) || errors.Is(err, db.ErrAccountClosed) || errors.Is(err, db.ErrAccountFrozen) ||
+			errors.Is(err, db.ErrKycLimitExceeded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to accept payment request: %v", err)
+	}
+
+	response := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: result.PaymentRequest.ToResponse(),
+		Transfer:       result.Transfer.ToResponse(),
+	}
+
+	return response, nil
+}
+
+// checkPayment screens the requester and runs the fraud rules on paying the request, bill splits are paid this
+// way too.
+func (h *PaymentHandler) checkPayment(ctx context.Context, authPayload *token.Payload, payer db.User, fromAccount *db.Account, paymentRequest db.PaymentRequest) error {
+	toAccount, err := h.Server.Store.GetAccount(ctx, paymentRequest.ToAccountID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to fetch account details: %v", err)
+	}
+
+	features, err := h.Server.TransferFeatures(ctx, authPayload, payer, fromAccount, &toAccount, paymentRequest.Amount)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get transfer history: %v", err)
+	}
+
+	err = h.Server.CheckPayee(ctx, &toAccount, features)
+	if err != nil {
+		return err
+	}
+
+	return h.Server.CheckFraudRules(features, fromAccount, &toAccount)
+}
+
+func validateAcceptPaymentRequestRequest(req *pb.AcceptPaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.LinkToken == nil {
+		if err := val.ValidateId(req.GetId()); err != nil {
+			violations = append(violations, core.FieldViolation("id", err))
+		}
+	}
+
+	if violation := core.ValidateAccountReference("from_account_id", req.GetFromAccountId(), "from_account_number", req.GetFromAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if req.MfaCode != nil {
+		if err := val.ValidateTOTPCode(req.GetMfaCode()); err != nil {
+			violations = append(violations, core.FieldViolation("mfa_code", err))
+		}
+	}
+
+	return violations
+}
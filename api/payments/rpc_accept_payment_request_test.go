@@ -0,0 +1,573 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAcceptPaymentRequest(t *testing.T) {
+	requester, _ := testutil.RandomUser(t)
+	payer, _ := testutil.RandomUser(t)
+	toAccount := testutil.RandomAccount(requester.Username)
+	fromAccount := testutil.RandomAccount(payer.Username)
+
+	toAccount.Currency = util.USD
+	fromAccount.Currency = util.USD
+
+	paymentRequest := testutil.RandomPaymentRequest(requester.Username, payer.Username, toAccount)
+	paymentLink := testutil.RandomPaymentRequest(requester.Username, "", toAccount)
+
+	expiredPaymentRequest := paymentRequest
+	expiredPaymentRequest.ExpiresAt = time.Now().Add(-time.Minute)
+
+	acceptedPaymentRequest := paymentRequest
+	acceptedPaymentRequest.Status = util.PaymentRequestAccepted
+
+	largePaymentRequest := paymentRequest
+	largePaymentRequest.Amount = 5000
+
+	testCases := []struct {
+		name          string
+		req           func(signer *token.Signer) *pb.AcceptPaymentRequestRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error)
+	}{
+		{
+			"OK",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				arg := db.AcceptPaymentRequestTxParams{
+					ID:            paymentRequest.ID,
+					Payer:         payer.Username,
+					FromAccountID: fromAccount.ID,
+				}
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{
+						PaymentRequest: acceptedPaymentRequest,
+						TransferTxResult: db.TransferTxResult{
+							Transfer: testutil.RandomTransfer(fromAccount.ID, toAccount.ID),
+						},
+					}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, pb.PaymentRequestStatus_PAYMENT_REQUEST_STATUS_ACCEPTED, res.PaymentRequest.Status)
+				require.NotNil(t, res.Transfer)
+			},
+		},
+		{
+			"OKLink",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				linkToken := signer.Sign(paymentLinkPurpose, paymentLink.ID, paymentLink.ExpiresAt)
+
+				return &pb.AcceptPaymentRequestRequest{
+					LinkToken:     &linkToken,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentLink.ID)).
+					Times(1).
+					Return(paymentLink, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				arg := db.AcceptPaymentRequestTxParams{
+					ID:            paymentLink.ID,
+					Payer:         payer.Username,
+					FromAccountID: fromAccount.ID,
+				}
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{PaymentRequest: paymentLink}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"InvalidLinkToken",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				linkToken := util.RandomString(32)
+
+				return &pb.AcceptPaymentRequestRequest{
+					LinkToken:     &linkToken,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"LinkWithoutToken",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentLink.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentLink.ID)).
+					Times(1).
+					Return(paymentLink, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"PayerRestricted",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				restrictedPayer := payer
+				restrictedPayer.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(payer.Username)).
+					Times(1).
+					Return(restrictedPayer, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"RequesterRestricted",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				restrictedRequester := requester
+				restrictedRequester.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(requester.Username)).
+					Times(1).
+					Return(restrictedRequester, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"FraudRuleBlock",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Eq(db.GetTransferHistoryParams{Owner: payer.Username, ToAccountID: toAccount.ID})).
+					Times(1).
+					Return(db.GetTransferHistoryRow{TransfersLastHour: 10, TransfersToPayee: 1}, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"FraudRuleReview",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				// far more than usual to someone never paid before
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetTransferHistoryRow{AverageAmount: 1}, nil)
+
+				store.EXPECT().
+					CreateFraudCase(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"AccountFrozen",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, fmt.Errorf("%w: %s", db.ErrAccountFrozen, toAccount.Number))
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"NotPayer",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, util.RandomOwner(), util.DepositorRole, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"StepUpMissingCode",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            largePaymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(largePaymentRequest.ID)).
+					Times(1).
+					Return(largePaymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"Expired",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            expiredPaymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(expiredPaymentRequest.ID)).
+					Times(1).
+					Return(expiredPaymentRequest, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ResolvedConcurrently",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrPaymentRequestNotPending)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"CurrencyMismatch",
+			func(signer *token.Signer) *pb.AcceptPaymentRequestRequest {
+				return &pb.AcceptPaymentRequestRequest{
+					Id:            paymentRequest.ID,
+					FromAccountId: fromAccount.ID,
+				}
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetPaymentRequest(gomock.Any(), gomock.Eq(paymentRequest.ID)).
+					Times(1).
+					Return(paymentRequest, nil)
+
+				eurAccount := fromAccount
+				eurAccount.Currency = util.EUR
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(eurAccount, nil)
+
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// the payer has paid the requester before from a device they used before
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(payer.Username)).
+				AnyTimes().
+				Return(payer, nil)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(requester.Username)).
+				AnyTimes().
+				Return(requester, nil)
+
+			store.EXPECT().
+				GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+				AnyTimes().
+				Return(toAccount, nil)
+
+			store.EXPECT().
+				GetTransferHistory(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(db.GetTransferHistoryRow{TransfersToPayee: 1}, nil)
+
+			store.EXPECT().
+				IsNewDeviceSession(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(pgtype.Bool{Bool: false, Valid: true}, nil)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewPaymentHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.AcceptPaymentRequest(ctx, tc.req(coreServer.Signer))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
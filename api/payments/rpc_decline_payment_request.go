@@ -0,0 +1,74 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeclinePaymentRequest declines a request addressed to the user, or cancels one the user has sent.
+func (h *PaymentHandler) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.PaymentRequest, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateDeclinePaymentRequestRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	paymentRequest, err := h.Server.Store.GetPaymentRequest(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "payment request not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get payment request: %v", err)
+	}
+
+	var newStatus string
+	switch {
+	case paymentRequest.Requester == authPayload.Username:
+		newStatus = util.PaymentRequestCancelled
+	case paymentRequest.Payer.Valid && paymentRequest.Payer.String == authPayload.Username:
+		newStatus = util.PaymentRequestDeclined
+	default:
+		return nil, status.Errorf(codes.PermissionDenied, "payment request does not belong to the authenticated user")
+	}
+
+	if paymentRequest.EffectiveStatus() != util.PaymentRequestPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "payment request is %s", paymentRequest.EffectiveStatus())
+	}
+
+	paymentRequest, err = h.Server.Store.ResolvePaymentRequest(ctx, db.ResolvePaymentRequestParams{
+		ID:     paymentRequest.ID,
+		Status: newStatus,
+	})
+	if err != nil {
+		// The request has been resolved concurrently
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrPaymentRequestNotPending)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to decline payment request: %v", err)
+	}
+
+	return paymentRequest.ToResponse(), nil
+}
+
+func validateDeclinePaymentRequestRequest(req *pb.DeclinePaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
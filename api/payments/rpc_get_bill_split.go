@@ -0,0 +1,73 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) GetBillSplit(ctx context.Context, req *pb.GetBillSplitRequest) (*pb.BillSplit, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateGetBillSplitRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	billSplit, paymentRequests, err := h.getBillSplit(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Participants can see the split they are part of
+	allowed := billSplit.Creator == authPayload.Username || core.HasPermission(ctx, util.PaymentsReadAnyPermission)
+	for _, paymentRequest := range paymentRequests {
+		if paymentRequest.Payer.String == authPayload.Username {
+			allowed = true
+		}
+	}
+
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "bill split does not belong to the authenticated user")
+	}
+
+	return billSplit.ToResponse(paymentRequests), nil
+}
+
+// getBillSplit fetches the bill split together with the payment requests of its participants.
+func (h *PaymentHandler) getBillSplit(ctx context.Context, id int64) (db.BillSplit, []db.PaymentRequest, error) {
+	billSplit, err := h.Server.Store.GetBillSplit(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return billSplit, nil, status.Errorf(codes.NotFound, "bill split not found")
+		}
+
+		return billSplit, nil, status.Errorf(codes.Internal, "failed to get bill split: %v", err)
+	}
+
+	paymentRequests, err := h.Server.Store.ListBillSplitPaymentRequests(ctx, []int64{billSplit.ID})
+	if err != nil {
+		return billSplit, nil, status.Errorf(codes.Internal, "failed to list bill split payment requests: %v", err)
+	}
+
+	return billSplit, paymentRequests, nil
+}
+
+func validateGetBillSplitRequest(req *pb.GetBillSplitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
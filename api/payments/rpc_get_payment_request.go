@@ -0,0 +1,82 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) GetPaymentRequest(ctx context.Context, req *pb.GetPaymentRequestRequest) (*pb.PaymentRequest, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateGetPaymentRequestRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	paymentRequestId, err := h.paymentRequestId(req.GetId(), req.GetLinkToken())
+	if err != nil {
+		return nil, err
+	}
+
+	paymentRequest, err := h.Server.Store.GetPaymentRequest(ctx, paymentRequestId)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "payment request not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get payment request: %v", err)
+	}
+
+	// Anyone holding a link may see it, otherwise only the parties involved and bankers
+	hasLink := req.GetLinkToken() != "" && paymentRequest.IsLink
+	if !hasLink && !isParty(paymentRequest, authPayload.Username) && !core.HasPermission(ctx, util.PaymentsReadAnyPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "payment request does not belong to the authenticated user")
+	}
+
+	return paymentRequest.ToResponse(), nil
+}
+
+// paymentRequestId resolves the payment request either by its id or by a signed link token.
+func (h *PaymentHandler) paymentRequestId(id int64, linkToken string) (int64, error) {
+	if linkToken == "" {
+		return id, nil
+	}
+
+	id, err := h.Server.Signer.Verify(paymentLinkPurpose, linkToken)
+	if err != nil {
+		if errors.Is(err, token.ErrExpiredToken) {
+			return 0, status.Errorf(codes.FailedPrecondition, "payment link has expired")
+		}
+
+		return 0, status.Errorf(codes.InvalidArgument, "invalid payment link")
+	}
+
+	return id, nil
+}
+
+func isParty(paymentRequest db.PaymentRequest, username string) bool {
+	return paymentRequest.Requester == username || (paymentRequest.Payer.Valid && paymentRequest.Payer.String == username)
+}
+
+func validateGetPaymentRequestRequest(req *pb.GetPaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetLinkToken() == "" {
+		if err := val.ValidateId(req.GetId()); err != nil {
+			violations = append(violations, core.FieldViolation("id", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,103 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) ListBillSplits(ctx context.Context, req *pb.ListBillSplitsRequest) (*pb.ListBillSplitsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateListBillSplitsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	username := authPayload.Username
+	if req.Username != nil {
+		username = req.GetUsername()
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListBillSplitsParams{
+		Creator:  username,
+		PageSize: int32(pageSize),
+	}
+
+	// The cursor is the id of the last split of the previous page, splits are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	billSplits, err := h.Server.Store.ListBillSplits(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bill splits: %v", err)
+	}
+
+	ids := make([]int64, len(billSplits))
+	for i, billSplit := range billSplits {
+		ids[i] = billSplit.ID
+	}
+
+	// Fetch participants of all splits at once
+	paymentRequests, err := h.Server.Store.ListBillSplitPaymentRequests(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bill split payment requests: %v", err)
+	}
+
+	response := &pb.ListBillSplitsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(billSplits)),
+		},
+		Data: make([]*pb.BillSplit, len(billSplits)),
+	}
+
+	for i, billSplit := range billSplits {
+		response.Data[i] = billSplit.ToResponse(paymentRequests)
+	}
+
+	if int64(len(billSplits)) == pageSize {
+		next := strconv.FormatInt(billSplits[len(billSplits)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListBillSplitsRequest(req *pb.ListBillSplitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Username != nil {
+		if err := val.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, core.FieldViolation("username", err))
+		}
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
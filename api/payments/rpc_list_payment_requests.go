@@ -0,0 +1,101 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateListPaymentRequestsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	username := authPayload.Username
+	if req.Username != nil {
+		username = req.GetUsername()
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListPaymentRequestsParams{
+		Username: username,
+		PageSize: int32(pageSize),
+	}
+
+	if req.Status != nil {
+		arg.Status = pgtype.Text{String: db.PaymentRequestStatusFromRequest(req.GetStatus()), Valid: true}
+	}
+
+	// The cursor is the id of the last request of the previous page, requests are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	// Find requests the user has sent or received
+	paymentRequests, err := h.Server.Store.ListPaymentRequests(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment requests: %v", err)
+	}
+
+	response := &pb.ListPaymentRequestsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(paymentRequests)),
+		},
+		Data: make([]*pb.PaymentRequest, len(paymentRequests)),
+	}
+
+	for i, paymentRequest := range paymentRequests {
+		response.Data[i] = paymentRequest.ToResponse()
+	}
+
+	if int64(len(paymentRequests)) == pageSize {
+		next := strconv.FormatInt(paymentRequests[len(paymentRequests)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListPaymentRequestsRequest(req *pb.ListPaymentRequestsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Username != nil {
+		if err := val.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, core.FieldViolation("username", err))
+		}
+	}
+
+	if req.Status != nil && req.GetStatus() == pb.PaymentRequestStatus_PAYMENT_REQUEST_STATUS_UNSPECIFIED {
+		violations = append(violations, core.FieldViolation("status", fmt.Errorf("must be specified")))
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
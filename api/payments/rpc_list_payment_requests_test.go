@@ -0,0 +1,112 @@
+package payments
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListPaymentRequests(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListPaymentRequestsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ListPaymentRequestsResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ListPaymentRequestsRequest{},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPaymentRequests(gomock.Any(), gomock.Eq(db.ListPaymentRequestsParams{
+						Username: user.Username,
+						PageSize: defaultPageSize,
+					})).
+					Times(1).
+					Return([]db.PaymentRequest{{ID: 2, Requester: user.Username}, {ID: 1, Requester: user.Username}}, nil)
+			},
+			func(t *testing.T, res *pb.ListPaymentRequestsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetData(), 2)
+				require.Nil(t, res.GetPagination().Next)
+			},
+		},
+		{
+			"NextPage",
+			&pb.ListPaymentRequestsRequest{
+				Cursor: "10",
+				Limit:  2,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPaymentRequests(gomock.Any(), gomock.Eq(db.ListPaymentRequestsParams{
+						Username: user.Username,
+						BeforeID: pgtype.Int8{Int64: 10, Valid: true},
+						PageSize: 2,
+					})).
+					Times(1).
+					Return([]db.PaymentRequest{{ID: 9, Requester: user.Username}, {ID: 8, Requester: user.Username}}, nil)
+			},
+			func(t *testing.T, res *pb.ListPaymentRequestsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "8", res.GetPagination().GetNext())
+			},
+		},
+		{
+			"InvalidCursor",
+			&pb.ListPaymentRequestsRequest{
+				Cursor: "abc",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPaymentRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ListPaymentRequestsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"InvalidLimit",
+			&pb.ListPaymentRequestsRequest{
+				Limit: maxPageSize + 1,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListPaymentRequests(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ListPaymentRequestsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := testutil.NewTestServer(t, store, nil)
+			handler := NewPaymentHandler(server)
+
+			ctx := testutil.NewContextWithBearerToken(t, server.TokenMaker, user.Username, util.DepositorRole, time.Minute)
+			res, err := handler.ListPaymentRequests(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
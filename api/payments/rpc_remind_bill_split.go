@@ -0,0 +1,85 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RemindBillSplit emails a reminder to every participant whose payment request is still pending.
+// Reminders can be sent at most once per configured interval.
+func (h *PaymentHandler) RemindBillSplit(ctx context.Context, req *pb.RemindBillSplitRequest) (*pb.RemindBillSplitResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateRemindBillSplitRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	billSplit, paymentRequests, err := h.getBillSplit(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if billSplit.Creator != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "bill split does not belong to the authenticated user")
+	}
+
+	var pending []db.PaymentRequest
+	for _, paymentRequest := range paymentRequests {
+		if paymentRequest.EffectiveStatus() == util.PaymentRequestPending {
+			pending = append(pending, paymentRequest)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no participants are left to remind")
+	}
+
+	_, err = h.Server.Store.MarkBillSplitReminded(ctx, db.MarkBillSplitRemindedParams{
+		ID:             billSplit.ID,
+		RemindedBefore: time.Now().Add(-h.Server.Config.BillSplitReminderInterval),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.ResourceExhausted, "reminders can be sent once every %s", h.Server.Config.BillSplitReminderInterval)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update bill split: %v", err)
+	}
+
+	response := &pb.RemindBillSplitResponse{
+		Reminded: make([]string, len(pending)),
+	}
+
+	for i, paymentRequest := range pending {
+		err = h.distributePaymentRequestEmail(ctx, paymentRequest.ID, true)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to send reminder: %v", err)
+		}
+
+		response.Reminded[i] = paymentRequest.Payer.String
+	}
+
+	return response, nil
+}
+
+func validateRemindBillSplitRequest(req *pb.RemindBillSplitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,195 @@
+package payments
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemindBillSplit(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	participant1, _ := testutil.RandomUser(t)
+	participant2, _ := testutil.RandomUser(t)
+	account := testutil.RandomAccount(user.Username)
+
+	billSplit := db.BillSplit{
+		ID:          util.RandomInt(1, 1000),
+		Creator:     user.Username,
+		ToAccountID: account.ID,
+		Amount:      200,
+		Currency:    account.Currency,
+		SplitMethod: util.SplitEven,
+	}
+
+	pendingRequest := testutil.RandomPaymentRequest(user.Username, participant1.Username, account)
+	pendingRequest.BillSplitID = pgtype.Int8{Int64: billSplit.ID, Valid: true}
+
+	acceptedRequest := testutil.RandomPaymentRequest(user.Username, participant2.Username, account)
+	acceptedRequest.BillSplitID = pgtype.Int8{Int64: billSplit.ID, Valid: true}
+	acceptedRequest.Status = util.PaymentRequestAccepted
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RemindBillSplitResponse, err error)
+	}{
+		{
+			"OK",
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).
+					Times(1).
+					Return(billSplit, nil)
+
+				store.EXPECT().
+					ListBillSplitPaymentRequests(gomock.Any(), gomock.Eq([]int64{billSplit.ID})).
+					Times(1).
+					Return([]db.PaymentRequest{pendingRequest, acceptedRequest}, nil)
+
+				store.EXPECT().
+					MarkBillSplitReminded(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(billSplit, nil)
+
+				taskPayload := &worker.PayloadSendPaymentRequestEmail{
+					PaymentRequestID: pendingRequest.ID,
+					Reminder:         true,
+				}
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RemindBillSplitResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{participant1.Username}, res.Reminded)
+			},
+		},
+		{
+			"RemindedRecently",
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).
+					Times(1).
+					Return(billSplit, nil)
+
+				store.EXPECT().
+					ListBillSplitPaymentRequests(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.PaymentRequest{pendingRequest}, nil)
+
+				store.EXPECT().
+					MarkBillSplitReminded(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BillSplit{}, db.ErrRecordNotFound)
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RemindBillSplitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"AllSettled",
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).
+					Times(1).
+					Return(billSplit, nil)
+
+				store.EXPECT().
+					ListBillSplitPaymentRequests(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.PaymentRequest{acceptedRequest}, nil)
+
+				store.EXPECT().
+					MarkBillSplitReminded(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RemindBillSplitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"NotCreator",
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).
+					Times(1).
+					Return(billSplit, nil)
+
+				store.EXPECT().
+					ListBillSplitPaymentRequests(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.PaymentRequest{pendingRequest}, nil)
+
+				store.EXPECT().
+					MarkBillSplitReminded(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, participant1.Username, participant1.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RemindBillSplitResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			coreServer := testutil.NewTestServer(t, store, taskDistributor)
+			handler := NewPaymentHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.RemindBillSplit(ctx, &pb.RemindBillSplitRequest{Id: billSplit.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *PaymentHandler) RequestMoney(ctx context.Context, req *pb.RequestMoneyRequest) (*pb.RequestMoneyResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateRequestMoneyRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	if req.Payer != nil && req.GetPayer() == authPayload.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot request money from yourself")
+	}
+
+	toAccount, err := core.GetAccount(ctx, h.Server.Store, req.GetToAccountId(), req.GetToAccountNumber())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account does not belong to the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, toAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", toAccount.ID)
+	}
+
+	_, err = h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shareable links are screened when they are paid
+	if req.Payer != nil {
+		_, err = h.Server.GetPayer(ctx, req.GetPayer())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	arg := db.CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: db.CreatePaymentRequestParams{
+			Requester: authPayload.Username,
+			Payer: pgtype.Text{
+				String: req.GetPayer(),
+				Valid:  req.Payer != nil,
+			},
+			IsLink:      req.Payer == nil,
+			ToAccountID: toAccount.ID,
+			Amount:      req.GetAmount(),
+			Currency:    toAccount.Currency,
+			Description: pgtype.Text{
+				String: req.GetDescription(),
+				Valid:  req.Description != nil,
+			},
+			ExpiresAt: time.Now().Add(h.Server.Config.PaymentRequestDuration),
+		},
+	}
+
+	// Shareable links have nobody to notify until they are paid
+	if req.Payer != nil {
+		arg.AfterCreate = func(paymentRequest db.PaymentRequest) error {
+			return h.distributePaymentRequestEmail(ctx, paymentRequest.ID, false)
+		}
+	}
+
+	txResult, err := h.Server.Store.CreatePaymentRequestTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "payer not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create payment request: %s", err)
+	}
+
+	paymentRequest := txResult.PaymentRequest
+	response := &pb.RequestMoneyResponse{
+		PaymentRequest: paymentRequest.ToResponse(),
+	}
+
+	if paymentRequest.IsLink {
+		linkToken := h.Server.Signer.Sign(paymentLinkPurpose, paymentRequest.ID, paymentRequest.ExpiresAt)
+		response.LinkToken = &linkToken
+	}
+
+	return response, nil
+}
+
+func validateRequestMoneyRequest(req *pb.RequestMoneyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("to_account_id", req.GetToAccountId(), "to_account_number", req.GetToAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if req.Payer != nil {
+		if err := val.ValidateUsername(req.GetPayer()); err != nil {
+			violations = append(violations, core.FieldViolation("payer", err))
+		}
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, core.FieldViolation("amount", err))
+	}
+
+	if req.Description != nil {
+		if err := val.ValidateDescription(req.GetDescription()); err != nil {
+			violations = append(violations, core.FieldViolation("description", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,419 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eqCreatePaymentRequestTxParamsMatcher struct {
+	arg            db.CreatePaymentRequestTxParams
+	paymentRequest db.PaymentRequest
+}
+
+func (expected *eqCreatePaymentRequestTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.CreatePaymentRequestTxParams)
+	if !ok {
+		return false
+	}
+
+	// Expiry is relative to the time of the request
+	expected.arg.ExpiresAt = actualArg.ExpiresAt
+	if !reflect.DeepEqual(expected.arg.CreatePaymentRequestParams, actualArg.CreatePaymentRequestParams) {
+		return false
+	}
+
+	if actualArg.AfterCreate == nil {
+		return !expected.paymentRequest.Payer.Valid
+	}
+
+	return actualArg.AfterCreate(expected.paymentRequest) == nil
+}
+
+func (expected *eqCreatePaymentRequestTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v", expected.arg)
+}
+
+func EqCreatePaymentRequestTxParams(arg db.CreatePaymentRequestTxParams, paymentRequest db.PaymentRequest) gomock.Matcher {
+	return &eqCreatePaymentRequestTxParamsMatcher{arg, paymentRequest}
+}
+
+func TestRequestMoney(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	payer, _ := testutil.RandomUser(t)
+	account := testutil.RandomAccount(user.Username)
+
+	paymentRequest := testutil.RandomPaymentRequest(user.Username, payer.Username, account)
+	paymentLink := testutil.RandomPaymentRequest(user.Username, "", account)
+
+	testCases := []struct {
+		name          string
+		req           *pb.RequestMoneyRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RequestMoneyResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				arg := db.CreatePaymentRequestTxParams{
+					CreatePaymentRequestParams: db.CreatePaymentRequestParams{
+						Requester:   user.Username,
+						Payer:       paymentRequest.Payer,
+						ToAccountID: account.ID,
+						Amount:      paymentRequest.Amount,
+						Currency:    account.Currency,
+					},
+				}
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), EqCreatePaymentRequestTxParams(arg, paymentRequest)).
+					Times(1).
+					Return(db.CreatePaymentRequestTxResult{PaymentRequest: paymentRequest}, nil)
+
+				taskPayload := &worker.PayloadSendPaymentRequestEmail{
+					PaymentRequestID: paymentRequest.ID,
+				}
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, payer.Username, res.PaymentRequest.GetPayer())
+				require.Equal(t, pb.PaymentRequestStatus_PAYMENT_REQUEST_STATUS_PENDING, res.PaymentRequest.Status)
+				require.Nil(t, res.LinkToken)
+			},
+		},
+		{
+			"OKLink",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Amount:      paymentLink.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				arg := db.CreatePaymentRequestTxParams{
+					CreatePaymentRequestParams: db.CreatePaymentRequestParams{
+						Requester:   user.Username,
+						IsLink:      true,
+						ToAccountID: account.ID,
+						Amount:      paymentLink.Amount,
+						Currency:    account.Currency,
+					},
+				}
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), EqCreatePaymentRequestTxParams(arg, paymentLink)).
+					Times(1).
+					Return(db.CreatePaymentRequestTxResult{PaymentRequest: paymentLink}, nil)
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.True(t, res.PaymentRequest.IsLink)
+				require.NotEmpty(t, res.GetLinkToken())
+			},
+		},
+		{
+			"RequestFromSelf",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &user.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"AccountNotOwned",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &user.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"PayerNotFound",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(payer.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			"PayerDeletedConcurrently",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreatePaymentRequestTxResult{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			"RequesterRestricted",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				restrictedUser := user
+				restrictedUser.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(restrictedUser, nil)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"PayerRestricted",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				restrictedPayer := payer
+				restrictedPayer.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(payer.Username)).
+					Times(1).
+					Return(restrictedPayer, nil)
+
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"InvalidAmount",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Payer:       &payer.Username,
+				Amount:      -1,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"Unauthorized",
+			&pb.RequestMoneyRequest{
+				ToAccountId: account.ID,
+				Amount:      paymentRequest.Amount,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			func(t *testing.T, res *pb.RequestMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(user.Username)).
+				AnyTimes().
+				Return(user, nil)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(payer.Username)).
+				AnyTimes().
+				Return(payer, nil)
+
+			coreServer := testutil.NewTestServer(t, store, taskDistributor)
+			handler := NewPaymentHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.RequestMoney(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
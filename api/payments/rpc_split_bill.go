@@ -0,0 +1,201 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBillSplitParticipants = 20
+
+func (h *PaymentHandler) SplitBill(ctx context.Context, req *pb.SplitBillRequest) (*pb.BillSplit, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateSplitBillRequest(req, authPayload.Username)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	amounts, err := splitAmounts(req)
+	if err != nil {
+		return nil, core.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{core.FieldViolation("participants", err)})
+	}
+
+	toAccount, err := core.GetAccount(ctx, h.Server.Store, req.GetToAccountId(), req.GetToAccountNumber())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account does not belong to the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, toAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", toAccount.ID)
+	}
+
+	_, err = h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	description := pgtype.Text{
+		String: req.GetDescription(),
+		Valid:  req.Description != nil,
+	}
+
+	arg := db.CreateBillSplitTxParams{
+		CreateBillSplitParams: db.CreateBillSplitParams{
+			Creator:     authPayload.Username,
+			ToAccountID: toAccount.ID,
+			Amount:      req.GetAmount(),
+			Currency:    toAccount.Currency,
+			Description: description,
+			SplitMethod: db.SplitMethodFromRequest(req.GetSplitMethod()),
+		},
+		AfterCreate: func(billSplit db.BillSplit, paymentRequests []db.PaymentRequest) error {
+			for _, paymentRequest := range paymentRequests {
+				err := h.distributePaymentRequestEmail(ctx, paymentRequest.ID, false)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	expiresAt := time.Now().Add(h.Server.Config.PaymentRequestDuration)
+	for i, participant := range req.GetParticipants() {
+		// The creator keeps their own share instead of requesting it from themselves
+		if participant.GetUsername() == authPayload.Username {
+			arg.CreatorShare = amounts[i]
+			continue
+		}
+
+		_, err = h.Server.GetPayer(ctx, participant.GetUsername())
+		if err != nil {
+			return nil, err
+		}
+
+		arg.PaymentRequests = append(arg.PaymentRequests, db.CreatePaymentRequestParams{
+			Requester: authPayload.Username,
+			Payer: pgtype.Text{
+				String: participant.GetUsername(),
+				Valid:  true,
+			},
+			ToAccountID: toAccount.ID,
+			Amount:      amounts[i],
+			Currency:    toAccount.Currency,
+			Description: description,
+			ExpiresAt:   expiresAt,
+		})
+	}
+
+	txResult, err := h.Server.Store.CreateBillSplitTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.NotFound, "participant not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to split bill: %s", err)
+	}
+
+	return txResult.BillSplit.ToResponse(txResult.PaymentRequests), nil
+}
+
+// splitAmounts returns the amount owed by each participant, in the order of the request.
+func splitAmounts(req *pb.SplitBillRequest) ([]int64, error) {
+	participants := req.GetParticipants()
+
+	switch req.GetSplitMethod() {
+	case pb.SplitMethod_SPLIT_METHOD_SHARES:
+		shares := make([]int64, len(participants))
+		for i, participant := range participants {
+			shares[i] = participant.GetShares()
+		}
+
+		return util.SplitByShares(req.GetAmount(), shares)
+	case pb.SplitMethod_SPLIT_METHOD_EXACT:
+		amounts := make([]int64, len(participants))
+		for i, participant := range participants {
+			amounts[i] = participant.GetAmount()
+		}
+
+		return amounts, util.CheckExactSplit(req.GetAmount(), amounts)
+	default:
+		return util.SplitEvenly(req.GetAmount(), len(participants))
+	}
+}
+
+func validateSplitBillRequest(req *pb.SplitBillRequest, creator string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("to_account_id", req.GetToAccountId(), "to_account_number", req.GetToAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, core.FieldViolation("amount", err))
+	}
+
+	if req.Description != nil {
+		if err := val.ValidateDescription(req.GetDescription()); err != nil {
+			violations = append(violations, core.FieldViolation("description", err))
+		}
+	}
+
+	if req.GetSplitMethod() == pb.SplitMethod_SPLIT_METHOD_UNSPECIFIED {
+		violations = append(violations, core.FieldViolation("split_method", fmt.Errorf("must be specified")))
+	}
+
+	participants := req.GetParticipants()
+	if len(participants) == 0 || len(participants) > maxBillSplitParticipants {
+		violations = append(violations, core.FieldViolation("participants", fmt.Errorf("must have between 1 and %d participants", maxBillSplitParticipants)))
+		return violations
+	}
+
+	seen := make(map[string]bool, len(participants))
+	for i, participant := range participants {
+		field := fmt.Sprintf("participants[%d]", i)
+
+		if err := val.ValidateUsername(participant.GetUsername()); err != nil {
+			violations = append(violations, core.FieldViolation(field+".username", err))
+		} else if seen[participant.GetUsername()] {
+			violations = append(violations, core.FieldViolation(field+".username", fmt.Errorf("must be unique")))
+		}
+		seen[participant.GetUsername()] = true
+
+		if req.GetSplitMethod() == pb.SplitMethod_SPLIT_METHOD_SHARES && participant.GetShares() <= 0 {
+			violations = append(violations, core.FieldViolation(field+".shares", fmt.Errorf("must be a positive integer")))
+		}
+
+		if req.GetSplitMethod() == pb.SplitMethod_SPLIT_METHOD_EXACT {
+			if err := val.ValidateAmount(participant.GetAmount()); err != nil {
+				violations = append(violations, core.FieldViolation(field+".amount", err))
+			}
+		}
+	}
+
+	if len(participants) == 1 && seen[creator] {
+		violations = append(violations, core.FieldViolation("participants", fmt.Errorf("must include someone other than the creator")))
+	}
+
+	return violations
+}
@@ -0,0 +1,420 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eqCreateBillSplitTxParamsMatcher struct {
+	arg db.CreateBillSplitTxParams
+}
+
+func (expected *eqCreateBillSplitTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.CreateBillSplitTxParams)
+	if !ok {
+		return false
+	}
+
+	if !reflect.DeepEqual(expected.arg.CreateBillSplitParams, actualArg.CreateBillSplitParams) {
+		return false
+	}
+
+	if len(expected.arg.PaymentRequests) != len(actualArg.PaymentRequests) {
+		return false
+	}
+
+	paymentRequests := make([]db.PaymentRequest, len(actualArg.PaymentRequests))
+	for i, actual := range actualArg.PaymentRequests {
+		// Expiry is relative to the time of the request
+		expected.arg.PaymentRequests[i].ExpiresAt = actual.ExpiresAt
+		if !reflect.DeepEqual(expected.arg.PaymentRequests[i], actual) {
+			return false
+		}
+
+		paymentRequests[i] = db.PaymentRequest{ID: int64(i + 1), Payer: actual.Payer}
+	}
+
+	return actualArg.AfterCreate(db.BillSplit{}, paymentRequests) == nil
+}
+
+func (expected *eqCreateBillSplitTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v", expected.arg)
+}
+
+func EqCreateBillSplitTxParams(arg db.CreateBillSplitTxParams) gomock.Matcher {
+	return &eqCreateBillSplitTxParamsMatcher{arg}
+}
+
+func TestSplitBill(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	participant1, _ := testutil.RandomUser(t)
+	participant2, _ := testutil.RandomUser(t)
+	account := testutil.RandomAccount(user.Username)
+
+	paymentRequestParams := func(payer string, amount int64) db.CreatePaymentRequestParams {
+		return db.CreatePaymentRequestParams{
+			Requester:   user.Username,
+			Payer:       pgtype.Text{String: payer, Valid: true},
+			ToAccountID: account.ID,
+			Amount:      amount,
+			Currency:    account.Currency,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SplitBillRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.BillSplit, err error)
+	}{
+		{
+			"OKEven",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      100,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: user.Username},
+					{Username: participant1.Username},
+					{Username: participant2.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				arg := db.CreateBillSplitTxParams{
+					CreateBillSplitParams: db.CreateBillSplitParams{
+						Creator:      user.Username,
+						ToAccountID:  account.ID,
+						Amount:       100,
+						Currency:     account.Currency,
+						SplitMethod:  util.SplitEven,
+						CreatorShare: 34,
+					},
+					PaymentRequests: []db.CreatePaymentRequestParams{
+						paymentRequestParams(participant1.Username, 33),
+						paymentRequestParams(participant2.Username, 33),
+					},
+				}
+
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), EqCreateBillSplitTxParams(arg)).
+					Times(1).
+					Return(db.CreateBillSplitTxResult{
+						BillSplit: db.BillSplit{ID: 1, Creator: user.Username, Amount: 100, Currency: account.Currency, SplitMethod: util.SplitEven, CreatorShare: 34},
+						PaymentRequests: []db.PaymentRequest{
+							{ID: 1, Payer: pgtype.Text{String: participant1.Username, Valid: true}, Amount: 33, Status: util.PaymentRequestPending, ExpiresAt: time.Now().Add(time.Hour), BillSplitID: pgtype.Int8{Int64: 1, Valid: true}},
+							{ID: 2, Payer: pgtype.Text{String: participant2.Username, Valid: true}, Amount: 33, Status: util.PaymentRequestPending, ExpiresAt: time.Now().Add(time.Hour), BillSplitID: pgtype.Int8{Int64: 1, Valid: true}},
+						},
+					}, nil)
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Len(t, res.Participants, 3)
+				require.Equal(t, user.Username, res.Participants[0].Username)
+				require.Equal(t, int64(34), res.Participants[0].Amount)
+				require.Equal(t, pb.PaymentRequestStatus_PAYMENT_REQUEST_STATUS_PENDING, res.Participants[1].Status)
+				require.False(t, res.Settled)
+			},
+		},
+		{
+			"OKShares",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      1000,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_SHARES,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: participant1.Username, Shares: int64Ptr(1)},
+					{Username: participant2.Username, Shares: int64Ptr(3)},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				arg := db.CreateBillSplitTxParams{
+					CreateBillSplitParams: db.CreateBillSplitParams{
+						Creator:     user.Username,
+						ToAccountID: account.ID,
+						Amount:      1000,
+						Currency:    account.Currency,
+						SplitMethod: util.SplitShares,
+					},
+					PaymentRequests: []db.CreatePaymentRequestParams{
+						paymentRequestParams(participant1.Username, 250),
+						paymentRequestParams(participant2.Username, 750),
+					},
+				}
+
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), EqCreateBillSplitTxParams(arg)).
+					Times(1).
+					Return(db.CreateBillSplitTxResult{}, nil)
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"CreatorRestricted",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      100,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: participant1.Username},
+					{Username: participant2.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				restrictedUser := user
+				restrictedUser.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(restrictedUser, nil)
+
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ParticipantRestricted",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      100,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: participant1.Username},
+					{Username: participant2.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				restrictedParticipant := participant2
+				restrictedParticipant.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(participant2.Username)).
+					Times(1).
+					Return(restrictedParticipant, nil)
+
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				distributor.EXPECT().
+					DistributeSendPaymentRequestEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ExactAmountsMismatch",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      1000,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EXACT,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: participant1.Username, Amount: int64Ptr(300)},
+					{Username: participant2.Username, Amount: int64Ptr(300)},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"DuplicateParticipant",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      1000,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: participant1.Username},
+					{Username: participant1.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"OnlyCreator",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      1000,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: user.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"AccountNotOwned",
+			&pb.SplitBillRequest{
+				ToAccountId: account.ID,
+				Amount:      1000,
+				SplitMethod: pb.SplitMethod_SPLIT_METHOD_EVEN,
+				Participants: []*pb.SplitBillParticipant{
+					{Username: user.Username},
+				},
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(account, nil)
+
+				store.EXPECT().
+					CreateBillSplitTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, participant1.Username, participant1.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BillSplit, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			for _, u := range []db.User{user, participant1, participant2} {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(u.Username)).
+					AnyTimes().
+					Return(u, nil)
+			}
+
+			coreServer := testutil.NewTestServer(t, store, taskDistributor)
+			handler := NewPaymentHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.SplitBill(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
@@ -0,0 +1,19 @@
+package screeningcases
+
+import "simplebank/api/core"
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ScreeningCaseHandler serves the queue of users whose names matched the sanctions watchlist.
+type ScreeningCaseHandler struct {
+	Server *core.Server
+}
+
+func NewScreeningCaseHandler(server *core.Server) *ScreeningCaseHandler {
+	return &ScreeningCaseHandler{
+		Server: server,
+	}
+}
@@ -0,0 +1,87 @@
+package screeningcases
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveScreeningCase clears a watchlist match as a false positive or confirms it, the restriction of the user
+// is lifted once all their matches are cleared.
+func (h *ScreeningCaseHandler) ResolveScreeningCase(ctx context.Context, req *pb.ResolveScreeningCaseRequest) (*pb.ResolveScreeningCaseResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateResolveScreeningCaseRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	screeningCase, err := h.Server.Store.GetScreeningCase(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "screening case not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get screening case: %v", err)
+	}
+
+	if screeningCase.Username == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot resolve your own screening case")
+	}
+
+	if screeningCase.Status != util.ScreeningCaseOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "screening case is %s", screeningCase.Status)
+	}
+
+	arg := db.ResolveScreeningCaseTxParams{
+		ID:         screeningCase.ID,
+		Confirm:    req.GetConfirm(),
+		ResolvedBy: authPayload.Username,
+	}
+
+	if req.Note != nil {
+		arg.Note = pgtype.Text{String: req.GetNote(), Valid: true}
+	}
+
+	result, err := h.Server.Store.ResolveScreeningCaseTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrScreeningCaseNotOpen) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to resolve screening case: %v", err)
+	}
+
+	response := &pb.ResolveScreeningCaseResponse{
+		ScreeningCase: result.ScreeningCase.ToResponse(),
+		User:          result.User.ToResponse(),
+	}
+
+	return response, nil
+}
+
+func validateResolveScreeningCaseRequest(req *pb.ResolveScreeningCaseRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	if req.Note != nil {
+		if err := val.ValidateString(req.GetNote(), 1, 200); err != nil {
+			violations = append(violations, core.FieldViolation("note", err))
+		}
+	}
+
+	return violations
+}
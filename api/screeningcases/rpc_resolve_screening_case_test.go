@@ -0,0 +1,217 @@
+package screeningcases
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResolveScreeningCase(t *testing.T) {
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	customer, _ := testutil.RandomUser(t)
+	customer.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	screeningCase := db.ScreeningCase{
+		ID:           util.RandomInt(1, 1000),
+		Username:     customer.Username,
+		Trigger:      util.ScreeningTriggerSignup,
+		ScreenedName: customer.FullName,
+		EntryID:      "7157",
+		EntryName:    "BOUT, Viktor Anatolijevitch",
+		Programs:     []string{"SDGT"},
+		Score:        0.95,
+		Status:       util.ScreeningCaseOpen,
+		CreatedAt:    time.Now(),
+	}
+
+	// resolveTx resolves the case like the store does, lifting the restriction when it is cleared
+	resolveTx := func(_ context.Context, arg db.ResolveScreeningCaseTxParams) (db.ResolveScreeningCaseTxResult, error) {
+		resolved := screeningCase
+		resolved.Status = util.ScreeningCaseCleared
+		if arg.Confirm {
+			resolved.Status = util.ScreeningCaseConfirmed
+		}
+		resolved.ResolvedBy = pgtype.Text{String: arg.ResolvedBy, Valid: true}
+		resolved.ResolutionNote = arg.Note
+		resolved.ResolvedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+		user := customer
+		if !arg.Confirm {
+			user.RestrictedAt = pgtype.Timestamptz{}
+		}
+
+		return db.ResolveScreeningCaseTxResult{ScreeningCase: resolved, User: user}, nil
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ResolveScreeningCaseRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error)
+	}{
+		{
+			"Clear",
+			&pb.ResolveScreeningCaseRequest{
+				Id:   screeningCase.ID,
+				Note: proto.String("different date of birth"),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScreeningCase(gomock.Any(), gomock.Eq(screeningCase.ID)).
+					Times(1).
+					Return(screeningCase, nil)
+
+				store.EXPECT().
+					ResolveScreeningCaseTx(gomock.Any(), gomock.Eq(db.ResolveScreeningCaseTxParams{
+						ID:         screeningCase.ID,
+						ResolvedBy: banker.Username,
+						Note:       pgtype.Text{String: "different date of birth", Valid: true},
+					})).
+					Times(1).
+					DoAndReturn(resolveTx)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.ScreeningCaseCleared, res.GetScreeningCase().GetStatus())
+				require.Equal(t, banker.Username, res.GetScreeningCase().GetResolvedBy())
+				require.Nil(t, res.GetUser().RestrictedAt)
+			},
+		},
+		{
+			"Confirm",
+			&pb.ResolveScreeningCaseRequest{
+				Id:      screeningCase.ID,
+				Confirm: true,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScreeningCase(gomock.Any(), gomock.Eq(screeningCase.ID)).
+					Times(1).
+					Return(screeningCase, nil)
+
+				store.EXPECT().
+					ResolveScreeningCaseTx(gomock.Any(), gomock.Cond(func(arg db.ResolveScreeningCaseTxParams) bool {
+						return arg.Confirm && !arg.Note.Valid
+					})).
+					Times(1).
+					DoAndReturn(resolveTx)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.ScreeningCaseConfirmed, res.GetScreeningCase().GetStatus())
+				require.NotNil(t, res.GetUser().RestrictedAt)
+			},
+		},
+		{
+			"OwnCase",
+			&pb.ResolveScreeningCaseRequest{
+				Id: screeningCase.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScreeningCase(gomock.Any(), gomock.Eq(screeningCase.ID)).
+					Times(1).
+					Return(screeningCase, nil)
+
+				store.EXPECT().
+					ResolveScreeningCaseTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, customer.Username, util.BankerRole, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"AlreadyResolved",
+			&pb.ResolveScreeningCaseRequest{
+				Id: screeningCase.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScreeningCase(gomock.Any(), gomock.Eq(screeningCase.ID)).
+					Times(1).
+					Return(screeningCase, nil)
+
+				store.EXPECT().
+					ResolveScreeningCaseTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveScreeningCaseTxResult{}, db.ErrScreeningCaseNotOpen)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"InvalidNote",
+			&pb.ResolveScreeningCaseRequest{
+				Id:   screeningCase.ID,
+				Note: proto.String(""),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetScreeningCase(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.ResolveScreeningCaseResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewScreeningCaseHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.ResolveScreeningCase(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -3,10 +3,21 @@ package api
 import (
 	"fmt"
 	"simplebank/api/accounts"
+	"simplebank/api/aml"
+	"simplebank/api/audit"
+	"simplebank/api/clients"
 	"simplebank/api/core"
+	"simplebank/api/debits"
+	"simplebank/api/fraudcases"
+	"simplebank/api/kyc"
+	"simplebank/api/payments"
+	"simplebank/api/screeningcases"
 	"simplebank/api/transfers"
 	"simplebank/api/users"
+	"simplebank/blob"
 	db "simplebank/db/sqlc"
+	"simplebank/fraud"
+	"simplebank/screening"
 	"simplebank/token"
 	"simplebank/util"
 	"simplebank/worker"
@@ -17,27 +28,118 @@ type Server struct {
 	*accounts.AccountHandler
 	*users.UserHandler
 	*transfers.TransferHandler
+	*payments.PaymentHandler
+	*debits.DirectDebitHandler
+	*clients.ServiceClientHandler
+	*audit.AuditHandler
+	*kyc.KycHandler
+	*fraudcases.FraudCaseHandler
+	*screeningcases.ScreeningCaseHandler
+	*aml.AmlHandler
 }
 
 func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker([]byte(config.TokenSymmetricKey))
+	tokenMaker, err := NewTokenMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	signer, err := token.NewSigner([]byte(config.LinkSigningKey))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create link signer: %w", err)
+	}
+
+	passwordHasher, err := NewPasswordHasher(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create password hasher: %w", err)
+	}
+
+	blobStore, err := blob.NewLocalStore(config.BlobStorePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create blob store: %w", err)
+	}
+
+	fraudEngine, err := fraud.LoadEngine(config.FraudRulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load fraud rules: %w", err)
+	}
+
+	screener, err := screening.LoadScreener(config.WatchlistPath, config.ScreeningThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load watchlist: %w", err)
+	}
+
 	coreServer := &core.Server{
 		Store:           store,
 		TokenMaker:      tokenMaker,
+		Signer:          signer,
+		PasswordHasher:  passwordHasher,
 		Config:          config,
 		TaskDistributor: taskDistributor,
+		BlobStore:       blobStore,
+		FraudEngine:     fraudEngine,
+		Screener:        screener,
 	}
 
 	server := &Server{
-		Server:          coreServer,
-		AccountHandler:  accounts.NewAccountHandler(coreServer),
-		UserHandler:     users.NewUserHandler(coreServer),
-		TransferHandler: transfers.NewTransferHandler(coreServer),
+		Server:               coreServer,
+		AccountHandler:       accounts.NewAccountHandler(coreServer),
+		UserHandler:          users.NewUserHandler(coreServer),
+		TransferHandler:      transfers.NewTransferHandler(coreServer),
+		PaymentHandler:       payments.NewPaymentHandler(coreServer),
+		DirectDebitHandler:   debits.NewDirectDebitHandler(coreServer),
+		ServiceClientHandler: clients.NewServiceClientHandler(coreServer),
+		AuditHandler:         audit.NewAuditHandler(coreServer),
+		KycHandler:           kyc.NewKycHandler(coreServer),
+		FraudCaseHandler:     fraudcases.NewFraudCaseHandler(coreServer),
+		ScreeningCaseHandler: screeningcases.NewScreeningCaseHandler(coreServer),
+		AmlHandler:           aml.NewAmlHandler(coreServer),
 	}
 
 	return server, nil
 }
+
+// NewTokenMaker returns the token maker for the configured token format. Tokens are signed with the keyring when a
+// signing key is configured, otherwise they are encrypted with the symmetric key and cannot be rotated.
+func NewTokenMaker(config util.Config) (token.Maker, error) {
+	if config.TokenSigningKey == "" {
+		return token.NewPasetoMaker([]byte(config.TokenSymmetricKey))
+	}
+
+	keyring, err := token.ParseKeyring(config.TokenSigningKey, config.TokenVerificationKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.TokenFormat {
+	case "", util.TokenFormatPaseto:
+		return token.NewPasetoPublicMaker(keyring)
+	case util.TokenFormatJWT:
+		return token.NewJWTPublicMaker(keyring)
+	default:
+		return nil, fmt.Errorf("unsupported token format %q", config.TokenFormat)
+	}
+}
+
+// NewPasswordHasher returns the hasher for new passwords. Unset argon2id parameters fall back to the defaults.
+func NewPasswordHasher(config util.Config) (util.PasswordHasher, error) {
+	switch config.PasswordHashAlgorithm {
+	case "", util.PasswordHashArgon2id:
+		memory, iterations, parallelism := config.Argon2idMemory, config.Argon2idIterations, config.Argon2idParallelism
+		if memory == 0 {
+			memory = util.Argon2idDefaultMemory
+		}
+		if iterations == 0 {
+			iterations = util.Argon2idDefaultIterations
+		}
+		if parallelism == 0 {
+			parallelism = util.Argon2idDefaultParallelism
+		}
+
+		return util.NewArgon2idHasher(memory, iterations, parallelism), nil
+	case util.PasswordHashBcrypt:
+		return util.NewBcryptHasher(config.BcryptCost)
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", config.PasswordHashAlgorithm)
+	}
+}
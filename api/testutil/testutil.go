@@ -6,10 +6,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/metadata"
 	"simplebank/api/core"
+	"simplebank/blob"
+	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
+	"simplebank/fraud"
+	"simplebank/screening"
 	"simplebank/token"
 	"simplebank/util"
 	"simplebank/worker"
@@ -17,23 +24,75 @@ import (
 
 func NewTestServer(t *testing.T, store db.Store, distributor worker.TaskDistributor) *core.Server {
 	config := util.Config{
-		TokenSymmetricKey:   util.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:         util.RandomString(32),
+		AccessTokenDuration:       time.Minute,
+		MfaChallengeDuration:      time.Minute,
+		MfaStepUpAmount:           1000,
+		LoginFailureWindow:        time.Minute,
+		LoginMaxFailures:          3,
+		LoginMaxFailuresPerIP:     10,
+		LoginRetryDelay:           time.Second,
+		LoginLockoutDuration:      time.Minute,
+		ClientTokenDuration:       time.Minute,
+		ClientKeyRotationOverlap:  time.Hour,
+		ImpersonationDuration:     time.Minute,
+		RequireVerifiedEmail:      true,
+		VerifyEmailResendInterval: time.Minute,
+		DataExportInterval:        time.Hour,
+		BankCountryCode:           "GB",
+		BankCode:                  "SMPL",
+		LinkSigningKey:            util.RandomString(32),
+		PaymentRequestDuration:    time.Hour,
+		BillSplitReminderInterval: time.Hour,
+		ChargebackWindow:          time.Hour,
 	}
 
 	tokenMaker, err := token.NewPasetoMaker([]byte(config.TokenSymmetricKey))
 	require.NoError(t, err)
 
+	signer, err := token.NewSigner([]byte(config.LinkSigningKey))
+	require.NoError(t, err)
+
+	blobStore, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	fraudEngine, err := fraud.NewEngine(nil)
+	require.NoError(t, err)
+
 	return &core.Server{
 		Config:          config,
 		Store:           store,
 		TokenMaker:      tokenMaker,
+		Signer:          signer,
+		PasswordHasher:  util.NewArgon2idHasher(util.Argon2idDefaultMemory, util.Argon2idDefaultIterations, util.Argon2idDefaultParallelism),
 		TaskDistributor: distributor,
+		BlobStore:       blobStore,
+		FraudEngine:     fraudEngine,
+		Screener:        screening.NewScreener(nil, 0),
 	}
 }
 
+// rolePermissions mirrors the grants of the permissions checked by handlers in the rbac migration.
+var rolePermissions = map[string][]string{
+	util.BankerRole: {
+		util.AccountsReadAnyPermission,
+		util.AccountsDeleteAnyPermission,
+		util.TransfersReadAnyPermission,
+		util.PaymentsReadAnyPermission,
+		util.DebitsReadAnyPermission,
+	},
+	util.AuditorRole: {
+		util.AccountsReadAnyPermission,
+		util.TransfersReadAnyPermission,
+		util.PaymentsReadAnyPermission,
+		util.DebitsReadAnyPermission,
+	},
+}
+
+// NewContextWithBearerToken returns the context of an authenticated request, carrying the bearer token
+// in its metadata and its payload and permissions as put there by the auth interceptor.
 func NewContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, role string, duration time.Duration) context.Context {
-	accessToken, _, err := tokenMaker.CreateToken(username, role, duration)
+	accessToken, payload, err := tokenMaker.CreateToken(username, role, uuid.New(), duration)
 	require.NoError(t, err)
 
 	bearerToken := fmt.Sprintf("%s %s", core.BearerPrefix, accessToken)
@@ -44,7 +103,23 @@ func NewContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username st
 		},
 	}
 
-	return metadata.NewIncomingContext(context.Background(), md)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	return core.ContextWithPermissions(core.ContextWithAuthPayload(ctx, payload), rolePermissions[role])
+}
+
+// ExpectLoginThrottle lets a login, or a check of a password or second factor, pass the throttle with the given
+// recent failures, the last of which was long enough ago for the retry delay to have passed.
+func ExpectLoginThrottle(store *mockdb.MockStore, ipFailures int64, failures int64) {
+	store.EXPECT().
+		CountLoginFailuresByIP(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(ipFailures, nil)
+
+	store.EXPECT().
+		GetLoginFailureStats(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.GetLoginFailureStatsRow{Failures: failures, LastFailedAt: time.Now().Add(-time.Hour)}, nil)
 }
 
 func RandomUser(t *testing.T) (user db.User, password string) {
@@ -58,16 +133,24 @@ func RandomUser(t *testing.T) (user db.User, password string) {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
+		// most tests exercise users who have completed sign-up and identity checks
+		IsEmailVerified: true,
+		KycTier:         2,
+		KycStatus:       util.KycStatusApproved,
+		Status:          util.UserStatusActive,
 	}
 	return
 }
 
 func RandomAccount(owner string) db.Account {
+	number, _ := util.GenerateAccountNumber("GB", "SMPL")
+
 	return db.Account{
 		ID:       util.RandomInt(1, 1000),
 		Owner:    owner,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Number:   number,
 	}
 }
 
@@ -79,3 +162,19 @@ func RandomTransfer(fromAccountID, toAccountID int64) db.Transfer {
 		Amount:        util.RandomMoney(),
 	}
 }
+
+// RandomPaymentRequest returns a pending payment request into the account, or a payment link if payer is empty.
+func RandomPaymentRequest(requester, payer string, toAccount db.Account) db.PaymentRequest {
+	return db.PaymentRequest{
+		ID:          util.RandomInt(1, 1000),
+		Requester:   requester,
+		Payer:       pgtype.Text{String: payer, Valid: payer != ""},
+		IsLink:      payer == "",
+		ToAccountID: toAccount.ID,
+		Amount:      util.RandomMoney(),
+		Currency:    toAccount.Currency,
+		Status:      util.PaymentRequestPending,
+		ExpiresAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,60 @@
+package transfers
+
+import (
+	"context"
+	"encoding/json"
+	db "simplebank/db/sqlc"
+	"simplebank/fraud"
+	"simplebank/pb"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// applyFraudRules evaluates the fraud rules for a transfer. Blocked transfers are rejected and transfers held for
+// review are turned into a fraud case, for which a response is returned. Allowed transfers return neither.
+func (h *TransferHandler) applyFraudRules(ctx context.Context, features fraud.Features, arg db.TransferTxParams, fromAccount, toAccount *db.Account) (*pb.CreateTransferResponse, error) {
+	decision := h.Server.FraudEngine.Evaluate(features)
+
+	switch decision.Action {
+	case fraud.ActionBlock:
+		log.Warn().
+			Str("username", fromAccount.Owner).
+			Int64("from_account_id", fromAccount.ID).
+			Int64("to_account_id", toAccount.ID).
+			Int64("amount", arg.Amount).
+			Str("rule", decision.Rule).
+			Msg("transfer blocked by fraud rule")
+
+		// The rule is not disclosed, so it cannot be worked around
+		return nil, status.Errorf(codes.PermissionDenied, "transfer has been declined")
+	case fraud.ActionReview:
+		encodedFeatures, err := json.Marshal(features)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode fraud features: %v", err)
+		}
+
+		fraudCase, err := h.Server.Store.CreateFraudCase(ctx, db.CreateFraudCaseParams{
+			Username:      fromAccount.Owner,
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        arg.Amount,
+			Description:   arg.Description,
+			Rule:          decision.Rule,
+			Features:      encodedFeatures,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create fraud case: %v", err)
+		}
+
+		response := &pb.CreateTransferResponse{
+			HeldForReview: true,
+			FraudCaseId:   fraudCase.ID,
+		}
+
+		return response, nil
+	}
+
+	return nil, nil
+}
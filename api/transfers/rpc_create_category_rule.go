@@ -0,0 +1,98 @@
+package transfers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TransferHandler) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleRequest) (*pb.CategoryRule, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateCreateCategoryRuleRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	arg := db.CreateCategoryRuleParams{
+		Owner: authPayload.Username,
+		Keyword: pgtype.Text{
+			String: strings.ToLower(req.GetKeyword()),
+			Valid:  req.Keyword != nil,
+		},
+		Category: db.SpendingCategoryFromRequest(req.GetCategory()),
+	}
+
+	if req.GetCounterpartyAccountId() != 0 || req.GetCounterpartyAccountNumber() != "" {
+		account, err := core.GetAccount(ctx, h.Server.Store, req.GetCounterpartyAccountId(), req.GetCounterpartyAccountNumber())
+		if err != nil {
+			// Unknown accounts are rejected like malformed references, whether given by id or number
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, core.InvalidArgumentError([]*errdetails.BadRequest_FieldViolation{counterpartyNotFound(req)})
+			}
+
+			return nil, status.Errorf(codes.Internal, "failed to fetch account details")
+		}
+
+		arg.CounterpartyAccountID = pgtype.Int8{
+			Int64: account.ID,
+			Valid: true,
+		}
+	}
+
+	rule, err := h.Server.Store.CreateCategoryRule(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create category rule: %v", err)
+	}
+
+	return rule.ToResponse(), nil
+}
+
+// counterpartyNotFound returns the violation of the field the counterparty account was looked up by.
+func counterpartyNotFound(req *pb.CreateCategoryRuleRequest) *errdetails.BadRequest_FieldViolation {
+	err := fmt.Errorf("account not found")
+	if req.GetCounterpartyAccountNumber() != "" {
+		return core.FieldViolation("counterparty_account_number", err)
+	}
+
+	return core.FieldViolation("counterparty_account_id", err)
+}
+
+func validateCreateCategoryRuleRequest(req *pb.CreateCategoryRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	hasCounterparty := req.GetCounterpartyAccountId() != 0 || req.GetCounterpartyAccountNumber() != ""
+
+	if req.Keyword == nil && !hasCounterparty {
+		violations = append(violations, core.FieldViolation("keyword", fmt.Errorf("keyword or counterparty must be provided")))
+	}
+
+	if req.Keyword != nil {
+		if err := val.ValidateKeyword(req.GetKeyword()); err != nil {
+			violations = append(violations, core.FieldViolation("keyword", err))
+		}
+	}
+
+	if hasCounterparty {
+		if violation := core.ValidateAccountReference("counterparty_account_id", req.GetCounterpartyAccountId(), "counterparty_account_number", req.GetCounterpartyAccountNumber()); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+
+	if req.GetCategory() == pb.SpendingCategory_SPENDING_CATEGORY_UNSPECIFIED {
+		violations = append(violations, core.FieldViolation("category", fmt.Errorf("must be specified")))
+	}
+
+	return violations
+}
@@ -0,0 +1,151 @@
+package transfers
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateCategoryRule(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	counterparty := testutil.RandomAccount(util.RandomOwner())
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateCategoryRuleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CategoryRule, err error)
+	}{
+		{
+			"OKKeyword",
+			&pb.CreateCategoryRuleRequest{
+				// 18 characters, more bytes
+				Keyword:  proto.String(strings.Repeat("é", 18)),
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CategoryRule{ID: 1, Owner: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"KeywordTooLong",
+			&pb.CreateCategoryRuleRequest{
+				Keyword:  proto.String(strings.Repeat("a", 19)),
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"KeywordBlank",
+			&pb.CreateCategoryRuleRequest{
+				Keyword:  proto.String("   "),
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"CounterpartyIdNotFound",
+			&pb.CreateCategoryRuleRequest{
+				CounterpartyAccountId: counterparty.ID,
+				Category:              pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(counterparty.ID)).
+					Times(1).
+					Return(db.Account{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"CounterpartyNumberNotFound",
+			&pb.CreateCategoryRuleRequest{
+				CounterpartyAccountNumber: &counterparty.Number,
+				Category:                  pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(counterparty.Number)).
+					Times(1).
+					Return(db.Account{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateCategoryRule(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"CounterpartyNumberMalformed",
+			&pb.CreateCategoryRuleRequest{
+				CounterpartyAccountNumber: proto.String("not-a-number"),
+				Category:                  pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.CategoryRule, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewTransferHandler(coreServer)
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, user.Username, user.Role, time.Minute)
+
+			res, err := handler.CreateCategoryRule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
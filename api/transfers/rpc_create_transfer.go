@@ -6,20 +6,26 @@ import (
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
-	"simplebank/util"
+	"simplebank/val"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (h *TransferHandler) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
 
+	violations := validateCreateTransferRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
 	// Fetch sender account
-	fromAccount, err := h.validAccount(ctx, req.FromAccountId, req.Currency.String())
+	fromAccount, err := h.validAccount(ctx, req.GetFromAccountId(), req.GetFromAccountNumber(), req.Currency.String())
 	if err != nil {
 		return nil, err
 	}
@@ -29,21 +35,63 @@ func (h *TransferHandler) CreateTransfer(ctx context.Context, req *pb.CreateTran
 		return nil, status.Errorf(codes.PermissionDenied, "from account does not belong to the authenticated user")
 	}
 
-	_, err = h.validAccount(ctx, req.ToAccountId, req.Currency.String())
+	if !core.CanAccessAccount(ctx, fromAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", fromAccount.ID)
+	}
+
+	user, err := h.Server.GetVerifiedUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.Server.CheckKycTransferLimit(ctx, user, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.Server.CheckStepUp(ctx, user, req.GetAmount(), req.MfaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccount, err := h.validAccount(ctx, req.GetToAccountId(), req.GetToAccountNumber(), req.Currency.String())
 	if err != nil {
 		return nil, err
 	}
 
 	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountId,
-		ToAccountID:   req.ToAccountId,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
 		Amount:        req.Amount,
 		Description:   req.GetDescription(),
 	}
 
-	result, err := h.Server.Store.TransferTx(ctx, arg)
+	features, err := h.Server.TransferFeatures(ctx, authPayload, user, fromAccount, toAccount, arg.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer history: %v", err)
+	}
 
+	err = h.Server.CheckPayee(ctx, toAccount, features)
 	if err != nil {
+		return nil, err
+	}
+
+	heldResponse, err := h.applyFraudRules(ctx, features, arg, fromAccount, toAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	if heldResponse != nil {
+		return heldResponse, nil
+	}
+
+	result, err := h.Server.Store.TransferTx(ctx, arg)
+	if err != nil {
+		// The accounts can have been frozen or closed and other transfers made since they were checked
+		if errors.Is(err, db.ErrAccountClosed) || errors.Is(err, db.ErrAccountFrozen) || errors.Is(err, db.ErrKycLimitExceeded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+
 		return nil, status.Errorf(codes.Internal, "failed to create transfer: %v", err)
 	}
 
@@ -58,8 +106,8 @@ func (h *TransferHandler) CreateTransfer(ctx context.Context, req *pb.CreateTran
 	return response, nil
 }
 
-func (h *TransferHandler) validAccount(ctx context.Context, accountId int64, currency string) (*db.Account, error) {
-	account, err := h.Server.Store.GetAccount(ctx, accountId)
+func (h *TransferHandler) validAccount(ctx context.Context, accountId int64, accountNumber string, currency string) (*db.Account, error) {
+	account, err := core.GetAccount(ctx, h.Server.Store, accountId, accountNumber)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "account not found")
@@ -68,9 +116,43 @@ func (h *TransferHandler) validAccount(ctx context.Context, accountId int64, cur
 		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
 	}
 
+	if account.ClosedAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "account [%s] is closed", account.Number)
+	}
+
+	if account.FrozenAt.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "account [%s] is frozen", account.Number)
+	}
+
 	if account.Currency != currency {
-		return nil, status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+		return nil, status.Errorf(codes.InvalidArgument, "account [%s] currency mismatch: %s vs %s", account.Number, account.Currency, currency)
 	}
 
 	return &account, nil
 }
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violation := core.ValidateAccountReference("from_account_id", req.GetFromAccountId(), "from_account_number", req.GetFromAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if violation := core.ValidateAccountReference("to_account_id", req.GetToAccountId(), "to_account_number", req.GetToAccountNumber()); violation != nil {
+		violations = append(violations, violation)
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, core.FieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency().String()); err != nil {
+		violations = append(violations, core.FieldViolation("currency", err))
+	}
+
+	if req.MfaCode != nil {
+		if err := val.ValidateTOTPCode(req.GetMfaCode()); err != nil {
+			violations = append(violations, core.FieldViolation("mfa_code", err))
+		}
+	}
+
+	return violations
+}
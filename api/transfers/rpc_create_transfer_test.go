@@ -2,15 +2,19 @@ package transfers
 
 import (
 	"context"
+	"fmt"
+	"simplebank/api/core"
 	"simplebank/api/testutil"
 	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
+	"simplebank/screening"
 	"simplebank/token"
 	"simplebank/util"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
@@ -25,7 +29,28 @@ func TestCreateTransfer(t *testing.T) {
 	account1.Currency = util.USD
 	account2.Currency = util.USD
 
+	payee, _ := testutil.RandomUser(t)
+	payee.Username = account2.Owner
+
+	restrictedPayee := payee
+	restrictedPayee.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	// on the watchlist of the test cases
+	sanctionedPayee := payee
+	sanctionedPayee.FullName = "Viktor Anatolijevitch Bout"
+
 	amount := int64(10)
+	// above the step-up amount of the test server
+	largeAmount := int64(5000)
+
+	secret, err := util.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	credential := db.TotpCredential{
+		Username:    user.Username,
+		Secret:      secret,
+		ConfirmedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
 
 	testCases := []struct {
 		name          string
@@ -78,6 +103,52 @@ func TestCreateTransfer(t *testing.T) {
 				require.NotNil(t, res)
 			},
 		},
+		{
+			"OKByAccountNumber",
+			&pb.CreateTransferRequest{
+				FromAccountNumber: &account1.Number,
+				ToAccountNumber:   &account2.Number,
+				Amount:            amount,
+				Currency:          pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account1.Number)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account2.Number)).
+					Times(1).
+					Return(account2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+				}
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer:    db.Transfer{ID: 1, FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: amount},
+						FromAccount: account1,
+						ToAccount:   account2,
+						FromEntry:   db.Entry{AccountID: account1.ID, Amount: -amount},
+						ToEntry:     db.Entry{AccountID: account2.ID, Amount: amount},
+					}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, account1.Number, res.FromAccount.Number)
+				require.Equal(t, account2.Number, res.ToAccount.Number)
+			},
+		},
 		{
 			"Unauthorized",
 			&pb.CreateTransferRequest{
@@ -305,22 +376,779 @@ func TestCreateTransfer(t *testing.T) {
 				require.Equal(t, codes.Internal, st.Code())
 			},
 		},
-	}
+		{
+			"StepUpOK",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        largeAmount,
+				Currency:      pb.Currency_USD,
+				MfaCode:       totpCode(t, secret),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+				testutil.ExpectLoginThrottle(store, 0, 0)
 
-			store := mockdb.NewMockStore(ctrl)
-			tc.buildStubs(store)
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
 
-			coreServer := testutil.NewTestServer(t, store, nil)
-			handler := NewTransferHandler(coreServer)
-			ctx := tc.buildContext(t, coreServer.TokenMaker)
+				store.EXPECT().
+					UseTotpStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(credential, nil)
 
-			res, err := handler.CreateTransfer(ctx, tc.req)
-			tc.checkResponse(t, res, err)
-		})
-	}
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{
+						Transfer: db.Transfer{ID: 1, FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: largeAmount},
+					}, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"StepUpMissingCode",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        largeAmount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"StepUpReplayedCode",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        largeAmount,
+				Currency:      pb.Currency_USD,
+				MfaCode:       totpCode(t, secret),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
+
+				store.EXPECT().
+					UseTotpStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+
+				// each rejected transfer counts towards the lockout, so codes cannot be guessed for free
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Cond(func(attempt db.CreateLoginAttemptParams) bool {
+						return attempt.Username == user.Username && attempt.FailureReason.String == core.LoginFailureWrongMfaCode
+					})).
+					Times(1)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"StepUpThrottled",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        largeAmount,
+				Currency:      pb.Currency_USD,
+				MfaCode:       totpCode(t, secret),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					CountLoginFailuresByIP(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+
+				// the retry delay after the last wrong code has not passed yet
+				store.EXPECT().
+					GetLoginFailureStats(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetLoginFailureStatsRow{Failures: 2, LastFailedAt: time.Now()}, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(1)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+			},
+		},
+		{
+			"StepUpNotEnabled",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        largeAmount,
+				Currency:      pb.Currency_USD,
+				MfaCode:       totpCode(t, secret),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ToAccountClosed",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				closedAccount := account2
+				closedAccount.Balance = 0
+				closedAccount.ClosedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(closedAccount, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ToAccountFrozen",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				frozenAccount := account2
+				frozenAccount.FrozenAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(frozenAccount, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"EmailNotVerified",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				unverifiedUser := user
+				unverifiedUser.IsEmailVerified = false
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(unverifiedUser, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"KycNotVerified",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				unidentifiedUser := user
+				unidentifiedUser.KycTier = 0
+				unidentifiedUser.KycStatus = util.KycStatusRejected
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(unidentifiedUser, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"KycTransferAmountLimit",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        util.GetKycTier(1).MaxTransferAmount + 1,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				basicUser := user
+				basicUser.KycTier = 1
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(basicUser, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"KycDailyLimitReachedConcurrently",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: tier 1 allows sending at most 250000 a day", db.ErrKycLimitExceeded))
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"KycDailyLimit",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					SumTransfersFromOwnerSince(gomock.Any(), gomock.Cond(func(arg db.SumTransfersFromOwnerSinceParams) bool {
+						return arg.Owner == user.Username && time.Since(arg.Since) >= 24*time.Hour
+					})).
+					Times(1).
+					Return(util.GetKycTier(user.KycTier).DailyTransferLimit-amount+1, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"FraudRuleReview",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				// far above the average, to someone the sender never paid before
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Eq(db.GetTransferHistoryParams{Owner: user.Username, ToAccountID: account2.ID})).
+					Times(1).
+					Return(db.GetTransferHistoryRow{AverageAmount: 1}, nil)
+
+				store.EXPECT().
+					CreateFraudCase(gomock.Any(), gomock.Cond(func(arg db.CreateFraudCaseParams) bool {
+						return arg.Username == user.Username && arg.FromAccountID == account1.ID && arg.ToAccountID == account2.ID &&
+							arg.Amount == amount && arg.Rule == "unusual_amount_new_payee"
+					})).
+					Times(1).
+					Return(db.FraudCase{ID: 7, Username: user.Username, Status: util.FraudCaseOpen}, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetHeldForReview())
+				require.Equal(t, int64(7), res.GetFraudCaseId())
+				require.Nil(t, res.GetTransfer())
+			},
+		},
+		{
+			"FraudRuleBlock",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetTransferHistoryRow{TransfersLastHour: 10, TransfersToPayee: 1}, nil)
+
+				store.EXPECT().
+					CreateFraudCase(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"PayeeRestricted",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(payee.Username)).
+					Times(1).
+					Return(restrictedPayee, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"NewPayeeOnWatchlist",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account2.ID)).
+					Times(1).
+					Return(account2, nil)
+
+				store.EXPECT().
+					GetTransferHistory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetTransferHistoryRow{}, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(payee.Username)).
+					Times(1).
+					Return(sanctionedPayee, nil)
+
+				store.EXPECT().
+					FlagScreeningHitsTx(gomock.Any(), gomock.Cond(func(arg db.FlagScreeningHitsTxParams) bool {
+						return arg.Username == payee.Username && arg.Trigger == util.ScreeningTriggerPayee &&
+							len(arg.Hits) == 1 && arg.Hits[0].EntryID == "7157"
+					})).
+					Times(1).
+					Return(db.FlagScreeningHitsTxResult{User: restrictedPayee}, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"SenderRestricted",
+			&pb.CreateTransferRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account1.ID)).
+					Times(1).
+					Return(account1, nil)
+
+				restrictedUser := user
+				restrictedUser.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(restrictedUser, nil)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"ZeroAmount",
+			&pb.CreateTransferRequest{
+				FromAccountId: account2.ID,
+				ToAccountId:   account1.ID,
+				Amount:        0,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"NegativeAmount",
+			&pb.CreateTransferRequest{
+				FromAccountId: account2.ID,
+				ToAccountId:   account1.ID,
+				Amount:        -amount,
+				Currency:      pb.Currency_USD,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.CreateTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// the sender has verified their email and identity and sent nothing today unless a case says otherwise
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(user.Username)).
+				AnyTimes().
+				Return(user, nil)
+
+			store.EXPECT().
+				SumTransfersFromOwnerSince(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(int64(0), nil)
+
+			// and has paid the receiver before from a device they used before
+			store.EXPECT().
+				GetTransferHistory(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(db.GetTransferHistoryRow{TransfersToPayee: 1}, nil)
+
+			store.EXPECT().
+				IsNewDeviceSession(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(pgtype.Bool{Bool: false, Valid: true}, nil)
+
+			store.EXPECT().
+				GetUser(gomock.Any(), gomock.Eq(payee.Username)).
+				AnyTimes().
+				Return(payee, nil)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			coreServer.Screener = screening.NewScreener([]screening.Entry{
+				{ID: "7157", Name: "BOUT, Viktor Anatolijevitch", Programs: []string{"SDGT"}},
+			}, 0)
+			handler := NewTransferHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+
+			res, err := handler.CreateTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func totpCode(t *testing.T, secret string) *string {
+	code, err := util.TOTPCode(secret, time.Now())
+	require.NoError(t, err)
+
+	return &code
 }
@@ -0,0 +1,55 @@
+package transfers
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *TransferHandler) DeleteCategoryRule(ctx context.Context, req *pb.DeleteCategoryRuleRequest) (*emptypb.Empty, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateDeleteCategoryRuleRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	rule, err := h.Server.Store.GetCategoryRule(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "category rule not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get category rule: %v", err)
+	}
+
+	if rule.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "category rule does not belong to the authenticated user")
+	}
+
+	err = h.Server.Store.DeleteCategoryRule(ctx, rule.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete category rule: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateDeleteCategoryRuleRequest(req *pb.DeleteCategoryRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	return violations
+}
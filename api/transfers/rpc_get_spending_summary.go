@@ -0,0 +1,130 @@
+package transfers
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// topCounterparties is the number of counterparties included in a spending summary.
+const topCounterparties = 10
+
+func (h *TransferHandler) GetSpendingSummary(ctx context.Context, req *pb.GetSpendingSummaryRequest) (*pb.GetSpendingSummaryResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateGetSpendingSummaryRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	username := authPayload.Username
+	if req.Username != nil {
+		username = req.GetUsername()
+	}
+
+	startTime := req.GetStartTime().AsTime()
+	endTime := req.GetEndTime().AsTime()
+
+	// A client restricted to some accounts of the user only sees the spending of those
+	accountIDs := core.AccessibleAccountIDs(ctx)
+
+	byCategory, err := h.Server.Store.GetSpendingByCategory(ctx, db.GetSpendingByCategoryParams{
+		Owner:      username,
+		AccountIds: accountIDs,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spending by category: %v", err)
+	}
+
+	byMonth, err := h.Server.Store.GetSpendingByMonth(ctx, db.GetSpendingByMonthParams{
+		Owner:      username,
+		AccountIds: accountIDs,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spending by month: %v", err)
+	}
+
+	byCounterparty, err := h.Server.Store.GetSpendingByCounterparty(ctx, db.GetSpendingByCounterpartyParams{
+		Owner:      username,
+		AccountIds: accountIDs,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Limit:      topCounterparties,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spending by counterparty: %v", err)
+	}
+
+	response := &pb.GetSpendingSummaryResponse{
+		ByCategory:     make([]*pb.CategorySpending, len(byCategory)),
+		ByMonth:        make([]*pb.MonthlySpending, len(byMonth)),
+		ByCounterparty: make([]*pb.CounterpartySpending, len(byCounterparty)),
+	}
+
+	for i, row := range byCategory {
+		response.ByCategory[i] = &pb.CategorySpending{
+			Category: db.SpendingCategoryToResponse(row.Category),
+			Currency: pb.Currency(pb.Currency_value[row.Currency]),
+			Amount:   row.Amount,
+			Count:    row.Count,
+		}
+	}
+
+	for i, row := range byMonth {
+		response.ByMonth[i] = &pb.MonthlySpending{
+			Month:    timestamppb.New(row.Month),
+			Currency: pb.Currency(pb.Currency_value[row.Currency]),
+			Amount:   row.Amount,
+			Count:    row.Count,
+		}
+	}
+
+	for i, row := range byCounterparty {
+		response.ByCounterparty[i] = &pb.CounterpartySpending{
+			Counterparty:              row.Counterparty,
+			CounterpartyAccountNumber: row.CounterpartyAccountNumber,
+			Currency:                  pb.Currency(pb.Currency_value[row.Currency]),
+			Amount:                    row.Amount,
+			Count:                     row.Count,
+		}
+	}
+
+	return response, nil
+}
+
+func validateGetSpendingSummaryRequest(req *pb.GetSpendingSummaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Username != nil {
+		if err := val.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, core.FieldViolation("username", err))
+		}
+	}
+
+	if req.StartTime == nil {
+		violations = append(violations, core.FieldViolation("start_time", fmt.Errorf("must be specified")))
+	}
+
+	if req.EndTime == nil {
+		violations = append(violations, core.FieldViolation("end_time", fmt.Errorf("must be specified")))
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()) {
+		violations = append(violations, core.FieldViolation("end_time", fmt.Errorf("must be after start_time")))
+	}
+
+	return violations
+}
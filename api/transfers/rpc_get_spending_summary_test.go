@@ -0,0 +1,230 @@
+package transfers
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetSpendingSummary(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	otherUser, _ := testutil.RandomUser(t)
+
+	startTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 3, 0)
+
+	byCategory := []db.GetSpendingByCategoryRow{
+		{Category: util.CategoryGroceries, Currency: util.EUR, Amount: 300, Count: 4},
+		{Category: "", Currency: util.EUR, Amount: 50, Count: 1},
+	}
+	byMonth := []db.GetSpendingByMonthRow{
+		{Month: startTime, Currency: util.EUR, Amount: 350, Count: 5},
+	}
+	byCounterparty := []db.GetSpendingByCounterpartyRow{
+		{Counterparty: otherUser.Username, CounterpartyAccountNumber: util.RandomString(22), Currency: util.EUR, Amount: 350, Count: 5},
+	}
+
+	expectSummary := func(store *mockdb.MockStore, owner string, accountIDs []int64) {
+		store.EXPECT().
+			GetSpendingByCategory(gomock.Any(), gomock.Eq(db.GetSpendingByCategoryParams{
+				Owner:      owner,
+				AccountIds: accountIDs,
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})).
+			Times(1).
+			Return(byCategory, nil)
+
+		store.EXPECT().
+			GetSpendingByMonth(gomock.Any(), gomock.Eq(db.GetSpendingByMonthParams{
+				Owner:      owner,
+				AccountIds: accountIDs,
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})).
+			Times(1).
+			Return(byMonth, nil)
+
+		store.EXPECT().
+			GetSpendingByCounterparty(gomock.Any(), gomock.Eq(db.GetSpendingByCounterpartyParams{
+				Owner:      owner,
+				AccountIds: accountIDs,
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Limit:      topCounterparties,
+			})).
+			Times(1).
+			Return(byCounterparty, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetSpendingSummaryRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.GetSpendingSummaryRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			func(store *mockdb.MockStore) {
+				expectSummary(store, user.Username, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+
+				require.Len(t, res.ByCategory, 2)
+				require.Equal(t, pb.SpendingCategory_SPENDING_CATEGORY_GROCERIES, res.ByCategory[0].GetCategory())
+				require.Equal(t, pb.Currency_EUR, res.ByCategory[0].GetCurrency())
+				require.Equal(t, int64(300), res.ByCategory[0].GetAmount())
+				require.Equal(t, pb.SpendingCategory_SPENDING_CATEGORY_OTHER, res.ByCategory[1].GetCategory())
+
+				require.Len(t, res.ByMonth, 1)
+				require.Equal(t, startTime, res.ByMonth[0].GetMonth().AsTime())
+				require.Equal(t, int64(5), res.ByMonth[0].GetCount())
+
+				require.Len(t, res.ByCounterparty, 1)
+				require.Equal(t, otherUser.Username, res.ByCounterparty[0].GetCounterparty())
+			},
+		},
+		{
+			"ServiceClientRestricted",
+			&pb.GetSpendingSummaryRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			func(store *mockdb.MockStore) {
+				expectSummary(store, user.Username, []int64{7, 9})
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				return core.ContextWithServiceClient(ctx, &db.ServiceClient{Owner: user.Username, AccountIds: []int64{7, 9}})
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"BankerOtherUser",
+			&pb.GetSpendingSummaryRequest{
+				Username:  &otherUser.Username,
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			func(store *mockdb.MockStore) {
+				expectSummary(store, otherUser.Username, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"InvalidRange",
+			&pb.GetSpendingSummaryRequest{
+				StartTime: timestamppb.New(endTime),
+				EndTime:   timestamppb.New(startTime),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSpendingByCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"Unauthenticated",
+			&pb.GetSpendingSummaryRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSpendingByCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"InternalError",
+			&pb.GetSpendingSummaryRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSpendingByCategory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, context.DeadlineExceeded)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.GetSpendingSummaryResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewTransferHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+			res, err := handler.GetSpendingSummary(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package transfers
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TransferHandler) ListCategoryRules(ctx context.Context, req *pb.ListCategoryRulesRequest) (*pb.ListCategoryRulesResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	rules, err := h.Server.Store.ListCategoryRules(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list category rules: %v", err)
+	}
+
+	response := &pb.ListCategoryRulesResponse{
+		Data: make([]*pb.CategoryRule, len(rules)),
+	}
+
+	for i, rule := range rules {
+		response.Data[i] = rule.ToResponse()
+	}
+
+	return response, nil
+}
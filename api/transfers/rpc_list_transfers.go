@@ -7,6 +7,7 @@ import (
 	"simplebank/pb"
 	"simplebank/util"
 	"simplebank/val"
+	"slices"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -14,7 +15,7 @@ import (
 )
 
 func (h *TransferHandler) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
+	authPayload, err := core.AuthPayloadFromContext(ctx)
 	if err != nil {
 		return nil, core.UnauthenticatedError(err)
 	}
@@ -25,7 +26,7 @@ func (h *TransferHandler) ListTransfers(ctx context.Context, req *pb.ListTransfe
 	}
 
 	// Only bankers can list transactions of other users
-	if req.Username != nil && !util.IsBanker(authPayload.Role) {
+	if req.Username != nil && !core.HasPermission(ctx, util.TransfersReadAnyPermission) {
 		return nil, status.Errorf(codes.PermissionDenied, "cannot list transactions of other users")
 	}
 
@@ -44,6 +45,10 @@ func (h *TransferHandler) ListTransfers(ctx context.Context, req *pb.ListTransfe
 		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
 	}
 
+	accounts = slices.DeleteFunc(accounts, func(account db.Account) bool {
+		return !core.CanAccessAccount(ctx, account.ID)
+	})
+
 	// List transfers from owned accounts
 	transfers := findTransfersForAccounts(ctx, h.Server.Store, accounts)
 
@@ -0,0 +1,76 @@
+package transfers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TransferHandler) SetTransferCategory(ctx context.Context, req *pb.SetTransferCategoryRequest) (*pb.Transfer, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateSetTransferCategoryRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	transfer, err := h.Server.Store.GetTransfer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %v", err)
+	}
+
+	fromAccount, err := h.Server.Store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch account details")
+	}
+
+	// Categories describe spending, so only the sender can categorize a transfer
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "transfer was not sent by the authenticated user")
+	}
+
+	if !core.CanAccessAccount(ctx, fromAccount.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "service client cannot use account %d", fromAccount.ID)
+	}
+
+	transfer, err = h.Server.Store.UpdateTransferCategory(ctx, db.UpdateTransferCategoryParams{
+		ID: transfer.ID,
+		Category: pgtype.Text{
+			String: db.SpendingCategoryFromRequest(req.GetCategory()),
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update transfer category: %v", err)
+	}
+
+	return transfer.ToResponse(), nil
+}
+
+func validateSetTransferCategoryRequest(req *pb.SetTransferCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, core.FieldViolation("id", err))
+	}
+
+	if req.GetCategory() == pb.SpendingCategory_SPENDING_CATEGORY_UNSPECIFIED {
+		violations = append(violations, core.FieldViolation("category", fmt.Errorf("must be specified")))
+	}
+
+	return violations
+}
@@ -0,0 +1,189 @@
+package transfers
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetTransferCategory(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	otherUser, _ := testutil.RandomUser(t)
+
+	fromAccount := testutil.RandomAccount(user.Username)
+	toAccount := testutil.RandomAccount(otherUser.Username)
+
+	transfer := testutil.RandomTransfer(fromAccount.ID, toAccount.ID)
+
+	updatedTransfer := transfer
+	updatedTransfer.Category = pgtype.Text{String: util.CategoryDining, Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetTransferCategoryRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.Transfer, err error)
+	}{
+		{
+			"OK",
+			&pb.SetTransferCategoryRequest{
+				Id:       transfer.ID,
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(transfer, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					UpdateTransferCategory(gomock.Any(), gomock.Eq(db.UpdateTransferCategoryParams{
+						ID:       transfer.ID,
+						Category: pgtype.Text{String: util.CategoryDining, Valid: true},
+					})).
+					Times(1).
+					Return(updatedTransfer, nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Transfer, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, pb.SpendingCategory_SPENDING_CATEGORY_DINING, res.GetCategory())
+			},
+		},
+		{
+			"Recipient",
+			&pb.SetTransferCategoryRequest{
+				Id:       transfer.ID,
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(transfer, nil)
+
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).
+					Times(1).
+					Return(fromAccount, nil)
+
+				store.EXPECT().
+					UpdateTransferCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, otherUser.Username, otherUser.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Transfer, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"NotFound",
+			&pb.SetTransferCategoryRequest{
+				Id:       transfer.ID,
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(db.Transfer{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					UpdateTransferCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Transfer, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			"UnspecifiedCategory",
+			&pb.SetTransferCategoryRequest{
+				Id: transfer.ID,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.Transfer, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"Unauthenticated",
+			&pb.SetTransferCategoryRequest{
+				Id:       transfer.ID,
+				Category: pb.SpendingCategory_SPENDING_CATEGORY_DINING,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTransfer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			func(t *testing.T, res *pb.Transfer, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewTransferHandler(coreServer)
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+			res, err := handler.SetTransferCategory(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -1,7 +1,24 @@
 package users
 
 import (
+	"context"
 	"simplebank/api/core"
+	"simplebank/worker"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	mfaChallengePurpose = "mfa_challenge"
+	// maxMfaAttempts limits the codes that can be tried per login, as 6-digit codes are easy to guess otherwise
+	maxMfaAttempts = 5
+	totpIssuer     = "Simplebank"
+
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// recentActivityLimit is how many of the latest logins and calls the details of a user show
+	recentActivityLimit = 10
 )
 
 type UserHandler struct {
@@ -13,3 +30,17 @@ func NewUserHandler(server *core.Server) *UserHandler {
 		Server: server,
 	}
 }
+
+// distributeVerifyEmail sends a verification link to the current email address of the user.
+func (h *UserHandler) distributeVerifyEmail(ctx context.Context, username string) error {
+	taskPayload := &worker.PayloadSendVerifyEmail{
+		Username: username,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.ProcessIn(10 * time.Second),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	return h.Server.TaskDistributor.DistributeSendVerifyEmailTask(ctx, taskPayload, opts...)
+}
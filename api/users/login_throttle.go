@@ -0,0 +1,51 @@
+package users
+
+import (
+	"context"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errInvalidCredentials is the only error of a failed login, whether the username is unknown,
+// the password is wrong or the user is locked, so the response does not reveal which usernames exist.
+var errInvalidCredentials = status.Errorf(codes.Unauthenticated, "invalid credentials or account temporarily locked")
+
+var (
+	dummyPasswordHashOnce sync.Once
+	dummyPasswordHash     string
+)
+
+// unknownUserPasswordHash returns a hash to check passwords of unknown users against.
+func (h *UserHandler) unknownUserPasswordHash() string {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = h.Server.PasswordHasher.Hash(util.RandomString(16))
+	})
+
+	return dummyPasswordHash
+}
+
+// failLogin records the failed attempt and returns the uniform login error.
+func (h *UserHandler) failLogin(ctx context.Context, attempt db.CreateLoginAttemptParams, reason string) error {
+	if err := h.Server.RecordLoginFailure(ctx, attempt, reason); err != nil {
+		return err
+	}
+
+	return errInvalidCredentials
+}
+
+// recordLoginSuccess records a completed login, which resets the failures counted towards the lockout. With
+// two-factor authentication enabled a login is only complete once the second factor has been checked.
+func (h *UserHandler) recordLoginSuccess(ctx context.Context, attempt db.CreateLoginAttemptParams) error {
+	attempt.Succeeded = true
+
+	_, err := h.Server.Store.CreateLoginAttempt(ctx, attempt)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot record login attempt: %v", err)
+	}
+
+	return nil
+}
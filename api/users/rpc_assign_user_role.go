@@ -0,0 +1,61 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) AssignUserRole(ctx context.Context, req *pb.AssignUserRoleRequest) (*pb.User, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateAssignUserRoleRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Admins could otherwise lock themselves out by giving up the permission to assign roles
+	if req.GetUsername() == authPayload.Username {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change your own role")
+	}
+
+	txResult, err := h.Server.Store.AssignUserRoleTx(ctx, db.AssignUserRoleTxParams{
+		Username: req.GetUsername(),
+		Role:     req.GetRole(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			return nil, status.Errorf(codes.InvalidArgument, "role %s does not exist", req.GetRole())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to assign role: %s", err)
+	}
+
+	return txResult.User.ToResponse(), nil
+}
+
+func validateAssignUserRoleRequest(req *pb.AssignUserRoleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateRole(req.GetRole()); err != nil {
+		violations = append(violations, core.FieldViolation("role", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,118 @@
+package users
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAssignUserRoleAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	admin, _ := testutil.RandomUser(t)
+	admin.Role = util.AdminRole
+
+	testCases := []struct {
+		name          string
+		req           *pb.AssignUserRoleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.User, err error)
+	}{
+		{
+			"OK",
+			&pb.AssignUserRoleRequest{Username: user.Username, Role: util.AuditorRole},
+			func(store *mockdb.MockStore) {
+				updatedUser := user
+				updatedUser.Role = util.AuditorRole
+
+				store.EXPECT().
+					AssignUserRoleTx(gomock.Any(), gomock.Eq(db.AssignUserRoleTxParams{
+						Username: user.Username,
+						Role:     util.AuditorRole,
+					})).
+					Times(1).
+					Return(db.AssignUserRoleTxResult{User: updatedUser}, nil)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUsername())
+				require.Equal(t, util.AuditorRole, res.GetRole())
+			},
+		},
+		{
+			"OwnRole",
+			&pb.AssignUserRoleRequest{Username: admin.Username, Role: util.DepositorRole},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AssignUserRoleTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"UserNotFound",
+			&pb.AssignUserRoleRequest{Username: user.Username, Role: util.AuditorRole},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AssignUserRoleTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AssignUserRoleTxResult{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			"UnknownRole",
+			&pb.AssignUserRoleRequest{Username: user.Username, Role: "janitor"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AssignUserRoleTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AssignUserRoleTxResult{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			"InvalidRole",
+			&pb.AssignUserRoleRequest{Username: user.Username, Role: "Banker!"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AssignUserRoleTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, admin.Username, admin.Role, time.Minute)
+			res, err := handler.AssignUserRole(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) BlockUserSessions(ctx context.Context, req *pb.BlockUserSessionsRequest) (*pb.BlockUserSessionsResponse, error) {
+	violations := validateBlockUserSessionsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	_, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	count, err := h.Server.Store.BlockSessions(ctx, req.GetUsername())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+
+	return &pb.BlockUserSessionsResponse{
+		Count: count,
+	}, nil
+}
+
+func validateBlockUserSessionsRequest(req *pb.BlockUserSessionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
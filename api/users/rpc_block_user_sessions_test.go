@@ -0,0 +1,90 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockUserSessionsAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.BlockUserSessionsResponse, err error)
+	}{
+		{
+			"OK",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					BlockSessions(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(int64(2), nil)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(2), res.GetCount())
+			},
+		},
+		{
+			"UserNotFound",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					BlockSessions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+			res, err := handler.BlockUserSessions(ctx, &pb.BlockUserSessionsRequest{Username: user.Username})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
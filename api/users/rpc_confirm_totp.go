@@ -0,0 +1,78 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ConfirmTotp(ctx context.Context, req *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateConfirmTotpRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	credential, err := h.Server.Store.GetTotpCredential(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "authenticator has not been enrolled")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot get two-factor credential: %v", err)
+	}
+
+	if credential.ConfirmedAt.Valid {
+		return nil, status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+	}
+
+	// A valid first code proves the authenticator app holds the secret
+	err = core.VerifyTOTP(ctx, h.Server.Store, credential, req.GetCode())
+	if err != nil {
+		return nil, core.MfaError(err)
+	}
+
+	recoveryCodes, err := util.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	hashedRecoveryCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedRecoveryCodes[i] = util.HashToken(code)
+	}
+
+	_, err = h.Server.Store.ConfirmTotpTx(ctx, db.ConfirmTotpTxParams{
+		Username:            authPayload.Username,
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to confirm authenticator: %v", err)
+	}
+
+	return &pb.ConfirmTotpResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func validateConfirmTotpRequest(req *pb.ConfirmTotpRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTOTPCode(req.GetCode()); err != nil {
+		violations = append(violations, core.FieldViolation("code", err))
+	}
+
+	return violations
+}
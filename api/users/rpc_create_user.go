@@ -5,12 +5,8 @@ import (
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
-	"simplebank/util"
 	"simplebank/val"
-	"simplebank/worker"
-	"time"
 
-	"github.com/hibiken/asynq"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,7 +18,7 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		return nil, core.InvalidArgumentError(violations)
 	}
 
-	hashedPassword, err := util.HashPassword(req.GetPassword())
+	hashedPassword, err := h.Server.PasswordHasher.Hash(req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 	}
@@ -34,16 +30,9 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
 		},
+		ScreeningHits: h.Server.ScreenName(req.GetFullName()),
 		AfterCreate: func(user db.User) error {
-			taskPayload := &worker.PayloadSendVerifyEmail{
-				Username: user.Username,
-			}
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
-			}
-			return h.Server.TaskDistributor.DistributeSendVerifyEmailTask(ctx, taskPayload, opts...)
+			return h.distributeVerifyEmail(ctx, user.Username)
 		},
 	}
 
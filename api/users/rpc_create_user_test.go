@@ -8,11 +8,14 @@ import (
 	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
+	"simplebank/screening"
 	"simplebank/util"
 	"simplebank/worker"
 	mockwk "simplebank/worker/mock"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -97,6 +100,33 @@ func TestCreateUser(t *testing.T) {
 				require.Equal(t, user.Username, res.Username)
 				require.Equal(t, user.FullName, res.FullName)
 				require.Equal(t, user.Email, res.Email)
+				require.Nil(t, res.RestrictedAt)
+			},
+		},
+		{
+			"OnWatchlist",
+			&pb.CreateUserRequest{
+				Username: user.Username,
+				Password: password,
+				FullName: "Viktor Anatolijevitch Bout",
+				Email:    user.Email,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				restrictedUser := user
+				restrictedUser.FullName = "Viktor Anatolijevitch Bout"
+				restrictedUser.RestrictedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Cond(func(arg db.CreateUserTxParams) bool {
+						return len(arg.ScreeningHits) == 1 && arg.ScreeningHits[0].EntryID == "7157" &&
+							arg.ScreeningHits[0].Score >= screening.DefaultThreshold
+					})).
+					Times(1).
+					Return(db.CreateUserTxResult{User: restrictedUser}, nil)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res.RestrictedAt)
 			},
 		},
 	}
@@ -115,6 +145,9 @@ func TestCreateUser(t *testing.T) {
 
 			// start the server and send request
 			coreServer := testutil.NewTestServer(t, store, taskDistributor)
+			coreServer.Screener = screening.NewScreener([]screening.Entry{
+				{ID: "7157", Name: "BOUT, Viktor Anatolijevitch", Programs: []string{"SDGT"}},
+			}, 0)
 			handler := NewUserHandler(coreServer)
 			res, err := handler.CreateUser(context.Background(), tc.body)
 			tc.checkResponse(t, res, err)
@@ -0,0 +1,51 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserHandler) DisableTotp(ctx context.Context, req *pb.DisableTotpRequest) (*emptypb.Empty, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateDisableTotpRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	// A stolen access token alone must not be enough to turn off the second factor, nor to guess it
+	err = h.Server.VerifyCredential(ctx, user, core.LoginFailureWrongMfaCode, func() error {
+		return core.VerifySecondFactor(ctx, h.Server.Store, user.Username, req.GetCode(), req.GetRecoveryCode())
+	})
+	if err != nil {
+		return nil, core.MfaError(err)
+	}
+
+	err = h.Server.Store.DisableTotpTx(ctx, db.DisableTotpTxParams{
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to disable two-factor authentication: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateDisableTotpRequest(req *pb.DisableTotpRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	return validateSecondFactor(req.Code, req.RecoveryCode)
+}
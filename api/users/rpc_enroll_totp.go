@@ -0,0 +1,45 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) EnrollTotp(ctx context.Context, req *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	// Enrolling again before confirming replaces the secret, e.g. when the QR code was never scanned
+	credential, err := h.Server.Store.UpsertTotpCredential(ctx, db.UpsertTotpCredentialParams{
+		Username: authPayload.Username,
+		Secret:   secret,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to enroll authenticator: %v", err)
+	}
+
+	response := &pb.EnrollTotpResponse{
+		Secret:          credential.Secret,
+		ProvisioningUri: util.TOTPProvisioningURI(totpIssuer, credential.Username, credential.Secret),
+	}
+
+	return response, nil
+}
@@ -0,0 +1,77 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"simplebank/worker"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.DataRequest, error) {
+	violations := validateExportMyDataRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	// The archive is sent by email, and changing the address does not need the password
+	if !user.IsEmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address must be verified first")
+	}
+
+	// Building the archive is expensive, so exports are limited to one per interval
+	arg := db.CreateDataExportRequestTxParams{
+		CreateDataExportRequestParams: db.CreateDataExportRequestParams{
+			Username:     user.Username,
+			CreatedAfter: time.Now().Add(-h.Server.Config.DataExportInterval),
+		},
+		AfterCreate: func(dataRequest db.DataRequest) error {
+			taskPayload := &worker.PayloadExportUserData{
+				DataRequestID: dataRequest.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(5),
+				asynq.ProcessIn(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+
+			return h.Server.TaskDistributor.DistributeExportUserDataTask(ctx, taskPayload, opts...)
+		},
+	}
+
+	txResult, err := h.Server.Store.CreateDataExportRequestTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.ResourceExhausted, "data can be exported once every %s", h.Server.Config.DataExportInterval)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to request data export: %s", err)
+	}
+
+	return txResult.DataRequest.ToResponse(), nil
+}
+
+func validateExportMyDataRequest(req *pb.ExportMyDataRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
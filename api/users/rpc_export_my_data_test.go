@@ -0,0 +1,188 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eqCreateDataExportRequestTxParamsMatcher struct {
+	username    string
+	dataRequest db.DataRequest
+}
+
+func (expected eqCreateDataExportRequestTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.CreateDataExportRequestTxParams)
+	if !ok {
+		return false
+	}
+
+	// the previous export has to be at least the configured interval ago
+	if actualArg.Username != expected.username || time.Since(actualArg.CreatedAfter) < time.Hour-time.Minute {
+		return false
+	}
+
+	err := actualArg.AfterCreate(expected.dataRequest)
+
+	return err == nil
+}
+
+func (expected eqCreateDataExportRequestTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches export request of %s", expected.username)
+}
+
+func EqCreateDataExportRequestTxParams(username string, dataRequest db.DataRequest) gomock.Matcher {
+	return eqCreateDataExportRequestTxParamsMatcher{username, dataRequest}
+}
+
+func TestExportMyData(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	dataRequest := db.DataRequest{
+		ID:        util.RandomInt(1, 1000),
+		Username:  user.Username,
+		Kind:      util.DataRequestExport,
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ExportMyDataRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.DataRequest, err error)
+	}{
+		{
+			"OK",
+			&pb.ExportMyDataRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateDataExportRequestTx(gomock.Any(), EqCreateDataExportRequestTxParams(user.Username, dataRequest)).
+					Times(1).
+					Return(db.CreateDataExportRequestTxResult{DataRequest: dataRequest}, nil)
+
+				taskPayload := &worker.PayloadExportUserData{
+					DataRequestID: dataRequest.ID,
+				}
+
+				distributor.EXPECT().
+					DistributeExportUserDataTask(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, res *pb.DataRequest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, dataRequest.ID, res.GetId())
+				require.Equal(t, util.DataRequestExport, res.GetKind())
+				require.Nil(t, res.CompletedAt)
+			},
+		},
+		{
+			"ExportedRecently",
+			&pb.ExportMyDataRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateDataExportRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateDataExportRequestTxResult{}, db.ErrRecordNotFound)
+
+				distributor.EXPECT().
+					DistributeExportUserDataTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.DataRequest, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"EmailNotVerified",
+			&pb.ExportMyDataRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				unverifiedUser := user
+				unverifiedUser.IsEmailVerified = false
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(unverifiedUser, nil)
+
+				store.EXPECT().
+					CreateDataExportRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.DataRequest, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"InvalidUsername",
+			&pb.ExportMyDataRequest{
+				Username: "Invalid-User",
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateDataExportRequestTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.DataRequest, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			distributorCtrl := gomock.NewController(t)
+			defer distributorCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(distributorCtrl)
+
+			tc.buildStubs(store, distributor)
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.ExportMyData(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
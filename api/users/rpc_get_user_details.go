@@ -0,0 +1,103 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) GetUserDetails(ctx context.Context, req *pb.GetUserDetailsRequest) (*pb.GetUserDetailsResponse, error) {
+	violations := validateGetUserDetailsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	accounts, err := h.Server.Store.ListAccountsByOwner(ctx, user.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %v", err)
+	}
+
+	documents, err := h.Server.Store.ListKycDocumentsByUsername(ctx, user.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list KYC documents: %v", err)
+	}
+
+	sessions, err := h.Server.Store.ListActiveSessions(ctx, user.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	attempts, err := h.Server.Store.ListLoginAttempts(ctx, db.ListLoginAttemptsParams{
+		Username: user.Username,
+		PageSize: recentActivityLimit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list login attempts: %v", err)
+	}
+
+	events, err := h.Server.Store.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		Actor:    pgtype.Text{String: user.Username, Valid: true},
+		PageSize: recentActivityLimit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+
+	response := &pb.GetUserDetailsResponse{
+		User:                user.ToResponse(),
+		Accounts:            make([]*pb.Account, len(accounts)),
+		KycDocuments:        make([]*pb.KycDocument, len(documents)),
+		Sessions:            make([]*pb.Session, len(sessions)),
+		RecentLoginAttempts: make([]*pb.LoginAttempt, len(attempts)),
+		RecentAuditEvents:   make([]*pb.AuditEvent, len(events)),
+	}
+
+	for i, account := range accounts {
+		response.Accounts[i] = account.ToResponse()
+	}
+
+	for i, document := range documents {
+		response.KycDocuments[i] = document.ToResponse()
+	}
+
+	// None of the sessions is the one of the banker asking
+	for i, session := range sessions {
+		response.Sessions[i] = session.ToResponse(uuid.Nil)
+	}
+
+	for i, attempt := range attempts {
+		response.RecentLoginAttempts[i] = attempt.ToResponse()
+	}
+
+	for i, event := range events {
+		response.RecentAuditEvents[i] = event.ToResponse()
+	}
+
+	return response, nil
+}
+
+func validateGetUserDetailsRequest(req *pb.GetUserDetailsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,111 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetUserDetailsAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	account := testutil.RandomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetUserDetailsResponse, err error)
+	}{
+		{
+			"OK",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					ListAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return([]db.Account{account}, nil)
+
+				store.EXPECT().
+					ListKycDocumentsByUsername(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return([]db.KycDocument{{ID: 1, Username: user.Username}}, nil)
+
+				store.EXPECT().
+					ListActiveSessions(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return([]db.Session{{ID: uuid.New(), Username: user.Username}}, nil)
+
+				store.EXPECT().
+					ListLoginAttempts(gomock.Any(), gomock.Eq(db.ListLoginAttemptsParams{Username: user.Username, PageSize: recentActivityLimit})).
+					Times(1).
+					Return([]db.LoginAttempt{{ID: 1, Username: user.Username, Succeeded: true}}, nil)
+
+				store.EXPECT().
+					ListAuditEvents(gomock.Any(), gomock.Eq(db.ListAuditEventsParams{
+						Actor:    pgtype.Text{String: user.Username, Valid: true},
+						PageSize: recentActivityLimit,
+					})).
+					Times(1).
+					Return([]db.AuditEvent{}, nil)
+			},
+			func(t *testing.T, res *pb.GetUserDetailsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.Equal(t, user.KycStatus, res.GetUser().GetKycStatus())
+				require.Len(t, res.GetAccounts(), 1)
+				require.Equal(t, account.Number, res.GetAccounts()[0].GetNumber())
+				require.Len(t, res.GetKycDocuments(), 1)
+				require.Len(t, res.GetSessions(), 1)
+				require.False(t, res.GetSessions()[0].GetCurrent())
+				require.Len(t, res.GetRecentLoginAttempts(), 1)
+				require.Empty(t, res.GetRecentAuditEvents())
+			},
+		},
+		{
+			"UserNotFound",
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					ListAccountsByOwner(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.GetUserDetailsResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.GetUserDetails(context.Background(), &pb.GetUserDetailsRequest{Username: user.Username})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) ImpersonateUser(ctx context.Context, req *pb.ImpersonateUserRequest) (*pb.ImpersonateUserResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateImpersonateUserRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Impersonations belong to the session of the impersonator, which service clients do not have
+	if _, ok := core.ServiceClientFromContext(ctx); ok {
+		return nil, status.Errorf(codes.PermissionDenied, "service clients cannot impersonate users")
+	}
+
+	if req.GetAllowWrites() && !core.HasPermission(ctx, util.UsersImpersonateWritePermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "missing permission %s", util.UsersImpersonateWritePermission)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	// Acting as another employee would let the impersonator use permissions their own role does not have
+	if user.Role != util.DepositorRole {
+		return nil, status.Errorf(codes.FailedPrecondition, "only customers can be impersonated")
+	}
+
+	payload, err := token.NewImpersonationPayload(uuid.New(), authPayload.Username, user.Username, user.Role, !req.GetAllowWrites(), h.Server.Config.ImpersonationDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %s", err)
+	}
+
+	impersonation, err := h.Server.Store.CreateImpersonation(ctx, db.CreateImpersonationParams{
+		ID:                    payload.SessionID,
+		Impersonator:          authPayload.Username,
+		ImpersonatorSessionID: authPayload.SessionID,
+		Username:              user.Username,
+		Reason:                req.GetReason(),
+		ReadOnly:              payload.ReadOnly,
+		ExpiresAt:             payload.ExpiredAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create impersonation: %s", err)
+	}
+
+	accessToken, err := h.Server.TokenMaker.IssueToken(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %s", err)
+	}
+
+	return &pb.ImpersonateUserResponse{
+		Impersonation:        impersonation.ToResponse(0),
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: timestamppb.New(payload.ExpiredAt),
+	}, nil
+}
+
+func validateImpersonateUserRequest(req *pb.ImpersonateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 3, 200); err != nil {
+		violations = append(violations, core.FieldViolation("reason", err))
+	}
+
+	return violations
+}
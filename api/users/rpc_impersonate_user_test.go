@@ -0,0 +1,182 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImpersonateUserAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+	agent := util.RandomOwner()
+
+	createImpersonation := func(store *mockdb.MockStore, readOnly bool) {
+		store.EXPECT().
+			CreateImpersonation(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, arg db.CreateImpersonationParams) (db.Impersonation, error) {
+				require.Equal(t, agent, arg.Impersonator)
+				require.Equal(t, user.Username, arg.Username)
+				require.Equal(t, readOnly, arg.ReadOnly)
+				require.NotEqual(t, uuid.Nil, arg.ImpersonatorSessionID)
+
+				return db.Impersonation{
+					ID:                    arg.ID,
+					Impersonator:          arg.Impersonator,
+					ImpersonatorSessionID: arg.ImpersonatorSessionID,
+					Username:              arg.Username,
+					Reason:                arg.Reason,
+					ReadOnly:              arg.ReadOnly,
+					ExpiresAt:             arg.ExpiresAt,
+					CreatedAt:             time.Now(),
+				}, nil
+			})
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ImpersonateUserRequest
+		permissions   []string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ImpersonateUserRequest{Username: user.Username, Reason: "customer cannot find a transfer"},
+			nil,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				createImpersonation(store, true)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetImpersonation().GetReadOnly())
+				require.Equal(t, "customer cannot find a transfer", res.GetImpersonation().GetReason())
+
+				payload, err := tokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.Equal(t, user.Username, payload.Username)
+				require.Equal(t, user.Role, payload.Role)
+				require.Equal(t, agent, payload.Impersonator)
+				require.True(t, payload.ReadOnly)
+				require.Equal(t, res.GetImpersonation().GetId(), payload.SessionID.String())
+				require.WithinDuration(t, time.Now().Add(time.Minute), payload.ExpiredAt, time.Second)
+			},
+		},
+		{
+			"AllowWrites",
+			&pb.ImpersonateUserRequest{Username: user.Username, Reason: "customer cannot open an account", AllowWrites: true},
+			[]string{"users:impersonate", util.UsersImpersonateWritePermission},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				createImpersonation(store, false)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetImpersonation().GetReadOnly())
+
+				payload, err := tokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.False(t, payload.ReadOnly)
+			},
+		},
+		{
+			"AllowWritesWithoutPermission",
+			&pb.ImpersonateUserRequest{Username: user.Username, Reason: "customer cannot open an account", AllowWrites: true},
+			[]string{"users:impersonate"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateImpersonation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			"NotACustomer",
+			&pb.ImpersonateUserRequest{Username: banker.Username, Reason: "borrow some permissions"},
+			nil,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(banker.Username)).
+					Times(1).
+					Return(banker, nil)
+
+				store.EXPECT().
+					CreateImpersonation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			"UserNotFound",
+			&pb.ImpersonateUserRequest{Username: user.Username, Reason: "customer cannot find a transfer"},
+			nil,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			"MissingReason",
+			&pb.ImpersonateUserRequest{Username: user.Username},
+			nil,
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker, res *pb.ImpersonateUserResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, agent, util.SupportRole, time.Minute)
+			ctx = core.ContextWithPermissions(ctx, tc.permissions)
+			res, err := handler.ImpersonateUser(ctx, tc.req)
+			tc.checkResponse(t, coreServer.TokenMaker, res, err)
+		})
+	}
+}
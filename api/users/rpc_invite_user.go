@@ -0,0 +1,98 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+	"simplebank/worker"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.User, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	violations := validateInviteUserRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Nobody knows this password, the customer chooses their own with the link in the invitation
+	placeholderPassword, err := util.GenerateSecureToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password: %s", err)
+	}
+
+	hashedPassword, err := h.Server.PasswordHasher.Hash(placeholderPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+	}
+
+	arg := db.CreateUserTxParams{
+		CreateUserParams: db.CreateUserParams{
+			Username:       req.GetUsername(),
+			HashedPassword: hashedPassword,
+			FullName:       req.GetFullName(),
+			Email:          req.GetEmail(),
+			InvitedBy:      pgtype.Text{String: authPayload.Username, Valid: true},
+		},
+		ScreeningHits: h.Server.ScreenName(req.GetFullName()),
+		AfterCreate: func(user db.User) error {
+			err := h.distributeVerifyEmail(ctx, user.Username)
+			if err != nil {
+				return err
+			}
+
+			return h.distributeInvitationEmail(ctx, user.Username)
+		},
+	}
+
+	txResult, err := h.Server.Store.CreateUserTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "username or email already exists")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create user: %s", err)
+	}
+
+	return txResult.User.ToResponse(), nil
+}
+
+func (h *UserHandler) distributeInvitationEmail(ctx context.Context, username string) error {
+	taskPayload := &worker.PayloadSendInvitationEmail{
+		Username: username,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	return h.Server.TaskDistributor.DistributeSendInvitationEmailTask(ctx, taskPayload, opts...)
+}
+
+func validateInviteUserRequest(req *pb.InviteUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateFullName(req.GetFullName()); err != nil {
+		violations = append(violations, core.FieldViolation("full_name", err))
+	}
+
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, core.FieldViolation("email", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,129 @@
+package users
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInviteUserAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	invitedUser := user
+	invitedUser.InvitedBy = pgtype.Text{String: banker.Username, Valid: true}
+
+	req := &pb.InviteUserRequest{
+		Username: user.Username,
+		FullName: user.FullName,
+		Email:    user.Email,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.InviteUserRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.User, err error)
+	}{
+		{
+			"OK",
+			req,
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Cond(func(arg db.CreateUserTxParams) bool {
+						return arg.Username == user.Username &&
+							arg.Email == user.Email &&
+							arg.InvitedBy.String == banker.Username &&
+							arg.HashedPassword != "" &&
+							arg.AfterCreate(invitedUser) == nil
+					})).
+					Times(1).
+					Return(db.CreateUserTxResult{User: invitedUser}, nil)
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), gomock.Eq(&worker.PayloadSendVerifyEmail{Username: user.Username}), gomock.Any()).
+					Times(1)
+
+				distributor.EXPECT().
+					DistributeSendInvitationEmailTask(gomock.Any(), gomock.Eq(&worker.PayloadSendInvitationEmail{Username: user.Username}), gomock.Any()).
+					Times(1)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUsername())
+				require.Equal(t, banker.Username, res.GetInvitedBy())
+			},
+		},
+		{
+			"AlreadyExists",
+			req,
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateUserTxResult{}, &pgconn.PgError{Code: db.UniqueViolation})
+
+				distributor.EXPECT().
+					DistributeSendInvitationEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			"InvalidEmail",
+			&pb.InviteUserRequest{
+				Username: user.Username,
+				FullName: user.FullName,
+				Email:    "invalid-email",
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, distributor)
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			handler := NewUserHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, banker.Username, banker.Role, time.Minute)
+			res, err := handler.InviteUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ListImpersonations(ctx context.Context, req *pb.ListImpersonationsRequest) (*pb.ListImpersonationsResponse, error) {
+	violations := validateListImpersonationsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListImpersonationsParams{
+		Username: req.GetUsername(),
+		PageSize: int32(pageSize),
+	}
+
+	// Impersonations have random ids, so the cursor is when the last impersonation of the previous page started
+	if req.GetCursor() != "" {
+		createdBefore, _ := time.Parse(time.RFC3339Nano, req.GetCursor())
+		arg.CreatedBefore = pgtype.Timestamptz{Time: createdBefore, Valid: true}
+	}
+
+	rows, err := h.Server.Store.ListImpersonations(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list impersonations: %v", err)
+	}
+
+	response := &pb.ListImpersonationsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(rows)),
+		},
+		Data: make([]*pb.Impersonation, len(rows)),
+	}
+
+	for i, row := range rows {
+		response.Data[i] = row.Impersonation.ToResponse(row.Calls)
+	}
+
+	if int64(len(rows)) == pageSize {
+		next := rows[len(rows)-1].Impersonation.CreatedAt.Format(time.RFC3339Nano)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListImpersonationsRequest(req *pb.ListImpersonationsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if req.GetCursor() != "" {
+		if _, err := time.Parse(time.RFC3339Nano, req.GetCursor()); err != nil {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
@@ -0,0 +1,80 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ListLoginAttempts(ctx context.Context, req *pb.ListLoginAttemptsRequest) (*pb.ListLoginAttemptsResponse, error) {
+	violations := validateListLoginAttemptsRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.ListLoginAttemptsParams{
+		Username: req.GetUsername(),
+		PageSize: int32(pageSize),
+	}
+
+	// The cursor is the id of the last attempt of the previous page, attempts are listed newest first
+	if req.GetCursor() != "" {
+		beforeID, _ := strconv.ParseInt(req.GetCursor(), 10, 64)
+		arg.BeforeID = pgtype.Int8{Int64: beforeID, Valid: true}
+	}
+
+	attempts, err := h.Server.Store.ListLoginAttempts(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list login attempts: %v", err)
+	}
+
+	response := &pb.ListLoginAttemptsResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(attempts)),
+		},
+		Data: make([]*pb.LoginAttempt, len(attempts)),
+	}
+
+	for i, attempt := range attempts {
+		response.Data[i] = attempt.ToResponse()
+	}
+
+	if int64(len(attempts)) == pageSize {
+		next := strconv.FormatInt(attempts[len(attempts)-1].ID, 10)
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateListLoginAttemptsRequest(req *pb.ListLoginAttemptsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if req.GetCursor() != "" {
+		if id, err := strconv.ParseInt(req.GetCursor(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
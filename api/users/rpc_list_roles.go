@@ -0,0 +1,29 @@
+package users
+
+import (
+	"context"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	roles, err := h.Server.Store.ListRoles(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list roles: %s", err)
+	}
+
+	data := make([]*pb.Role, len(roles))
+	for i, role := range roles {
+		data[i] = &pb.Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		}
+	}
+
+	return &pb.ListRolesResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	sessions, err := h.Server.Store.ListActiveSessions(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %s", err)
+	}
+
+	data := make([]*pb.Session, len(sessions))
+	for i, session := range sessions {
+		data[i] = session.ToResponse(authPayload.SessionID)
+	}
+
+	return &pb.ListSessionsResponse{
+		Data: data,
+	}, nil
+}
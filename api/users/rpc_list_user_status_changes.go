@@ -0,0 +1,42 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ListUserStatusChanges(ctx context.Context, req *pb.ListUserStatusChangesRequest) (*pb.ListUserStatusChangesResponse, error) {
+	violations := validateListUserStatusChangesRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	statusChanges, err := h.Server.Store.ListUserStatusChanges(ctx, req.GetUsername())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user status changes: %v", err)
+	}
+
+	response := &pb.ListUserStatusChangesResponse{
+		Data: make([]*pb.UserStatusChange, len(statusChanges)),
+	}
+
+	for i, statusChange := range statusChanges {
+		response.Data[i] = statusChange.ToResponse()
+	}
+
+	return response, nil
+}
+
+func validateListUserStatusChangesRequest(req *pb.ListUserStatusChangesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
@@ -6,10 +6,14 @@ import (
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
+	"simplebank/token"
 	"simplebank/util"
 	"simplebank/val"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,38 +26,143 @@ func (h *UserHandler) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, core.InvalidArgumentError(violations)
 	}
 
+	metadata := core.ExtractMetadata(ctx)
+	attempt := db.CreateLoginAttemptParams{
+		Username:  req.GetUsername(),
+		ClientIp:  metadata.ClientIP,
+		UserAgent: metadata.UserAgent,
+	}
+
+	// Throttling is keyed by the username as entered, so it behaves the same for unknown users
+	failures, err := h.Server.CheckLoginThrottle(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "cannot find user: %v", err)
+			// Spend as long as for a known user, so response times do not reveal which usernames exist
+			_ = util.CheckPassword(req.GetPassword(), h.unknownUserPasswordHash())
+			return nil, h.failLogin(ctx, attempt, core.LoginFailureUnknownUser)
 		}
 
 		return nil, status.Errorf(codes.Internal, "cannot get user: %v", err)
 	}
 
-	err = util.CheckPassword(req.GetPassword(), user.HashedPassword)
+	passwordErr := util.CheckPassword(req.GetPassword(), user.HashedPassword)
+
+	if user.LockedUntil.Valid && time.Now().Before(user.LockedUntil.Time) {
+		return nil, h.failLogin(ctx, attempt, core.LoginFailureLocked)
+	}
+
+	if passwordErr != nil {
+		err = h.failLogin(ctx, attempt, core.LoginFailureWrongPassword)
+		if failures+1 >= h.Server.Config.LoginMaxFailures {
+			if lockErr := h.Server.LockUser(ctx, user, metadata.ClientIP); lockErr != nil {
+				return nil, lockErr
+			}
+		}
+
+		return nil, err
+	}
+
+	// Only someone who knows the password learns that the user has been suspended
+	if err = core.CheckUserActive(user.Status); err != nil {
+		if recordErr := h.Server.RecordLoginFailure(ctx, attempt, core.LoginFailureInactive); recordErr != nil {
+			return nil, recordErr
+		}
+
+		return nil, err
+	}
+
+	// The plaintext is only at hand now, so this is when hashes made with outdated parameters can be upgraded
+	if h.Server.PasswordHasher.NeedsRehash(user.HashedPassword) {
+		h.rehashPassword(ctx, user, req.GetPassword())
+	}
+
+	credential, err := h.Server.Store.GetTotpCredential(ctx, user.Username)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "cannot get two-factor credential: %v", err)
+	}
+
+	// With two-factor authentication enabled the password alone only earns a challenge,
+	// tokens are issued by VerifyLoginMfa once a valid code is presented
+	if err == nil && credential.ConfirmedAt.Valid {
+		return h.createMfaChallenge(ctx, user)
+	}
+
+	err = h.recordLoginSuccess(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.createSession(ctx, user)
+}
+
+// rehashPassword stores a new hash of the password. Failing to do so does not fail the login,
+// the upgrade is retried on the next one.
+func (h *UserHandler) rehashPassword(ctx context.Context, user db.User, password string) {
+	hashedPassword, err := h.Server.PasswordHasher.Hash(password)
+	if err == nil {
+		// Only replaces the hash that was checked, so a concurrent password change wins
+		_, err = h.Server.Store.RehashUserPassword(ctx, db.RehashUserPasswordParams{
+			Username:          user.Username,
+			OldHashedPassword: user.HashedPassword,
+			NewHashedPassword: hashedPassword,
+		})
+	}
+
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		log.Warn().
+			Err(err).
+			Str("username", user.Username).
+			Msg("cannot rehash password")
+	}
+}
+
+func (h *UserHandler) createMfaChallenge(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
+	challenge, err := h.Server.Store.CreateMfaChallenge(ctx, db.CreateMfaChallengeParams{
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(h.Server.Config.MfaChallengeDuration),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create mfa challenge: %v", err)
+	}
+
+	response := &pb.LoginUserResponse{
+		MfaRequired:       true,
+		MfaToken:          h.Server.Signer.Sign(mfaChallengePurpose, challenge.ID, challenge.ExpiresAt),
+		MfaTokenExpiresAt: timestamppb.New(challenge.ExpiresAt),
+	}
+	return response, nil
+}
+
+func (h *UserHandler) createSession(ctx context.Context, user db.User) (*pb.LoginUserResponse, error) {
+	sessionID, err := uuid.NewRandom()
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
+		return nil, status.Errorf(codes.Internal, "cannot create session id: %v", err)
 	}
 
-	accessToken, accessPayload, err := h.Server.TokenMaker.CreateToken(user.Username, user.Role, h.Server.Config.AccessTokenDuration)
+	// Both tokens carry the session id, so blocking the session revokes them together
+	accessToken, accessPayload, err := h.Server.TokenMaker.CreateToken(user.Username, user.Role, sessionID, h.Server.Config.AccessTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot create access token: %v", err)
 	}
 
-	refreshToken, refreshPayload, err := h.Server.TokenMaker.CreateToken(user.Username, user.Role, h.Server.Config.RefreshTokenDuration)
+	refreshToken, refreshPayload, err := token.CreateRefreshToken(h.Server.TokenMaker, user.Username, user.Role, sessionID, h.Server.Config.RefreshTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot create refresh token: %v", err)
 	}
 
 	metadata := core.ExtractMetadata(ctx)
 	session, err := h.Server.Store.CreateSession(ctx, db.CreateSessionParams{
-		ID:           refreshPayload.ID,
-		Username:     user.Username,
-		RefreshToken: refreshToken,
-		UserAgent:    metadata.UserAgent,
-		ClientIp:     metadata.ClientIP,
-		IsBlocked:    false,
+		ID:                 sessionID,
+		Username:           user.Username,
+		HashedRefreshToken: util.HashToken(refreshToken),
+		UserAgent:          metadata.UserAgent,
+		ClientIp:           metadata.ClientIP,
+		IsBlocked:          false,
 		ExpiresAt: pgtype.Timestamp{
 			Time:  refreshPayload.ExpiredAt,
 			Valid: true,
@@ -79,7 +188,8 @@ func validateLoginUserRequest(req *pb.LoginUserRequest) (violations []*errdetail
 		violations = append(violations, core.FieldViolation("username", err))
 	}
 
-	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+	// The password policy only applies to new passwords, existing ones may predate it
+	if err := val.ValidateString(req.GetPassword(), 1, val.MaxPasswordLength); err != nil {
 		violations = append(violations, core.FieldViolation("password", err))
 	}
 
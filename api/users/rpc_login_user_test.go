@@ -0,0 +1,479 @@
+package users
+
+import (
+	"context"
+	"database/sql"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	mockwk "simplebank/worker/mock"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func loginAttempt(username string, failureReason string) db.CreateLoginAttemptParams {
+	return db.CreateLoginAttemptParams{
+		Username:  username,
+		Succeeded: failureReason == "",
+		FailureReason: pgtype.Text{
+			String: failureReason,
+			Valid:  failureReason != "",
+		},
+	}
+}
+
+func TestLoginUser(t *testing.T) {
+	user, password := testutil.RandomUser(t)
+
+	legacyHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	legacyUser := user
+	legacyUser.HashedPassword = string(legacyHash)
+
+	confirmedCredential := db.TotpCredential{
+		Username:    user.Username,
+		Secret:      "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		ConfirmedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.LoginUserRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetMfaRequired())
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+			},
+		},
+		{
+			"RehashLegacyPassword",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(legacyUser, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					RehashUserPassword(gomock.Any(), gomock.Cond(func(arg db.RehashUserPasswordParams) bool {
+						return arg.Username == user.Username &&
+							arg.OldHashedPassword == legacyUser.HashedPassword &&
+							strings.HasPrefix(arg.NewHashedPassword, "$argon2id$") &&
+							util.CheckPassword(password, arg.NewHashedPassword) == nil
+					})).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			"RehashFailureDoesNotFailLogin",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(legacyUser, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					RehashUserPassword(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, sql.ErrConnDone)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			"PendingEnrollment",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.TotpCredential{Username: user.Username}, nil)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetMfaRequired())
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			"MfaRequired",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				// the login is not complete until the second factor is checked
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(confirmedCredential, nil)
+
+				store.EXPECT().
+					CreateMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MfaChallenge{ID: 1, Username: user.Username, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetMfaRequired())
+				require.NotEmpty(t, res.GetMfaToken())
+				require.Empty(t, res.GetAccessToken())
+				require.Empty(t, res.GetRefreshToken())
+				require.Nil(t, res.GetUser())
+			},
+		},
+		{
+			"WrongPassword",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password + "x",
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureWrongPassword))).
+					Times(1)
+
+				store.EXPECT().
+					LockUser(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"UnknownUser",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureUnknownUser))).
+					Times(1)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Equal(t, errInvalidCredentials, err)
+			},
+		},
+		{
+			"LockedUser",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				lockedUser := user
+				lockedUser.LockedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(lockedUser, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureLocked))).
+					Times(1)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Equal(t, errInvalidCredentials, err)
+			},
+		},
+		{
+			"SuspendedUser",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				suspendedUser := user
+				suspendedUser.Status = util.UserStatusSuspended
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(suspendedUser, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureInactive))).
+					Times(1)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"LockAfterMaxFailures",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password + "x",
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				// the retry delay after the second failure has passed
+				testutil.ExpectLoginThrottle(store, 0, 2)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureWrongPassword))).
+					Times(1)
+
+				lockedUser := user
+				lockedUser.LockedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+
+				store.EXPECT().
+					LockUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(lockedUser, nil)
+
+				distributor.EXPECT().
+					DistributeSendAccountLockedEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Equal(t, errInvalidCredentials, err)
+			},
+		},
+		{
+			"Throttled",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CountLoginFailuresByIP(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), nil)
+
+				store.EXPECT().
+					GetLoginFailureStats(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetLoginFailureStatsRow{Failures: 2, LastFailedAt: time.Now()}, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureThrottled))).
+					Times(1)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"IPThrottled",
+			&pb.LoginUserRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					CountLoginFailuresByIP(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(10), nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureIPThrottled))).
+					Times(1)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			distributorCtrl := gomock.NewController(t)
+			defer distributorCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(distributorCtrl)
+
+			tc.buildStubs(store, distributor)
+
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			coreServer.Config.RefreshTokenDuration = time.Hour
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.LoginUser(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
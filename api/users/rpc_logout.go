@@ -0,0 +1,34 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	_, err = h.Server.Store.BlockSession(ctx, db.BlockSessionParams{
+		ID:       authPayload.SessionID,
+		Username: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to block session: %s", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,78 @@
+package users
+
+import (
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestLogoutAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, arg db.BlockSessionParams)
+		checkResponse func(t *testing.T, res *emptypb.Empty, err error)
+	}{
+		{
+			"OK",
+			func(store *mockdb.MockStore, arg db.BlockSessionParams) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Session{ID: arg.ID, Username: arg.Username, IsBlocked: true}, nil)
+			},
+			func(t *testing.T, res *emptypb.Empty, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			},
+		},
+		{
+			"SessionNotFound",
+			func(store *mockdb.MockStore, arg db.BlockSessionParams) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.Session{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, res *emptypb.Empty, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, user.Username, user.Role, time.Minute)
+			payload, err := core.AuthPayloadFromContext(ctx)
+			require.NoError(t, err)
+
+			tc.buildStubs(store, db.BlockSessionParams{
+				ID:       payload.SessionID,
+				Username: user.Username,
+			})
+
+			res, err := handler.Logout(ctx, &pb.LogoutRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
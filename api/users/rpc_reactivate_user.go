@@ -0,0 +1,38 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (h *UserHandler) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*pb.ChangeUserStatusResponse, error) {
+	violations := validateReactivateUserRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	return h.changeUserStatus(ctx, db.ChangeUserStatusTxParams{
+		Username:         req.GetUsername(),
+		Status:           util.UserStatusActive,
+		Reason:           req.GetReason(),
+		UnfreezeAccounts: req.GetUnfreezeAccounts(),
+	})
+}
+
+func validateReactivateUserRequest(req *pb.ReactivateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+		violations = append(violations, core.FieldViolation("reason", err))
+	}
+
+	return violations
+}
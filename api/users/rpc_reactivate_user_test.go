@@ -0,0 +1,98 @@
+package users
+
+import (
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReactivateUserAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReactivateUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ChangeUserStatusResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ReactivateUserRequest{Username: user.Username, Reason: "identity confirmed by phone", UnfreezeAccounts: true},
+			func(store *mockdb.MockStore) {
+				arg := db.ChangeUserStatusTxParams{
+					Username:         user.Username,
+					Status:           util.UserStatusActive,
+					Reason:           "identity confirmed by phone",
+					ChangedBy:        banker.Username,
+					UnfreezeAccounts: true,
+				}
+
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{
+						User: user,
+						StatusChange: db.UserStatusChange{
+							Username:       user.Username,
+							PreviousStatus: util.UserStatusSuspended,
+							Status:         util.UserStatusActive,
+							Reason:         arg.Reason,
+							ChangedBy:      banker.Username,
+						},
+						Accounts: []db.Account{testutil.RandomAccount(user.Username)},
+					}, nil)
+			},
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.UserStatusActive, res.GetUser().GetStatus())
+				require.Equal(t, util.UserStatusSuspended, res.GetStatusChange().GetPreviousStatus())
+				require.Equal(t, "identity confirmed by phone", res.GetStatusChange().GetReason())
+				require.Zero(t, res.GetBlockedSessions())
+				require.Len(t, res.GetAccounts(), 1)
+				require.Nil(t, res.GetAccounts()[0].GetFrozenAt())
+			},
+		},
+		{
+			"AlreadyActive",
+			&pb.ReactivateUserRequest{Username: user.Username, Reason: "identity confirmed by phone"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{}, db.ErrUserStatusUnchanged)
+			},
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := testutil.NewContextWithBearerToken(t, coreServer.TokenMaker, banker.Username, banker.Role, time.Minute)
+			res, err := handler.ReactivateUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
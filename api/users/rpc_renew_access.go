@@ -3,11 +3,15 @@ package users
 import (
 	"context"
 	"errors"
+	"fmt"
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
 	"time"
 
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,7 +23,12 @@ func (h *UserHandler) RenewAccess(ctx context.Context, req *pb.RenewAccessReques
 		return nil, core.UnauthenticatedError(err)
 	}
 
-	session, err := h.Server.Store.GetSession(ctx, refreshPayload.ID)
+	// Access tokens share the session of the refresh token, without this check they could renew themselves
+	if refreshPayload.Type != token.TypeRefresh {
+		return nil, core.UnauthenticatedError(fmt.Errorf("not a refresh token"))
+	}
+
+	session, err := h.Server.Store.GetSession(ctx, refreshPayload.SessionID)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "session not found")
@@ -36,23 +45,80 @@ func (h *UserHandler) RenewAccess(ctx context.Context, req *pb.RenewAccessReques
 		return nil, status.Errorf(codes.PermissionDenied, "invalid session user")
 	}
 
-	if session.RefreshToken != req.RefreshToken {
-		return nil, status.Errorf(codes.PermissionDenied, "invalid session token")
+	userStatus, err := h.Server.Store.GetUserStatus(ctx, session.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user status: %v", err)
+	}
+
+	err = core.CheckUserActive(userStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	// A session is a refresh token family, a validly signed token of the session that is no longer
+	// its latest one has already been rotated, so either the user or an attacker is replaying it
+	hashedRefreshToken := util.HashToken(req.RefreshToken)
+	if session.HashedRefreshToken != hashedRefreshToken {
+		return nil, h.revokeReusedSession(ctx, session)
 	}
 
 	if time.Now().After(session.ExpiresAt.Time) {
 		return nil, status.Errorf(codes.PermissionDenied, "expired session")
 	}
 
-	accessToken, accessPayload, err := h.Server.TokenMaker.CreateToken(refreshPayload.Username, refreshPayload.Role, h.Server.Config.AccessTokenDuration)
+	accessToken, accessPayload, err := h.Server.TokenMaker.CreateToken(refreshPayload.Username, refreshPayload.Role, refreshPayload.SessionID, h.Server.Config.AccessTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot create access token: %v", err)
 	}
 
+	// Rotation does not extend the session, the family expires when the first refresh token would have
+	refreshToken, rotatedPayload, err := token.CreateRefreshToken(h.Server.TokenMaker, refreshPayload.Username, refreshPayload.Role, refreshPayload.SessionID, time.Until(session.ExpiresAt.Time))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create refresh token: %v", err)
+	}
+
+	_, err = h.Server.Store.RotateSessionRefreshToken(ctx, db.RotateSessionRefreshTokenParams{
+		ID:                    session.ID,
+		HashedRefreshToken:    hashedRefreshToken,
+		NewHashedRefreshToken: util.HashToken(refreshToken),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			// A concurrent renewal rotated the same token first
+			return nil, h.revokeReusedSession(ctx, session)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to rotate refresh token: %v", err)
+	}
+
 	response := &pb.RenewAccessResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: timestamppb.New(accessPayload.ExpiredAt),
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(rotatedPayload.ExpiredAt),
 	}
 
 	return response, nil
 }
+
+// revokeReusedSession blocks the session of a replayed refresh token,
+// which logs out both the legitimate holder and whoever stole the token.
+func (h *UserHandler) revokeReusedSession(ctx context.Context, session db.Session) error {
+	metadata := core.ExtractMetadata(ctx)
+	log.Warn().
+		Str("username", session.Username).
+		Str("session_id", session.ID.String()).
+		Str("client_ip", metadata.ClientIP).
+		Str("user_agent", metadata.UserAgent).
+		Msg("refresh token reuse detected, revoking session")
+
+	_, err := h.Server.Store.BlockSession(ctx, db.BlockSessionParams{
+		ID:       session.ID,
+		Username: session.Username,
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+
+	return status.Errorf(codes.PermissionDenied, "refresh token has already been used, session revoked")
+}
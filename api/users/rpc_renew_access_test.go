@@ -3,12 +3,15 @@ package users
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"simplebank/api/core"
 	"simplebank/api/testutil"
 	"simplebank/pb"
+	"simplebank/token"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -21,6 +24,33 @@ import (
 	mockwk "simplebank/worker/mock"
 )
 
+type eqRotateSessionRefreshTokenParamsMatcher struct {
+	id           uuid.UUID
+	refreshToken string
+}
+
+func (expected eqRotateSessionRefreshTokenParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.RotateSessionRefreshTokenParams)
+	if !ok {
+		return false
+	}
+
+	hashedRefreshToken := util.HashToken(expected.refreshToken)
+
+	return actualArg.ID == expected.id &&
+		actualArg.HashedRefreshToken == hashedRefreshToken &&
+		actualArg.NewHashedRefreshToken != "" &&
+		actualArg.NewHashedRefreshToken != hashedRefreshToken
+}
+
+func (expected eqRotateSessionRefreshTokenParamsMatcher) String() string {
+	return fmt.Sprintf("rotates refresh token %v of session %v", expected.refreshToken, expected.id)
+}
+
+func EqRotateSessionRefreshTokenParams(id uuid.UUID, refreshToken string) gomock.Matcher {
+	return eqRotateSessionRefreshTokenParamsMatcher{id, refreshToken}
+}
+
 func TestRenewAccess(t *testing.T) {
 	user, _ := testutil.RandomUser(t)
 
@@ -35,14 +65,14 @@ func TestRenewAccess(t *testing.T) {
 		{
 			"OK",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				session := db.Session{
-					ID:           payload.ID,
-					Username:     user.Username,
-					RefreshToken: refreshToken,
-					IsBlocked:    false,
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken(refreshToken),
+					IsBlocked:          false,
 					ExpiresAt: pgtype.Timestamp{
 						Time:  payload.ExpiredAt,
 						Valid: true,
@@ -50,7 +80,17 @@ func TestRenewAccess(t *testing.T) {
 				}
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					RotateSessionRefreshToken(gomock.Any(), EqRotateSessionRefreshTokenParams(session.ID, refreshToken)).
 					Times(1).
 					Return(session, nil)
 
@@ -61,6 +101,8 @@ func TestRenewAccess(t *testing.T) {
 				require.NotNil(t, res)
 				require.NotEmpty(t, res.AccessToken)
 				require.NotEmpty(t, res.AccessTokenExpiresAt)
+				require.NotEmpty(t, res.RefreshToken)
+				require.NotEmpty(t, res.RefreshTokenExpiresAt)
 			},
 		},
 		{
@@ -74,14 +116,36 @@ func TestRenewAccess(t *testing.T) {
 				require.Equal(t, codes.Unauthenticated, st.Code())
 			},
 		},
+		{
+			"AccessToken",
+			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
+				accessToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, uuid.New(), duration)
+				require.NoError(t, err)
+
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return accessToken, payload.ExpiredAt
+			},
+			func(t *testing.T, res *pb.RenewAccessResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
 		{
 			"SessionNotFound",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
 					Times(1).
 					Return(db.Session{}, db.ErrRecordNotFound)
 
@@ -96,14 +160,14 @@ func TestRenewAccess(t *testing.T) {
 		{
 			"SessionBlocked",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				session := db.Session{
-					ID:           payload.ID,
-					Username:     user.Username,
-					RefreshToken: refreshToken,
-					IsBlocked:    true,
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken(refreshToken),
+					IsBlocked:          true,
 					ExpiresAt: pgtype.Timestamp{
 						Time:  payload.ExpiredAt,
 						Valid: true,
@@ -111,7 +175,7 @@ func TestRenewAccess(t *testing.T) {
 				}
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
 					Times(1).
 					Return(session, nil)
 
@@ -126,14 +190,14 @@ func TestRenewAccess(t *testing.T) {
 		{
 			"UserMismatch",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				session := db.Session{
-					ID:           payload.ID,
-					Username:     "other-user",
-					RefreshToken: refreshToken,
-					IsBlocked:    false,
+					ID:                 payload.SessionID,
+					Username:           "other-user",
+					HashedRefreshToken: util.HashToken(refreshToken),
+					IsBlocked:          false,
 					ExpiresAt: pgtype.Timestamp{
 						Time:  payload.ExpiredAt,
 						Valid: true,
@@ -141,7 +205,7 @@ func TestRenewAccess(t *testing.T) {
 				}
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
 					Times(1).
 					Return(session, nil)
 
@@ -154,16 +218,15 @@ func TestRenewAccess(t *testing.T) {
 			},
 		},
 		{
-			"TokenMismatch",
+			"UserSuspended",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				session := db.Session{
-					ID:           payload.ID,
-					Username:     user.Username,
-					RefreshToken: "mismatch-token",
-					IsBlocked:    false,
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken(refreshToken),
 					ExpiresAt: pgtype.Timestamp{
 						Time:  payload.ExpiredAt,
 						Valid: true,
@@ -171,7 +234,104 @@ func TestRenewAccess(t *testing.T) {
 				}
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.UserStatusSuspended, nil)
+
+				store.EXPECT().
+					RotateSessionRefreshToken(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken, payload.ExpiredAt
+			},
+			func(t *testing.T, res *pb.RenewAccessResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"TokenReused",
+			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
+				require.NoError(t, err)
+
+				session := db.Session{
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken("rotated-token"),
+					IsBlocked:          false,
+					ExpiresAt: pgtype.Timestamp{
+						Time:  payload.ExpiredAt,
+						Valid: true,
+					},
+				}
+
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(db.BlockSessionParams{ID: session.ID, Username: user.Username})).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					RotateSessionRefreshToken(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				return refreshToken, payload.ExpiredAt
+			},
+			func(t *testing.T, res *pb.RenewAccessResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"ConcurrentRotation",
+			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
+				require.NoError(t, err)
+
+				session := db.Session{
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken(refreshToken),
+					ExpiresAt: pgtype.Timestamp{
+						Time:  payload.ExpiredAt,
+						Valid: true,
+					},
+				}
+
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
+				store.EXPECT().
+					RotateSessionRefreshToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(db.BlockSessionParams{ID: session.ID, Username: user.Username})).
 					Times(1).
 					Return(session, nil)
 
@@ -186,14 +346,14 @@ func TestRenewAccess(t *testing.T) {
 		{
 			"SessionExpired",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				session := db.Session{
-					ID:           payload.ID,
-					Username:     user.Username,
-					RefreshToken: refreshToken,
-					IsBlocked:    false,
+					ID:                 payload.SessionID,
+					Username:           user.Username,
+					HashedRefreshToken: util.HashToken(refreshToken),
+					IsBlocked:          false,
 					ExpiresAt: pgtype.Timestamp{
 						Time:  time.Now().Add(-time.Minute),
 						Valid: true,
@@ -201,10 +361,15 @@ func TestRenewAccess(t *testing.T) {
 				}
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
 					Times(1).
 					Return(session, nil)
 
+				store.EXPECT().
+					GetUserStatus(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(util.UserStatusActive, nil)
+
 				return refreshToken, payload.ExpiredAt
 			},
 			func(t *testing.T, res *pb.RenewAccessResponse, err error) {
@@ -216,11 +381,11 @@ func TestRenewAccess(t *testing.T) {
 		{
 			"InternalError",
 			func(store *mockdb.MockStore, coreServer *core.Server) (string, time.Time) {
-				refreshToken, payload, err := coreServer.TokenMaker.CreateToken(user.Username, role, duration)
+				refreshToken, payload, err := token.CreateRefreshToken(coreServer.TokenMaker, user.Username, role, uuid.New(), duration)
 				require.NoError(t, err)
 
 				store.EXPECT().
-					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					GetSession(gomock.Any(), gomock.Eq(payload.SessionID)).
 					Times(1).
 					Return(db.Session{}, sql.ErrConnDone)
 
@@ -0,0 +1,90 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) RequestAccountErasure(ctx context.Context, req *pb.RequestAccountErasureRequest) (*pb.RequestAccountErasureResponse, error) {
+	violations := validateRequestAccountErasureRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	// Erasure cannot be undone, so a stolen access token alone must not be enough. Wrong passwords count towards
+	// the login lockout, otherwise this would be a way to guess them without limit
+	err = h.Server.VerifyCredential(ctx, user, core.LoginFailureWrongPassword, func() error {
+		if util.CheckPassword(req.GetPassword(), user.HashedPassword) != nil {
+			return core.ErrInvalidPassword
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, core.ErrInvalidPassword) {
+			return nil, status.Errorf(codes.PermissionDenied, "incorrect password")
+		}
+
+		return nil, err
+	}
+
+	txResult, err := h.Server.Store.EraseUserTx(ctx, db.EraseUserTxParams{
+		Username: user.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrAccountNotEmpty) {
+			return nil, status.Errorf(codes.FailedPrecondition, "all accounts must be emptied before they can be closed")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to erase user: %s", err)
+	}
+
+	// The documents are gone from the database, a file that cannot be deleted is left for an operator to remove
+	for _, key := range txResult.KycBlobKeys {
+		if deleteErr := h.Server.BlobStore.Delete(ctx, key); deleteErr != nil {
+			log.Error().Err(deleteErr).Str("key", key).Msg("failed to delete document of erased user")
+		}
+	}
+
+	response := &pb.RequestAccountErasureResponse{
+		DataRequest:    txResult.DataRequest.ToResponse(),
+		ClosedAccounts: make([]*pb.Account, len(txResult.ClosedAccounts)),
+	}
+
+	for i, account := range txResult.ClosedAccounts {
+		response.ClosedAccounts[i] = account.ToResponse()
+	}
+
+	return response, nil
+}
+
+func validateRequestAccountErasureRequest(req *pb.RequestAccountErasureRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateString(req.GetPassword(), 1, val.MaxPasswordLength); err != nil {
+		violations = append(violations, core.FieldViolation("password", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,240 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	"simplebank/blob"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestAccountErasure(t *testing.T) {
+	user, password := testutil.RandomUser(t)
+
+	closedAccount := testutil.RandomAccount(user.Username)
+	closedAccount.Balance = 0
+	closedAccount.ClosedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	lockedUser := user
+	lockedUser.LockedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.RequestAccountErasureRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.RequestAccountErasureResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.RequestAccountErasureRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					EraseUserTx(gomock.Any(), gomock.Eq(db.EraseUserTxParams{Username: user.Username})).
+					Times(1).
+					Return(db.EraseUserTxResult{
+						User: db.User{Username: user.Username},
+						DataRequest: db.DataRequest{
+							ID:          1,
+							Username:    user.Username,
+							Kind:        util.DataRequestErasure,
+							CreatedAt:   time.Now(),
+							CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+						},
+						ClosedAccounts: []db.Account{closedAccount},
+					}, nil)
+			},
+			func(t *testing.T, res *pb.RequestAccountErasureResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.DataRequestErasure, res.GetDataRequest().GetKind())
+				require.NotNil(t, res.GetDataRequest().CompletedAt)
+				require.Len(t, res.GetClosedAccounts(), 1)
+				require.Equal(t, closedAccount.Number, res.GetClosedAccounts()[0].GetNumber())
+				require.NotNil(t, res.GetClosedAccounts()[0].ClosedAt)
+			},
+		},
+		{
+			"WrongPassword",
+			&pb.RequestAccountErasureRequest{
+				Username: user.Username,
+				Password: password + "x",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.False(t, arg.Succeeded)
+						require.Equal(t, core.LoginFailureWrongPassword, arg.FailureReason.String)
+						return db.LoginAttempt{}, nil
+					})
+
+				store.EXPECT().
+					EraseUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.RequestAccountErasureResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			"UserLocked",
+			&pb.RequestAccountErasureRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(lockedUser, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.LoginAttempt{}, nil)
+
+				store.EXPECT().
+					EraseUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.RequestAccountErasureResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"AccountNotEmpty",
+			&pb.RequestAccountErasureRequest{
+				Username: user.Username,
+				Password: password,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					EraseUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EraseUserTxResult{}, db.ErrAccountNotEmpty)
+			},
+			func(t *testing.T, res *pb.RequestAccountErasureResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"MissingPassword",
+			&pb.RequestAccountErasureRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.RequestAccountErasureResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.RequestAccountErasure(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestRequestAccountErasureDeletesKycDocuments(t *testing.T) {
+	user, password := testutil.RandomUser(t)
+	key := fmt.Sprintf("kyc/%s/%s", user.Username, util.RandomString(8))
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().
+		GetUser(gomock.Any(), gomock.Eq(user.Username)).
+		Times(1).
+		Return(user, nil)
+
+	testutil.ExpectLoginThrottle(store, 0, 0)
+
+	store.EXPECT().
+		EraseUserTx(gomock.Any(), gomock.Eq(db.EraseUserTxParams{Username: user.Username})).
+		Times(1).
+		Return(db.EraseUserTxResult{
+			User:        db.User{Username: "erased-1"},
+			DataRequest: db.DataRequest{ID: 1, Username: "erased-1", Kind: util.DataRequestErasure},
+			KycBlobKeys: []string{key},
+		}, nil)
+
+	coreServer := testutil.NewTestServer(t, store, nil)
+	require.NoError(t, coreServer.BlobStore.Put(context.Background(), key, []byte("document")))
+
+	handler := NewUserHandler(coreServer)
+	res, err := handler.RequestAccountErasure(context.Background(), &pb.RequestAccountErasureRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "erased-1", res.GetDataRequest().GetUsername())
+
+	_, err = coreServer.BlobStore.Get(context.Background(), key)
+	require.ErrorIs(t, err, blob.ErrNotFound)
+}
@@ -0,0 +1,57 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"simplebank/worker"
+
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	violations := validateRequestPasswordResetRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	// Respond the same whether or not the email belongs to a user, so the endpoint cannot be used to find accounts
+	user, err := h.Server.Store.GetUserByEmail(ctx, req.GetEmail())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return &emptypb.Empty{}, nil
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	taskPayload := &worker.PayloadSendPasswordResetEmail{
+		Username: user.Username,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	err = h.Server.TaskDistributor.DistributeSendPasswordResetEmailTask(ctx, taskPayload, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send password reset email: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateRequestPasswordResetRequest(req *pb.RequestPasswordResetRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, core.FieldViolation("email", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,108 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestPasswordReset(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	testCases := []struct {
+		name          string
+		req           *pb.RequestPasswordResetRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			"OK",
+			&pb.RequestPasswordResetRequest{
+				Email: user.Email,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+
+				distributor.EXPECT().
+					DistributeSendPasswordResetEmailTask(gomock.Any(), gomock.Eq(&worker.PayloadSendPasswordResetEmail{
+						Username: user.Username,
+					}), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			"UnknownEmail",
+			&pb.RequestPasswordResetRequest{
+				Email: user.Email,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				distributor.EXPECT().
+					DistributeSendPasswordResetEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, err error) {
+				// indistinguishable from a known email
+				require.NoError(t, err)
+			},
+		},
+		{
+			"InvalidEmail",
+			&pb.RequestPasswordResetRequest{
+				Email: "invalid",
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			coreServer := testutil.NewTestServer(t, store, taskDistributor)
+			handler := NewUserHandler(coreServer)
+
+			_, err := handler.RequestPasswordReset(context.Background(), tc.req)
+			tc.checkResponse(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) ResendVerificationEmail(ctx context.Context, req *pb.ResendVerificationEmailRequest) (*pb.ResendVerificationEmailResponse, error) {
+	violations := validateResendVerificationEmailRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, req.GetUsername())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	if user.IsEmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address is already verified")
+	}
+
+	// Claiming the send slot in the same statement that checks it keeps concurrent requests from both sending
+	_, err = h.Server.Store.MarkVerificationEmailSent(ctx, db.MarkVerificationEmailSentParams{
+		Username:   user.Username,
+		SentBefore: time.Now().Add(-h.Server.Config.VerifyEmailResendInterval),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.ResourceExhausted, "verification emails can be sent once every %s", h.Server.Config.VerifyEmailResendInterval)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
+	}
+
+	err = h.distributeVerifyEmail(ctx, user.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send verification email: %s", err)
+	}
+
+	response := &pb.ResendVerificationEmailResponse{
+		Email: user.Email,
+	}
+
+	return response, nil
+}
+
+func validateResendVerificationEmailRequest(req *pb.ResendVerificationEmailRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,157 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResendVerificationEmail(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	user.IsEmailVerified = false
+
+	verifiedUser := user
+	verifiedUser.IsEmailVerified = true
+
+	testCases := []struct {
+		name          string
+		req           *pb.ResendVerificationEmailRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.ResendVerificationEmailResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.ResendVerificationEmailRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					MarkVerificationEmailSent(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+
+				taskPayload := &worker.PayloadSendVerifyEmail{
+					Username: user.Username,
+				}
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			func(t *testing.T, res *pb.ResendVerificationEmailResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Email, res.GetEmail())
+			},
+		},
+		{
+			"AlreadyVerified",
+			&pb.ResendVerificationEmailRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(verifiedUser, nil)
+
+				store.EXPECT().
+					MarkVerificationEmailSent(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ResendVerificationEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"SentRecently",
+			&pb.ResendVerificationEmailRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				store.EXPECT().
+					MarkVerificationEmailSent(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ResendVerificationEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"UserNotFound",
+			&pb.ResendVerificationEmailRequest{
+				Username: user.Username,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.ResendVerificationEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			distributorCtrl := gomock.NewController(t)
+			defer distributorCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(distributorCtrl)
+
+			tc.buildStubs(store, distributor)
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.ResendVerificationEmail(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
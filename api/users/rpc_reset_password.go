@@ -0,0 +1,54 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	violations := validateResetPasswordRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	hashedPassword, err := h.Server.PasswordHasher.Hash(req.GetPassword())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+	}
+
+	_, err = h.Server.Store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		HashedToken:    util.HashToken(req.GetToken()),
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "reset token is invalid, used or expired")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to reset password: %s", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateResetPasswordRequest(req *pb.ResetPasswordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSecretCode(req.GetToken()); err != nil {
+		violations = append(violations, core.FieldViolation("token", err))
+	}
+
+	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+		violations = append(violations, core.FieldViolation("password", err))
+	}
+
+	return violations
+}
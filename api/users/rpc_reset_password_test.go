@@ -0,0 +1,133 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eqResetPasswordTxParamsMatcher struct {
+	arg      db.ResetPasswordTxParams
+	password string
+}
+
+func (expected eqResetPasswordTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.ResetPasswordTxParams)
+	if !ok {
+		return false
+	}
+
+	if err := util.CheckPassword(expected.password, actualArg.HashedPassword); err != nil {
+		return false
+	}
+
+	return expected.arg.HashedToken == actualArg.HashedToken
+}
+
+func (expected eqResetPasswordTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v and password %v", expected.arg, expected.password)
+}
+
+func EqResetPasswordTxParams(arg db.ResetPasswordTxParams, password string) gomock.Matcher {
+	return eqResetPasswordTxParamsMatcher{arg, password}
+}
+
+func TestResetPassword(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	token, err := util.GenerateSecureToken()
+	require.NoError(t, err)
+
+	password := util.RandomString(8)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ResetPasswordRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, err error)
+	}{
+		{
+			"OK",
+			&pb.ResetPasswordRequest{
+				Token:    token,
+				Password: password,
+			},
+			func(store *mockdb.MockStore) {
+				arg := db.ResetPasswordTxParams{
+					HashedToken: util.HashToken(token),
+				}
+
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), EqResetPasswordTxParams(arg, password)).
+					Times(1).
+					Return(db.ResetPasswordTxResult{User: user}, nil)
+			},
+			func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			"InvalidToken",
+			&pb.ResetPasswordRequest{
+				Token:    token,
+				Password: password,
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResetPasswordTxResult{}, db.ErrRecordNotFound)
+			},
+			func(t *testing.T, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"ShortPassword",
+			&pb.ResetPasswordRequest{
+				Token:    token,
+				Password: "abc",
+			},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			_, err := handler.ResetPassword(context.Background(), tc.req)
+			tc.checkResponse(t, err)
+		})
+	}
+}
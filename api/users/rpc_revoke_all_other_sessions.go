@@ -0,0 +1,30 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) RevokeAllOtherSessions(ctx context.Context, req *pb.RevokeAllOtherSessionsRequest) (*pb.RevokeAllOtherSessionsResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	count, err := h.Server.Store.BlockOtherSessions(ctx, db.BlockOtherSessionsParams{
+		Username:         authPayload.Username,
+		CurrentSessionID: authPayload.SessionID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %s", err)
+	}
+
+	return &pb.RevokeAllOtherSessionsResponse{
+		Count: count,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/val"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	violations := validateSearchUsersRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	pageSize := req.GetLimit()
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	arg := db.SearchUsersParams{
+		UsernamePrefix: pgtype.Text{String: req.GetUsernamePrefix(), Valid: req.UsernamePrefix != nil},
+		Email:          pgtype.Text{String: req.GetEmail(), Valid: req.Email != nil},
+		FullName:       pgtype.Text{String: req.GetFullName(), Valid: req.FullName != nil},
+		PageSize:       int32(pageSize),
+	}
+
+	// The cursor is the username of the last user of the previous page, users are listed by username
+	if req.GetCursor() != "" {
+		arg.AfterUsername = pgtype.Text{String: req.GetCursor(), Valid: true}
+	}
+
+	users, err := h.Server.Store.SearchUsers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
+	}
+
+	response := &pb.SearchUsersResponse{
+		Pagination: &pb.Pagination{
+			Count: int64(len(users)),
+		},
+		Data: make([]*pb.User, len(users)),
+	}
+
+	for i, user := range users {
+		response.Data[i] = user.ToResponse()
+	}
+
+	if int64(len(users)) == pageSize {
+		next := users[len(users)-1].Username
+		response.Pagination.Next = &next
+	}
+
+	return response, nil
+}
+
+func validateSearchUsersRequest(req *pb.SearchUsersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.UsernamePrefix != nil {
+		if err := val.ValidateString(req.GetUsernamePrefix(), 1, 100); err != nil {
+			violations = append(violations, core.FieldViolation("username_prefix", err))
+		}
+	}
+
+	if req.Email != nil {
+		if err := val.ValidateString(req.GetEmail(), 1, 200); err != nil {
+			violations = append(violations, core.FieldViolation("email", err))
+		}
+	}
+
+	if req.FullName != nil {
+		if err := val.ValidateString(req.GetFullName(), 1, 100); err != nil {
+			violations = append(violations, core.FieldViolation("full_name", err))
+		}
+	}
+
+	if req.GetCursor() != "" {
+		if err := val.ValidateUsername(req.GetCursor()); err != nil {
+			violations = append(violations, core.FieldViolation("cursor", fmt.Errorf("invalid cursor")))
+		}
+	}
+
+	if req.GetLimit() < 0 || req.GetLimit() > maxPageSize {
+		violations = append(violations, core.FieldViolation("limit", fmt.Errorf("must be between 1 and %d", maxPageSize)))
+	}
+
+	return violations
+}
@@ -0,0 +1,115 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSearchUsersAPI(t *testing.T) {
+	user1, _ := testutil.RandomUser(t)
+	user2, _ := testutil.RandomUser(t)
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchUsersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.SearchUsersResponse, err error)
+	}{
+		{
+			"FullPage",
+			&pb.SearchUsersRequest{FullName: proto.String("smith"), Cursor: "alice", Limit: 2},
+			func(store *mockdb.MockStore) {
+				arg := db.SearchUsersParams{
+					FullName:      pgtype.Text{String: "smith", Valid: true},
+					AfterUsername: pgtype.Text{String: "alice", Valid: true},
+					PageSize:      2,
+				}
+
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.User{user1, user2}, nil)
+			},
+			func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetData(), 2)
+				require.Equal(t, user2.Username, res.GetPagination().GetNext())
+			},
+		},
+		{
+			"LastPage",
+			&pb.SearchUsersRequest{UsernamePrefix: proto.String(user1.Username[:3]), Email: proto.String(user1.Email)},
+			func(store *mockdb.MockStore) {
+				arg := db.SearchUsersParams{
+					UsernamePrefix: pgtype.Text{String: user1.Username[:3], Valid: true},
+					Email:          pgtype.Text{String: user1.Email, Valid: true},
+					PageSize:       defaultPageSize,
+				}
+
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.User{user1}, nil)
+			},
+			func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetData(), 1)
+				require.Nil(t, res.GetPagination().Next)
+			},
+		},
+		{
+			"EmptyFilter",
+			&pb.SearchUsersRequest{FullName: proto.String("")},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"InvalidLimit",
+			&pb.SearchUsersRequest{Limit: maxPageSize + 1},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.SearchUsers(context.Background(), tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (h *UserHandler) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.ChangeUserStatusResponse, error) {
+	violations := validateSuspendUserRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	return h.changeUserStatus(ctx, db.ChangeUserStatusTxParams{
+		Username:       req.GetUsername(),
+		Status:         util.UserStatusSuspended,
+		Reason:         req.GetReason(),
+		FreezeAccounts: req.GetFreezeAccounts(),
+	})
+}
+
+func validateSuspendUserRequest(req *pb.SuspendUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, core.FieldViolation("username", err))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+		violations = append(violations, core.FieldViolation("reason", err))
+	}
+
+	return violations
+}
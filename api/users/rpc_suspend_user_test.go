@@ -0,0 +1,178 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSuspendUserAPI(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+	banker, _ := testutil.RandomUser(t)
+	banker.Role = util.BankerRole
+
+	suspendedUser := user
+	suspendedUser.Status = util.UserStatusSuspended
+
+	frozenAccount := testutil.RandomAccount(user.Username)
+	frozenAccount.FrozenAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	bankerContext := func(t *testing.T, tokenMaker token.Maker) context.Context {
+		return testutil.NewContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.SuspendUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ChangeUserStatusResponse, err error)
+	}{
+		{
+			"OK",
+			&pb.SuspendUserRequest{Username: user.Username, Reason: "suspected account takeover", FreezeAccounts: true},
+			func(store *mockdb.MockStore) {
+				arg := db.ChangeUserStatusTxParams{
+					Username:       user.Username,
+					Status:         util.UserStatusSuspended,
+					Reason:         "suspected account takeover",
+					ChangedBy:      banker.Username,
+					FreezeAccounts: true,
+				}
+
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{
+						User: suspendedUser,
+						StatusChange: db.UserStatusChange{
+							Username:       user.Username,
+							PreviousStatus: util.UserStatusActive,
+							Status:         util.UserStatusSuspended,
+							Reason:         arg.Reason,
+							ChangedBy:      banker.Username,
+						},
+						BlockedSessions: 2,
+						Accounts:        []db.Account{frozenAccount},
+					}, nil)
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.UserStatusSuspended, res.GetUser().GetStatus())
+				require.Equal(t, util.UserStatusActive, res.GetStatusChange().GetPreviousStatus())
+				require.Equal(t, banker.Username, res.GetStatusChange().GetChangedBy())
+				require.Equal(t, int64(2), res.GetBlockedSessions())
+				require.Len(t, res.GetAccounts(), 1)
+				require.NotNil(t, res.GetAccounts()[0].GetFrozenAt())
+			},
+		},
+		{
+			"MissingReason",
+			&pb.SuspendUserRequest{Username: user.Username},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			"OwnUser",
+			&pb.SuspendUserRequest{Username: banker.Username, Reason: "leaving the bank"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"AlreadySuspended",
+			&pb.SuspendUserRequest{Username: user.Username, Reason: "suspected account takeover"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{}, db.ErrUserStatusUnchanged)
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			"UserNotFound",
+			&pb.SuspendUserRequest{Username: user.Username, Reason: "suspected account takeover"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{}, db.ErrRecordNotFound)
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			"InternalError",
+			&pb.SuspendUserRequest{Username: user.Username, Reason: "suspected account takeover"},
+			func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangeUserStatusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangeUserStatusTxResult{}, fmt.Errorf("connection reset"))
+			},
+			bankerContext,
+			func(t *testing.T, res *pb.ChangeUserStatusResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			coreServer := testutil.NewTestServer(t, store, nil)
+			handler := NewUserHandler(coreServer)
+
+			ctx := tc.buildContext(t, coreServer.TokenMaker)
+			res, err := handler.SuspendUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
-	"simplebank/util"
 	"simplebank/val"
 	"time"
 
@@ -17,34 +16,38 @@ import (
 )
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
-	authPayload, err := core.AuthorizeUser(h.Server.TokenMaker, ctx, []string{util.DepositorRole, util.BankerRole})
-	if err != nil {
-		return nil, core.UnauthenticatedError(err)
-	}
-
 	violations := validateUpdateUserRequest(req)
 	if violations != nil {
 		return nil, core.InvalidArgumentError(violations)
 	}
 
-	if !util.IsBanker(authPayload.Role) && authPayload.Username != req.GetUsername() {
-		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
-	}
-
-	arg := db.UpdateUserParams{
-		Username: req.GetUsername(),
-		FullName: pgtype.Text{
-			String: req.GetFullName(),
-			Valid:  req.FullName != nil,
+	arg := db.UpdateUserTxParams{
+		UpdateUserParams: db.UpdateUserParams{
+			Username: req.GetUsername(),
+			FullName: pgtype.Text{
+				String: req.GetFullName(),
+				Valid:  req.FullName != nil,
+			},
+			Email: pgtype.Text{
+				String: req.GetEmail(),
+				Valid:  req.Email != nil,
+			},
 		},
-		Email: pgtype.Text{
-			String: req.GetEmail(),
-			Valid:  req.Email != nil,
+		AfterUpdate: func(before db.User, after db.User) error {
+			if before.Email == after.Email {
+				return nil
+			}
+
+			return h.distributeVerifyEmail(ctx, after.Username)
 		},
 	}
 
+	if req.FullName != nil {
+		arg.ScreeningHits = h.Server.ScreenName(req.GetFullName())
+	}
+
 	if req.Password != nil {
-		hashedPassword, err := util.HashPassword(req.GetPassword())
+		hashedPassword, err := h.Server.PasswordHasher.Hash(req.GetPassword())
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 		}
@@ -59,7 +62,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		}
 	}
 
-	user, err := h.Server.Store.UpdateUser(ctx, arg)
+	txResult, err := h.Server.Store.UpdateUserTx(ctx, arg)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "user not found")
@@ -72,7 +75,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
 
-	return user.ToResponse(), nil
+	return txResult.User.ToResponse(), nil
 }
 
 func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
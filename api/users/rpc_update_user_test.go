@@ -2,12 +2,16 @@ package users
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"simplebank/api/testutil"
 	mockdb "simplebank/db/mock"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
 	"simplebank/token"
 	"simplebank/util"
+	"simplebank/worker"
+	mockwk "simplebank/worker/mock"
 	"testing"
 	"time"
 
@@ -16,6 +20,35 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+type eqUpdateUserTxParamsMatcher struct {
+	arg    db.UpdateUserParams
+	before db.User
+	after  db.User
+}
+
+func (expected eqUpdateUserTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.UpdateUserTxParams)
+	if !ok {
+		return false
+	}
+
+	if !reflect.DeepEqual(expected.arg, actualArg.UpdateUserParams) {
+		return false
+	}
+
+	err := actualArg.AfterUpdate(expected.before, expected.after)
+
+	return err == nil
+}
+
+func (expected eqUpdateUserTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v", expected.arg)
+}
+
+func EqUpdateUserTxParams(arg db.UpdateUserParams, before db.User, after db.User) gomock.Matcher {
+	return eqUpdateUserTxParamsMatcher{arg, before, after}
+}
+
 func TestUpdateUserAPI(t *testing.T) {
 	user, _ := testutil.RandomUser(t)
 
@@ -25,7 +58,7 @@ func TestUpdateUserAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		req           *pb.UpdateUserRequest
-		buildStubs    func(store *mockdb.MockStore)
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
 		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
 		checkResponse func(t *testing.T, resp *pb.User, err error)
 	}{
@@ -36,7 +69,7 @@ func TestUpdateUserAPI(t *testing.T) {
 				FullName: &newName,
 				Email:    &newEmail,
 			},
-			func(store *mockdb.MockStore) {
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
 				arg := db.UpdateUserParams{
 					Username: user.Username,
 					FullName: pgtype.Text{
@@ -57,9 +90,18 @@ func TestUpdateUserAPI(t *testing.T) {
 				}
 
 				store.EXPECT().
-					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					UpdateUserTx(gomock.Any(), EqUpdateUserTxParams(arg, user, updatedUser)).
 					Times(1).
-					Return(updatedUser, nil)
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
+
+				taskPayload := &worker.PayloadSendVerifyEmail{
+					Username: user.Username,
+				}
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
 			},
 			func(t *testing.T, tokenMaker token.Maker) context.Context {
 				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
@@ -70,6 +112,49 @@ func TestUpdateUserAPI(t *testing.T) {
 				require.Equal(t, user.Username, res.Username)
 				require.Equal(t, newName, res.FullName)
 				require.Equal(t, newEmail, res.Email)
+				require.False(t, res.IsEmailVerified)
+			},
+		},
+		{
+			"SameEmail",
+			&pb.UpdateUserRequest{
+				Username: user.Username,
+				FullName: &newName,
+				Email:    &user.Email,
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					FullName: pgtype.Text{
+						String: newName,
+						Valid:  true,
+					},
+					Email: pgtype.Text{
+						String: user.Email,
+						Valid:  true,
+					},
+				}
+
+				updatedUser := user
+				updatedUser.FullName = newName
+
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), EqUpdateUserTxParams(arg, user, updatedUser)).
+					Times(1).
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
+
+				distributor.EXPECT().
+					DistributeSendVerifyEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return testutil.NewContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			func(t *testing.T, res *pb.User, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, newName, res.FullName)
+				require.True(t, res.IsEmailVerified)
 			},
 		},
 	}
@@ -80,8 +165,12 @@ func TestUpdateUserAPI(t *testing.T) {
 			defer storeCtrl.Finish()
 			store := mockdb.NewMockStore(storeCtrl)
 
-			tc.buildStubs(store)
-			coreServer := testutil.NewTestServer(t, store, nil)
+			distributorCtrl := gomock.NewController(t)
+			defer distributorCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(distributorCtrl)
+
+			tc.buildStubs(store, distributor)
+			coreServer := testutil.NewTestServer(t, store, distributor)
 			handler := NewUserHandler(coreServer)
 
 			ctx := tc.buildContext(t, coreServer.TokenMaker)
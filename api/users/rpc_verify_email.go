@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"errors"
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	"simplebank/pb"
@@ -23,6 +24,11 @@ func (h *UserHandler) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReques
 		SecretCode: req.GetSecretCode(),
 	})
 	if err != nil {
+		// Also the case for links sent to an address the user has changed since
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "verification link is invalid or has expired")
+		}
+
 		return nil, status.Errorf(codes.Internal, "failed to verify email")
 	}
 
@@ -0,0 +1,124 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/token"
+	"simplebank/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) VerifyLoginMfa(ctx context.Context, req *pb.VerifyLoginMfaRequest) (*pb.LoginUserResponse, error) {
+	violations := validateVerifyLoginMfaRequest(req)
+	if violations != nil {
+		return nil, core.InvalidArgumentError(violations)
+	}
+
+	challengeId, err := h.Server.Signer.Verify(mfaChallengePurpose, req.GetMfaToken())
+	if err != nil {
+		if errors.Is(err, token.ErrExpiredToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "mfa token has expired")
+		}
+
+		return nil, status.Errorf(codes.Unauthenticated, "invalid mfa token")
+	}
+
+	// Count the attempt before checking the code, so concurrent guesses are limited as well
+	challenge, err := h.Server.Store.AttemptMfaChallenge(ctx, db.AttemptMfaChallengeParams{
+		ID:          challengeId,
+		MaxAttempts: maxMfaAttempts,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, "mfa challenge is no longer valid, log in again")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot get mfa challenge: %v", err)
+	}
+
+	user, err := h.Server.Store.GetUser(ctx, challenge.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get user: %v", err)
+	}
+
+	// Wrong codes count towards the lockout like wrong passwords, so logging in again does not earn more guesses
+	err = h.Server.VerifyCredential(ctx, user, core.LoginFailureWrongMfaCode, func() error {
+		return core.VerifySecondFactor(ctx, h.Server.Store, user.Username, req.GetCode(), req.GetRecoveryCode())
+	})
+	if err != nil {
+		if errors.Is(err, core.ErrInvalidMfaCode) {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", err)
+		}
+
+		return nil, core.MfaError(err)
+	}
+
+	_, err = h.Server.Store.CompleteMfaChallenge(ctx, challenge.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, "mfa challenge is no longer valid, log in again")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot complete mfa challenge: %v", err)
+	}
+
+	metadata := core.ExtractMetadata(ctx)
+	attempt := db.CreateLoginAttemptParams{
+		Username:  user.Username,
+		ClientIp:  metadata.ClientIP,
+		UserAgent: metadata.UserAgent,
+	}
+
+	// The user may have been suspended since the password was checked
+	err = core.CheckUserActive(user.Status)
+	if err != nil {
+		if recordErr := h.Server.RecordLoginFailure(ctx, attempt, core.LoginFailureInactive); recordErr != nil {
+			return nil, recordErr
+		}
+
+		return nil, err
+	}
+
+	err = h.recordLoginSuccess(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.createSession(ctx, user)
+}
+
+func validateVerifyLoginMfaRequest(req *pb.VerifyLoginMfaRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetMfaToken() == "" {
+		violations = append(violations, core.FieldViolation("mfa_token", fmt.Errorf("must be specified")))
+	}
+
+	return append(violations, validateSecondFactor(req.Code, req.RecoveryCode)...)
+}
+
+// validateSecondFactor ensures exactly one of a TOTP code or a recovery code is provided.
+func validateSecondFactor(code *string, recoveryCode *string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if (code == nil) == (recoveryCode == nil) {
+		return append(violations, core.FieldViolation("code", fmt.Errorf("either code or recovery_code must be provided")))
+	}
+
+	if code != nil {
+		if err := val.ValidateTOTPCode(*code); err != nil {
+			violations = append(violations, core.FieldViolation("code", err))
+		}
+	}
+
+	if recoveryCode != nil {
+		if err := val.ValidateRecoveryCode(*recoveryCode); err != nil {
+			violations = append(violations, core.FieldViolation("recovery_code", err))
+		}
+	}
+
+	return violations
+}
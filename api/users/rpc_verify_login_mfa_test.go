@@ -0,0 +1,382 @@
+package users
+
+import (
+	"context"
+	"simplebank/api/core"
+	"simplebank/api/testutil"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+	"simplebank/util"
+	mockwk "simplebank/worker/mock"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyLoginMfa(t *testing.T) {
+	user, _ := testutil.RandomUser(t)
+
+	secret, err := util.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	code, err := util.TOTPCode(secret, time.Now())
+	require.NoError(t, err)
+
+	recoveryCode := "abcde-fghjk"
+	wrongCode := "000000"
+	if code == wrongCode {
+		wrongCode = "111111"
+	}
+
+	credential := db.TotpCredential{
+		Username:    user.Username,
+		Secret:      secret,
+		ConfirmedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	challenge := db.MfaChallenge{
+		ID:        util.RandomInt(1, 1000),
+		Username:  user.Username,
+		Attempts:  1,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	validToken := func(coreServer *core.Server) string {
+		return coreServer.Signer.Sign(mfaChallengePurpose, challenge.ID, challenge.ExpiresAt)
+	}
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(coreServer *core.Server) *pb.VerifyLoginMfaRequest
+		buildStubs    func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.LoginUserResponse, err error)
+	}{
+		{
+			"OK",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &code}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Eq(db.AttemptMfaChallengeParams{
+						ID:          challenge.ID,
+						MaxAttempts: maxMfaAttempts,
+					})).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
+
+				store.EXPECT().
+					UseTotpStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(credential, nil)
+
+				store.EXPECT().
+					CompleteMfaChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			"OKRecoveryCode",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), RecoveryCode: &recoveryCode}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
+
+				store.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(db.UseRecoveryCodeParams{
+						Username:   user.Username,
+						HashedCode: util.HashToken(recoveryCode),
+					})).
+					Times(1).
+					Return(db.RecoveryCode{Username: user.Username}, nil)
+
+				store.EXPECT().
+					CompleteMfaChallenge(gomock.Any(), gomock.Eq(challenge.ID)).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, ""))).
+					Times(1)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{Username: user.Username}, nil)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+			},
+		},
+		{
+			"InvalidCode",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &wrongCode}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
+
+				// counts towards the lockout like a wrong password
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureWrongMfaCode))).
+					Times(1)
+
+				store.EXPECT().
+					LockUser(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CompleteMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"InvalidCodeLocksUser",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &wrongCode}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+
+				// the failures of earlier logins are not reset by entering the password again
+				testutil.ExpectLoginThrottle(store, 0, 2)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(credential, nil)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureWrongMfaCode))).
+					Times(1)
+
+				lockedUser := user
+				lockedUser.LockedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+
+				store.EXPECT().
+					LockUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(lockedUser, nil)
+
+				distributor.EXPECT().
+					DistributeSendAccountLockedEmailTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"UserLocked",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &code}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(challenge, nil)
+
+				lockedUser := user
+				lockedUser.LockedUntil = pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true}
+
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(lockedUser, nil)
+
+				testutil.ExpectLoginThrottle(store, 0, 0)
+
+				store.EXPECT().
+					CreateLoginAttempt(gomock.Any(), gomock.Eq(loginAttempt(user.Username, core.LoginFailureLocked))).
+					Times(1)
+
+				// a correct code does not help while locked
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Any()).
+					Times(0)
+
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.ResourceExhausted, st.Code())
+			},
+		},
+		{
+			"AttemptsExhausted",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &code}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MfaChallenge{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					GetTotpCredential(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"InvalidToken",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				// a token signed for another purpose must not be accepted
+				token := coreServer.Signer.Sign("payment_request", challenge.ID, challenge.ExpiresAt)
+				return &pb.VerifyLoginMfaRequest{MfaToken: token, Code: &code}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			"BothFactors",
+			func(coreServer *core.Server) *pb.VerifyLoginMfaRequest {
+				return &pb.VerifyLoginMfaRequest{MfaToken: validToken(coreServer), Code: &code, RecoveryCode: &recoveryCode}
+			},
+			func(store *mockdb.MockStore, distributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					AttemptMfaChallenge(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			func(t *testing.T, res *pb.LoginUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			distributorCtrl := gomock.NewController(t)
+			defer distributorCtrl.Finish()
+			distributor := mockwk.NewMockTaskDistributor(distributorCtrl)
+
+			tc.buildStubs(store, distributor)
+
+			coreServer := testutil.NewTestServer(t, store, distributor)
+			coreServer.Config.RefreshTokenDuration = time.Hour
+			handler := NewUserHandler(coreServer)
+
+			res, err := handler.VerifyLoginMfa(context.Background(), tc.buildRequest(coreServer))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
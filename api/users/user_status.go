@@ -0,0 +1,53 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"simplebank/api/core"
+	db "simplebank/db/sqlc"
+	"simplebank/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// changeUserStatus moves the user to the status on behalf of the calling banker.
+func (h *UserHandler) changeUserStatus(ctx context.Context, arg db.ChangeUserStatusTxParams) (*pb.ChangeUserStatusResponse, error) {
+	authPayload, err := core.AuthPayloadFromContext(ctx)
+	if err != nil {
+		return nil, core.UnauthenticatedError(err)
+	}
+
+	// Bankers could otherwise reactivate themselves after being suspended by someone else
+	if arg.Username == authPayload.Username {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot change your own status")
+	}
+
+	arg.ChangedBy = authPayload.Username
+	txResult, err := h.Server.Store.ChangeUserStatusTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+
+		if errors.Is(err, db.ErrUserStatusUnchanged) {
+			return nil, status.Errorf(codes.FailedPrecondition, "user is already %s", arg.Status)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to change user status: %s", err)
+	}
+
+	accounts := make([]*pb.Account, len(txResult.Accounts))
+	for i, account := range txResult.Accounts {
+		accounts[i] = account.ToResponse()
+	}
+
+	response := &pb.ChangeUserStatusResponse{
+		User:            txResult.User.ToResponse(),
+		StatusChange:    txResult.StatusChange.ToResponse(),
+		BlockedSessions: txResult.BlockedSessions,
+		Accounts:        accounts,
+	}
+
+	return response, nil
+}
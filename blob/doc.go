@@ -0,0 +1,2 @@
+// Package blob provides storage for uploaded files such as identity documents.
+package blob
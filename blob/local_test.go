@@ -0,0 +1,50 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalStore(root)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	content := []byte("%PDF-1.7 document")
+
+	err = store.Put(ctx, "kyc/alice/passport", content)
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(root, "kyc", "alice", "passport"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	stored, err := store.Get(ctx, "kyc/alice/passport")
+	require.NoError(t, err)
+	require.Equal(t, content, stored)
+
+	err = store.Delete(ctx, "kyc/alice/passport")
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, "kyc/alice/passport")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// deleting twice is fine
+	require.NoError(t, store.Delete(ctx, "kyc/alice/passport"))
+}
+
+func TestLocalStoreInvalidKey(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "kyc/../../outside", "kyc//alice", "kyc\\alice", "kyc/./alice"} {
+		require.ErrorIs(t, store.Put(context.Background(), key, []byte("x")), ErrInvalidKey, key)
+		_, err = store.Get(context.Background(), key)
+		require.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
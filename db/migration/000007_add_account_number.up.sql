@@ -0,0 +1,32 @@
+ALTER TABLE "accounts" ADD COLUMN "number" varchar;
+
+-- backfill existing accounts using the fixed util.BackfillCountryCode and util.BackfillBankCode, new accounts get their
+-- numbers from the application using the configured country and bank code
+CREATE FUNCTION "tmp_account_number"(country_code varchar, bban varchar) RETURNS varchar AS $$
+DECLARE
+    rearranged varchar := bban || country_code || '00';
+    remainder  int     := 0;
+    c          char;
+BEGIN
+    FOREACH c IN ARRAY regexp_split_to_array(rearranged, '')
+        LOOP
+            IF c BETWEEN '0' AND '9' THEN
+                remainder := (remainder * 10 + ascii(c) - ascii('0')) % 97;
+            ELSE
+                remainder := (remainder * 100 + ascii(c) - ascii('A') + 10) % 97;
+            END IF;
+        END LOOP;
+
+    RETURN country_code || lpad((98 - remainder)::text, 2, '0') || bban;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE "accounts"
+SET "number" = tmp_account_number('GB', 'SMPL' || lpad(floor(random() * 1e14)::bigint::text, 14, '0'))
+WHERE "number" IS NULL;
+
+DROP FUNCTION "tmp_account_number"(varchar, varchar);
+
+ALTER TABLE "accounts" ALTER COLUMN "number" SET NOT NULL;
+
+CREATE UNIQUE INDEX ON "accounts" ("number");
@@ -0,0 +1,32 @@
+CREATE TABLE "payment_requests"
+(
+    "id"            bigserial PRIMARY KEY,
+    "requester"     varchar     NOT NULL,
+    "payer"         varchar,
+    "is_link"       boolean     NOT NULL DEFAULT false,
+    "to_account_id" bigint      NOT NULL,
+    "amount"        bigint      NOT NULL,
+    "currency"      varchar     NOT NULL,
+    "description"   varchar(18),
+    "status"        varchar     NOT NULL DEFAULT 'pending',
+    "transfer_id"   bigint,
+    "expires_at"    timestamptz NOT NULL,
+    "created_at"    timestamptz NOT NULL DEFAULT (now()),
+    "resolved_at"   timestamptz
+);
+
+COMMENT ON COLUMN "payment_requests"."payer" IS 'null for shareable links until someone pays';
+
+COMMENT ON COLUMN "payment_requests"."amount" IS 'must be positive';
+
+CREATE INDEX ON "payment_requests" ("requester");
+
+CREATE INDEX ON "payment_requests" ("payer");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,3 @@
+ALTER TABLE "payment_requests" DROP COLUMN "bill_split_id";
+
+DROP TABLE IF EXISTS "bill_splits";
@@ -0,0 +1,29 @@
+CREATE TABLE "bill_splits"
+(
+    "id"            bigserial PRIMARY KEY,
+    "creator"       varchar     NOT NULL,
+    "to_account_id" bigint      NOT NULL,
+    "amount"        bigint      NOT NULL,
+    "currency"      varchar     NOT NULL,
+    "description"   varchar(18),
+    "split_method"  varchar     NOT NULL,
+    "creator_share" bigint      NOT NULL DEFAULT 0,
+    "created_at"    timestamptz NOT NULL DEFAULT (now()),
+    "reminded_at"   timestamptz
+);
+
+COMMENT ON COLUMN "bill_splits"."amount" IS 'total amount of the expense, must be positive';
+
+COMMENT ON COLUMN "bill_splits"."creator_share" IS 'part of the expense paid by the creator, not requested from anyone';
+
+CREATE INDEX ON "bill_splits" ("creator");
+
+ALTER TABLE "bill_splits" ADD FOREIGN KEY ("creator") REFERENCES "users" ("username");
+
+ALTER TABLE "bill_splits" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD COLUMN "bill_split_id" bigint;
+
+CREATE INDEX ON "payment_requests" ("bill_split_id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("bill_split_id") REFERENCES "bill_splits" ("id");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "direct_debits";
+DROP TABLE IF EXISTS "mandates";
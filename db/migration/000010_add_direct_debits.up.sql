@@ -0,0 +1,52 @@
+CREATE TABLE "mandates"
+(
+    "id"                  bigserial PRIMARY KEY,
+    "debtor"              varchar     NOT NULL,
+    "debtor_account_id"   bigint      NOT NULL,
+    "creditor"            varchar     NOT NULL,
+    "creditor_account_id" bigint      NOT NULL,
+    "max_amount"          bigint      NOT NULL,
+    "currency"            varchar     NOT NULL,
+    "frequency"           varchar     NOT NULL,
+    "reference"           varchar(18),
+    "status"              varchar     NOT NULL DEFAULT 'active',
+    "last_collected_at"   timestamptz,
+    "created_at"          timestamptz NOT NULL DEFAULT (now()),
+    "revoked_at"          timestamptz
+);
+
+COMMENT ON COLUMN "mandates"."max_amount" IS 'maximum amount per collection, must be positive';
+
+COMMENT ON COLUMN "mandates"."frequency" IS 'at most one collection per daily, weekly, monthly or yearly period';
+
+CREATE TABLE "direct_debits"
+(
+    "id"                     bigserial PRIMARY KEY,
+    "mandate_id"             bigint      NOT NULL,
+    "transfer_id"            bigint      NOT NULL,
+    "amount"                 bigint      NOT NULL,
+    "status"                 varchar     NOT NULL DEFAULT 'collected',
+    "chargeback_transfer_id" bigint,
+    "collected_at"           timestamptz NOT NULL DEFAULT (now()),
+    "charged_back_at"        timestamptz
+);
+
+CREATE INDEX ON "mandates" ("debtor");
+
+CREATE INDEX ON "mandates" ("creditor");
+
+CREATE INDEX ON "direct_debits" ("mandate_id");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("debtor") REFERENCES "users" ("username");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("debtor_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("creditor") REFERENCES "users" ("username");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("creditor_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "direct_debits" ADD FOREIGN KEY ("mandate_id") REFERENCES "mandates" ("id");
+
+ALTER TABLE "direct_debits" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "direct_debits" ADD FOREIGN KEY ("chargeback_transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "category_rules";
+
+DROP INDEX IF EXISTS "transfers_from_account_id_created_at_idx";
+
+ALTER TABLE "transfers" DROP COLUMN "category";
@@ -0,0 +1,23 @@
+ALTER TABLE "transfers" ADD COLUMN "category" varchar;
+
+COMMENT ON COLUMN "transfers"."category" IS 'spending category of the sender, null for transfers made before categorization';
+
+CREATE TABLE "category_rules"
+(
+    "id"                      bigserial PRIMARY KEY,
+    "owner"                   varchar     NOT NULL,
+    "keyword"                 varchar(18),
+    "counterparty_account_id" bigint,
+    "category"                varchar     NOT NULL,
+    "created_at"              timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "category_rules"."keyword" IS 'matched case-insensitively against the transfer description';
+
+CREATE INDEX ON "category_rules" ("owner");
+
+CREATE INDEX ON "transfers" ("from_account_id", "created_at");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("counterparty_account_id") REFERENCES "accounts" ("id");
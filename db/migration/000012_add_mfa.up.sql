@@ -0,0 +1,43 @@
+CREATE TABLE "totp_credentials"
+(
+    "username"       varchar PRIMARY KEY,
+    "secret"         varchar     NOT NULL,
+    "last_used_step" bigint      NOT NULL DEFAULT 0,
+    "confirmed_at"   timestamptz,
+    "created_at"     timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "totp_credentials"."last_used_step" IS 'time step of the last accepted code, codes cannot be replayed';
+
+COMMENT ON COLUMN "totp_credentials"."confirmed_at" IS 'two-factor authentication is enabled once enrollment is confirmed with a code';
+
+CREATE TABLE "recovery_codes"
+(
+    "id"          bigserial PRIMARY KEY,
+    "username"    varchar     NOT NULL,
+    "hashed_code" varchar     NOT NULL,
+    "used_at"     timestamptz,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "mfa_challenges"
+(
+    "id"           bigserial PRIMARY KEY,
+    "username"     varchar     NOT NULL,
+    "attempts"     int         NOT NULL DEFAULT 0,
+    "expires_at"   timestamptz NOT NULL,
+    "completed_at" timestamptz,
+    "created_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "mfa_challenges"."attempts" IS 'number of codes submitted, limited to prevent guessing';
+
+CREATE UNIQUE INDEX ON "recovery_codes" ("username", "hashed_code");
+
+CREATE INDEX ON "mfa_challenges" ("username");
+
+ALTER TABLE "totp_credentials" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
+
+ALTER TABLE "recovery_codes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
+
+ALTER TABLE "mfa_challenges" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
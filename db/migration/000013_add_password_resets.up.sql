@@ -0,0 +1,17 @@
+CREATE TABLE "password_resets"
+(
+    "id"           bigserial PRIMARY KEY,
+    "username"     varchar     NOT NULL,
+    "hashed_token" varchar     NOT NULL,
+    "is_used"      bool        NOT NULL DEFAULT false,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "expired_at"   timestamptz NOT NULL DEFAULT (now() + interval '30 minutes')
+);
+
+COMMENT ON COLUMN "password_resets"."hashed_token" IS 'SHA-256 of the token sent by email, the token itself is never stored';
+
+CREATE UNIQUE INDEX ON "password_resets" ("hashed_token");
+
+CREATE INDEX ON "password_resets" ("username");
+
+ALTER TABLE "password_resets" ADD FOREIGN KEY ("username") REFERENCES "users" ("username") ON DELETE CASCADE;
@@ -0,0 +1,7 @@
+-- The raw tokens cannot be recovered, sessions have to log in again
+UPDATE "sessions"
+SET "is_blocked" = true;
+
+COMMENT ON COLUMN "sessions"."hashed_refresh_token" IS NULL;
+
+ALTER TABLE "sessions" RENAME COLUMN "hashed_refresh_token" TO "refresh_token";
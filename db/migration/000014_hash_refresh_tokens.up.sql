@@ -0,0 +1,6 @@
+ALTER TABLE "sessions" RENAME COLUMN "refresh_token" TO "hashed_refresh_token";
+
+UPDATE "sessions"
+SET "hashed_refresh_token" = encode(sha256(convert_to("hashed_refresh_token", 'UTF8')), 'hex');
+
+COMMENT ON COLUMN "sessions"."hashed_refresh_token" IS 'SHA-256 of the latest refresh token of the session, rotated on every renewal';
@@ -0,0 +1,22 @@
+CREATE TABLE "login_attempts"
+(
+    "id"             bigserial PRIMARY KEY,
+    "username"       varchar     NOT NULL,
+    "client_ip"      varchar     NOT NULL,
+    "user_agent"     varchar     NOT NULL,
+    "succeeded"      bool        NOT NULL,
+    "failure_reason" varchar,
+    "created_at"     timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "login_attempts"."username" IS 'as entered, attempts for unknown usernames are recorded too';
+
+CREATE INDEX ON "login_attempts" ("username", "created_at");
+
+CREATE INDEX ON "login_attempts" ("client_ip", "created_at");
+
+ALTER TABLE "users" ADD COLUMN "locked_until" timestamptz;
+
+ALTER TABLE "users" ADD COLUMN "unlocked_at" timestamptz;
+
+COMMENT ON COLUMN "users"."unlocked_at" IS 'failed logins before a banker unlocked the user no longer count towards a lockout';
@@ -0,0 +1,129 @@
+CREATE TABLE "roles"
+(
+    "name"        varchar PRIMARY KEY,
+    "description" varchar     NOT NULL,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "permissions"
+(
+    "name"        varchar PRIMARY KEY,
+    "description" varchar NOT NULL
+);
+
+CREATE TABLE "role_permissions"
+(
+    "role"       varchar NOT NULL,
+    "permission" varchar NOT NULL,
+    PRIMARY KEY ("role", "permission")
+);
+
+COMMENT ON TABLE "role_permissions" IS 'permissions are resolved on every request, changes take effect immediately';
+
+ALTER TABLE "role_permissions" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name") ON DELETE CASCADE;
+
+ALTER TABLE "role_permissions" ADD FOREIGN KEY ("permission") REFERENCES "permissions" ("name") ON DELETE CASCADE;
+
+INSERT INTO "roles" ("name", "description")
+VALUES ('depositor', 'Customer managing their own money'),
+       ('banker', 'Bank employee serving customers'),
+       ('auditor', 'Read-only access to all data'),
+       ('support', 'Support agent helping customers with their access'),
+       ('admin', 'Manages users and their roles');
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('accounts:create', 'Open accounts'),
+       ('accounts:read', 'View own accounts'),
+       ('accounts:read:any', 'View accounts of any user'),
+       ('accounts:delete', 'Close own accounts'),
+       ('accounts:delete:any', 'Close accounts of any user'),
+       ('transfers:create', 'Send money from own accounts'),
+       ('transfers:read', 'View own transfers and spending'),
+       ('transfers:read:any', 'View transfers and spending of any user'),
+       ('transfers:categorize', 'Categorize own transfers and manage category rules'),
+       ('transfers:approve', 'Approve transfers held for review'),
+       ('payments:create', 'Request money and split bills'),
+       ('payments:respond', 'Accept and decline payment requests'),
+       ('payments:read', 'View own payment requests and bill splits'),
+       ('payments:read:any', 'View payment requests and bill splits of any user'),
+       ('debits:manage', 'Grant and revoke mandates, collect and charge back direct debits'),
+       ('debits:read', 'View own mandates and direct debits'),
+       ('debits:read:any', 'View mandates and direct debits of any user'),
+       ('users:update', 'Update own profile'),
+       ('users:update:any', 'Update the profile of any user'),
+       ('users:read:any', 'View users and their login history'),
+       ('users:unlock', 'Unlock users locked out after failed logins'),
+       ('users:impersonate', 'Act as a customer to see what they see'),
+       ('sessions:revoke:any', 'Log any user out of all sessions'),
+       ('roles:read', 'View roles and their permissions'),
+       ('roles:assign', 'Change the role of users');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('depositor', 'accounts:create'),
+       ('depositor', 'accounts:read'),
+       ('depositor', 'accounts:delete'),
+       ('depositor', 'transfers:create'),
+       ('depositor', 'transfers:read'),
+       ('depositor', 'transfers:categorize'),
+       ('depositor', 'payments:create'),
+       ('depositor', 'payments:respond'),
+       ('depositor', 'payments:read'),
+       ('depositor', 'debits:manage'),
+       ('depositor', 'debits:read'),
+       ('depositor', 'users:update'),
+
+       ('banker', 'accounts:read'),
+       ('banker', 'accounts:read:any'),
+       ('banker', 'accounts:delete'),
+       ('banker', 'accounts:delete:any'),
+       ('banker', 'transfers:create'),
+       ('banker', 'transfers:read'),
+       ('banker', 'transfers:read:any'),
+       ('banker', 'transfers:categorize'),
+       ('banker', 'transfers:approve'),
+       ('banker', 'payments:create'),
+       ('banker', 'payments:respond'),
+       ('banker', 'payments:read'),
+       ('banker', 'payments:read:any'),
+       ('banker', 'debits:manage'),
+       ('banker', 'debits:read'),
+       ('banker', 'debits:read:any'),
+       ('banker', 'users:update'),
+       ('banker', 'users:update:any'),
+       ('banker', 'users:read:any'),
+       ('banker', 'users:unlock'),
+       ('banker', 'sessions:revoke:any'),
+
+       ('auditor', 'accounts:read'),
+       ('auditor', 'accounts:read:any'),
+       ('auditor', 'transfers:read'),
+       ('auditor', 'transfers:read:any'),
+       ('auditor', 'payments:read'),
+       ('auditor', 'payments:read:any'),
+       ('auditor', 'debits:read'),
+       ('auditor', 'debits:read:any'),
+       ('auditor', 'users:read:any'),
+       ('auditor', 'roles:read'),
+
+       ('support', 'accounts:read'),
+       ('support', 'accounts:read:any'),
+       ('support', 'transfers:read'),
+       ('support', 'transfers:read:any'),
+       ('support', 'payments:read'),
+       ('support', 'payments:read:any'),
+       ('support', 'debits:read'),
+       ('support', 'debits:read:any'),
+       ('support', 'users:read:any'),
+       ('support', 'users:unlock'),
+       ('support', 'users:impersonate'),
+       ('support', 'sessions:revoke:any'),
+
+       ('admin', 'users:update'),
+       ('admin', 'users:update:any'),
+       ('admin', 'users:read:any'),
+       ('admin', 'users:unlock'),
+       ('admin', 'sessions:revoke:any'),
+       ('admin', 'roles:read'),
+       ('admin', 'roles:assign');
+
+ALTER TABLE "users" ADD FOREIGN KEY ("role") REFERENCES "roles" ("name");
@@ -0,0 +1,4 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'service_clients:manage';
+DELETE FROM "permissions" WHERE "name" = 'service_clients:manage';
+DROP TABLE IF EXISTS "service_client_keys";
+DROP TABLE IF EXISTS "service_clients";
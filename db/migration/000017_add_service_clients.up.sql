@@ -0,0 +1,41 @@
+CREATE TABLE "service_clients"
+(
+    "id"          varchar PRIMARY KEY,
+    "owner"       varchar     NOT NULL,
+    "name"        varchar     NOT NULL,
+    "scopes"      varchar[]   NOT NULL,
+    "account_ids" bigint[]    NOT NULL DEFAULT '{}',
+    "disabled_at" timestamptz,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "service_clients"."scopes" IS 'permissions of the owner the client can use';
+
+COMMENT ON COLUMN "service_clients"."account_ids" IS 'accounts of the owner the client can use, empty for all of them';
+
+CREATE INDEX ON "service_clients" ("owner");
+
+ALTER TABLE "service_clients" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+CREATE TABLE "service_client_keys"
+(
+    "id"            bigserial PRIMARY KEY,
+    "client_id"     varchar     NOT NULL,
+    "hashed_secret" varchar     NOT NULL UNIQUE,
+    "expires_at"    timestamptz,
+    "last_used_at"  timestamptz,
+    "created_at"    timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "service_client_keys"."expires_at" IS 'set when the key is rotated, so integrations can switch to the new key in the meantime';
+
+CREATE INDEX ON "service_client_keys" ("client_id");
+
+ALTER TABLE "service_client_keys" ADD FOREIGN KEY ("client_id") REFERENCES "service_clients" ("id");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('service_clients:manage', 'Create API keys for own integrations');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('depositor', 'service_clients:manage'),
+       ('banker', 'service_clients:manage');
@@ -0,0 +1,4 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'users:impersonate:write';
+DELETE FROM "permissions" WHERE "name" = 'users:impersonate:write';
+DROP TABLE IF EXISTS "audit_events";
+DROP TABLE IF EXISTS "impersonations";
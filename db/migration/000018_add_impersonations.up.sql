@@ -0,0 +1,45 @@
+CREATE TABLE "impersonations"
+(
+    "id"                      uuid PRIMARY KEY,
+    "impersonator"            varchar     NOT NULL,
+    "impersonator_session_id" uuid        NOT NULL,
+    "username"                varchar     NOT NULL,
+    "reason"                  varchar     NOT NULL,
+    "read_only"               bool        NOT NULL DEFAULT true,
+    "expires_at"              timestamptz NOT NULL,
+    "created_at"              timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "impersonations"."impersonator_session_id" IS 'the impersonation ends when the session of the impersonator is blocked';
+
+CREATE INDEX ON "impersonations" ("username", "created_at");
+
+ALTER TABLE "impersonations" ADD FOREIGN KEY ("impersonator") REFERENCES "users" ("username");
+
+ALTER TABLE "impersonations" ADD FOREIGN KEY ("impersonator_session_id") REFERENCES "sessions" ("id");
+
+ALTER TABLE "impersonations" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+CREATE TABLE "audit_events"
+(
+    "id"               bigserial PRIMARY KEY,
+    "username"         varchar     NOT NULL,
+    "impersonator"     varchar,
+    "impersonation_id" uuid,
+    "method"           varchar     NOT NULL,
+    "status_code"      varchar     NOT NULL,
+    "created_at"       timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "audit_events"."username" IS 'the user the call was made as';
+
+COMMENT ON COLUMN "audit_events"."impersonator" IS 'the employee acting as the user, if the call was made under impersonation';
+
+CREATE INDEX ON "audit_events" ("username", "created_at");
+
+CREATE INDEX ON "audit_events" ("impersonation_id");
+
+ALTER TABLE "audit_events" ADD FOREIGN KEY ("impersonation_id") REFERENCES "impersonations" ("id");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('users:impersonate:write', 'Make changes while impersonating a customer');
@@ -0,0 +1,13 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'audit:read';
+DELETE FROM "permissions" WHERE "name" = 'audit:read';
+DROP TRIGGER IF EXISTS "audit_events_no_truncate" ON "audit_events";
+DROP TRIGGER IF EXISTS "audit_events_append_only" ON "audit_events";
+DROP FUNCTION IF EXISTS "reject_audit_event_changes"();
+DELETE FROM "audit_events" WHERE "username" IS NULL;
+ALTER TABLE "audit_events" ALTER COLUMN "username" SET NOT NULL;
+ALTER TABLE "audit_events" DROP COLUMN "user_agent";
+ALTER TABLE "audit_events" DROP COLUMN "client_ip";
+ALTER TABLE "audit_events" DROP COLUMN "request";
+ALTER TABLE "audit_events" DROP COLUMN "resources";
+ALTER TABLE "audit_events" DROP COLUMN "client_id";
+ALTER TABLE "audit_events" DROP COLUMN "role";
@@ -0,0 +1,51 @@
+ALTER TABLE "audit_events" ALTER COLUMN "username" DROP NOT NULL;
+
+COMMENT ON COLUMN "audit_events"."username" IS 'the user the call was made as, null for calls without an access token';
+
+ALTER TABLE "audit_events" ADD COLUMN "role" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "audit_events" ADD COLUMN "client_id" varchar;
+
+ALTER TABLE "audit_events" ADD COLUMN "resources" varchar[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "audit_events"."resources" IS 'resources the call acted on as type/id, e.g. accounts/42';
+
+ALTER TABLE "audit_events" ADD COLUMN "request" jsonb NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN "audit_events"."request" IS 'the request with its sensitive fields redacted';
+
+ALTER TABLE "audit_events" ADD COLUMN "client_ip" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "audit_events" ADD COLUMN "user_agent" varchar NOT NULL DEFAULT '';
+
+CREATE INDEX ON "audit_events" ("impersonator", "created_at");
+
+CREATE INDEX ON "audit_events" ("created_at");
+
+CREATE INDEX ON "audit_events" USING GIN ("resources");
+
+-- the audit log is append-only, not even the application can change or remove what it recorded
+CREATE FUNCTION "reject_audit_event_changes"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_append_only"
+    BEFORE UPDATE OR DELETE
+    ON "audit_events"
+    FOR EACH ROW
+EXECUTE FUNCTION "reject_audit_event_changes"();
+
+CREATE TRIGGER "audit_events_no_truncate"
+    BEFORE TRUNCATE
+    ON "audit_events"
+    FOR EACH STATEMENT
+EXECUTE FUNCTION "reject_audit_event_changes"();
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('audit:read', 'View the audit log');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'audit:read'),
+       ('auditor', 'audit:read');
@@ -0,0 +1 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "verification_email_sent_at";
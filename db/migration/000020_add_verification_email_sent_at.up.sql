@@ -0,0 +1 @@
+ALTER TABLE "users" ADD COLUMN "verification_email_sent_at" timestamptz DEFAULT (now());
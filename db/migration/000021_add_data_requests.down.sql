@@ -0,0 +1,5 @@
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "closed_at";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "erased_at";
+
+DROP TABLE IF EXISTS "data_requests";
@@ -0,0 +1,20 @@
+CREATE TABLE "data_requests"
+(
+    "id"           bigserial PRIMARY KEY,
+    "username"     varchar     NOT NULL,
+    "kind"         varchar     NOT NULL,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "completed_at" timestamptz
+);
+
+COMMENT ON COLUMN "data_requests"."kind" IS 'export or erasure';
+
+CREATE INDEX ON "data_requests" ("username", "kind", "created_at");
+
+ALTER TABLE "data_requests" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "users" ADD COLUMN "erased_at" timestamptz;
+
+COMMENT ON COLUMN "users"."erased_at" IS 'personal data has been pseudonymized, the username is kept as the ledger refers to it';
+
+ALTER TABLE "accounts" ADD COLUMN "closed_at" timestamptz;
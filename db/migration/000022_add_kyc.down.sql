@@ -0,0 +1,5 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'kyc:review';
+DELETE FROM "permissions" WHERE "name" = 'kyc:review';
+DROP TABLE IF EXISTS "kyc_documents";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "kyc_status";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "kyc_tier";
@@ -0,0 +1,46 @@
+ALTER TABLE "users" ADD COLUMN "kyc_tier" int NOT NULL DEFAULT 0;
+
+ALTER TABLE "users" ADD COLUMN "kyc_status" varchar NOT NULL DEFAULT 'none';
+
+COMMENT ON COLUMN "users"."kyc_tier" IS 'limits of what the user can do, raised by approved documents';
+
+COMMENT ON COLUMN "users"."kyc_status" IS 'none, pending_review, approved or rejected';
+
+-- customers who signed up before identity checks keep the basic tier until they upload documents
+UPDATE "users" SET "kyc_tier" = 1, "kyc_status" = 'approved';
+
+CREATE TABLE "kyc_documents"
+(
+    "id"               bigserial PRIMARY KEY,
+    "username"         varchar     NOT NULL,
+    "kind"             varchar     NOT NULL,
+    "blob_key"         varchar     NOT NULL,
+    "content_type"     varchar     NOT NULL,
+    "size"             bigint      NOT NULL,
+    "sha256"           varchar     NOT NULL,
+    "status"           varchar     NOT NULL DEFAULT 'pending',
+    "rejection_reason" varchar,
+    "reviewed_by"      varchar,
+    "reviewed_at"      timestamptz,
+    "created_at"       timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "kyc_documents"."kind" IS 'identity or proof_of_address';
+
+COMMENT ON COLUMN "kyc_documents"."blob_key" IS 'key of the uploaded file in the blob store';
+
+COMMENT ON COLUMN "kyc_documents"."status" IS 'pending, approved or rejected';
+
+CREATE INDEX ON "kyc_documents" ("username");
+
+CREATE INDEX ON "kyc_documents" ("status", "id");
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('kyc:review', 'View and approve or reject identity documents');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'kyc:review');
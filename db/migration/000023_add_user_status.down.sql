@@ -0,0 +1,5 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'users:suspend';
+DELETE FROM "permissions" WHERE "name" = 'users:suspend';
+DROP TABLE IF EXISTS "user_status_changes";
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "frozen_at";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "status";
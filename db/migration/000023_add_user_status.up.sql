@@ -0,0 +1,31 @@
+ALTER TABLE "users" ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+COMMENT ON COLUMN "users"."status" IS 'active, suspended or deactivated, only active users can log in';
+
+ALTER TABLE "accounts" ADD COLUMN "frozen_at" timestamptz;
+
+COMMENT ON COLUMN "accounts"."frozen_at" IS 'no money moves in or out of frozen accounts';
+
+CREATE TABLE "user_status_changes"
+(
+    "id"              bigserial PRIMARY KEY,
+    "username"        varchar     NOT NULL,
+    "previous_status" varchar     NOT NULL,
+    "status"          varchar     NOT NULL,
+    "reason"          varchar     NOT NULL,
+    "changed_by"      varchar     NOT NULL,
+    "created_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "user_status_changes" ("username");
+
+ALTER TABLE "user_status_changes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "user_status_changes" ADD FOREIGN KEY ("changed_by") REFERENCES "users" ("username");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('users:suspend', 'Suspend, deactivate and reactivate users');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'users:suspend'),
+       ('admin', 'users:suspend');
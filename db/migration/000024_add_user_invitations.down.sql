@@ -0,0 +1,4 @@
+DELETE FROM "role_permissions" WHERE "permission" IN ('users:search', 'users:create:any');
+DELETE FROM "permissions" WHERE "name" IN ('users:search', 'users:create:any');
+DROP INDEX IF EXISTS "users_lower_idx";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "invited_by";
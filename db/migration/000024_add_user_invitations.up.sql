@@ -0,0 +1,17 @@
+ALTER TABLE "users" ADD COLUMN "invited_by" varchar;
+
+COMMENT ON COLUMN "users"."invited_by" IS 'banker who created the user on behalf of the customer';
+
+ALTER TABLE "users" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+CREATE INDEX ON "users" (lower("email"));
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('users:search', 'Search users and view their details'),
+       ('users:create:any', 'Create users on behalf of customers');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'users:search'),
+       ('banker', 'users:create:any'),
+       ('admin', 'users:search'),
+       ('admin', 'users:create:any');
@@ -0,0 +1,3 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'fraud:review';
+DELETE FROM "permissions" WHERE "name" = 'fraud:review';
+DROP TABLE IF EXISTS "fraud_cases";
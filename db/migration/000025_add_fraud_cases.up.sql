@@ -0,0 +1,48 @@
+CREATE TABLE "fraud_cases"
+(
+    "id"              bigserial PRIMARY KEY,
+    "username"        varchar     NOT NULL,
+    "from_account_id" bigint      NOT NULL,
+    "to_account_id"   bigint      NOT NULL,
+    "amount"          bigint      NOT NULL,
+    "description"     varchar     NOT NULL,
+    "rule"            varchar     NOT NULL,
+    "features"        jsonb       NOT NULL,
+    "status"          varchar     NOT NULL DEFAULT 'open',
+    "resolved_by"     varchar,
+    "resolution_note" varchar,
+    "resolved_at"     timestamptz,
+    "transfer_id"     bigint,
+    "created_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "fraud_cases" IS 'transfers held back by a fraud rule until a banker reviews them';
+
+COMMENT ON COLUMN "fraud_cases"."rule" IS 'name of the fraud rule that held back the transfer';
+
+COMMENT ON COLUMN "fraud_cases"."features" IS 'what the rules were evaluated against';
+
+COMMENT ON COLUMN "fraud_cases"."status" IS 'open, approved or rejected';
+
+COMMENT ON COLUMN "fraud_cases"."transfer_id" IS 'the transfer made once the case was approved';
+
+CREATE INDEX ON "fraud_cases" ("status", "id");
+
+CREATE INDEX ON "fraud_cases" ("username");
+
+ALTER TABLE "fraud_cases" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "fraud_cases" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fraud_cases" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fraud_cases" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fraud_cases" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('fraud:review', 'Review transfers held back by fraud rules');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'fraud:review'),
+       ('admin', 'fraud:review');
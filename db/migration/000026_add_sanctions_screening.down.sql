@@ -0,0 +1,4 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'screening:review';
+DELETE FROM "permissions" WHERE "name" = 'screening:review';
+DROP TABLE IF EXISTS "screening_cases";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "restricted_at";
@@ -0,0 +1,47 @@
+ALTER TABLE "users" ADD COLUMN "restricted_at" timestamptz;
+
+COMMENT ON COLUMN "users"."restricted_at" IS 'set while the user matches the sanctions watchlist, restricted users cannot move money';
+
+CREATE TABLE "screening_cases"
+(
+    "id"              bigserial PRIMARY KEY,
+    "username"        varchar     NOT NULL,
+    "trigger"         varchar     NOT NULL,
+    "screened_name"   varchar     NOT NULL,
+    "entry_id"        varchar     NOT NULL,
+    "entry_name"      varchar     NOT NULL,
+    "programs"        varchar[]   NOT NULL,
+    "score"           float8      NOT NULL,
+    "status"          varchar     NOT NULL DEFAULT 'open',
+    "resolved_by"     varchar,
+    "resolution_note" varchar,
+    "resolved_at"     timestamptz,
+    "created_at"      timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "screening_cases" IS 'users whose name matched the sanctions watchlist, waiting for a banker to review them';
+
+COMMENT ON COLUMN "screening_cases"."trigger" IS 'signup, name_change or payee';
+
+COMMENT ON COLUMN "screening_cases"."entry_id" IS 'id of the matched watchlist entry';
+
+COMMENT ON COLUMN "screening_cases"."entry_name" IS 'name or alias of the watchlist entry that matched';
+
+COMMENT ON COLUMN "screening_cases"."score" IS 'Jaro-Winkler similarity of the names, between 0 and 1';
+
+COMMENT ON COLUMN "screening_cases"."status" IS 'open, cleared as a false positive or confirmed as a true match';
+
+CREATE UNIQUE INDEX ON "screening_cases" ("username", "entry_id", "screened_name");
+
+CREATE INDEX ON "screening_cases" ("status", "id");
+
+ALTER TABLE "screening_cases" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "screening_cases" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('screening:review', 'Review sanctions screening matches');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('banker', 'screening:review'),
+       ('admin', 'screening:review');
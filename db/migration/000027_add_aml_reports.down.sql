@@ -0,0 +1,5 @@
+DELETE FROM "role_permissions" WHERE "permission" = 'aml:read';
+DELETE FROM "permissions" WHERE "name" = 'aml:read';
+DROP TABLE IF EXISTS "aml_reports";
+UPDATE "users" SET "role" = 'depositor' WHERE "role" = 'compliance';
+DELETE FROM "roles" WHERE "name" = 'compliance';
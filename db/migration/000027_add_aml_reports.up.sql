@@ -0,0 +1,42 @@
+INSERT INTO "roles" ("name", "description")
+VALUES ('compliance', 'Compliance officer monitoring for money laundering');
+
+CREATE TABLE "aml_reports"
+(
+    "id"           bigserial PRIMARY KEY,
+    "kind"         varchar     NOT NULL,
+    "username"     varchar     NOT NULL,
+    "currency"     varchar     NOT NULL,
+    "total_amount" bigint      NOT NULL,
+    "transfer_ids" bigint[]    NOT NULL,
+    "window_start" timestamptz NOT NULL,
+    "window_end"   timestamptz NOT NULL,
+    "created_at"   timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON TABLE "aml_reports" IS 'transfers the periodic anti-money-laundering scan found suspicious';
+
+COMMENT ON COLUMN "aml_reports"."kind" IS 'large_transaction or structuring';
+
+COMMENT ON COLUMN "aml_reports"."username" IS 'the sender of the transfers';
+
+COMMENT ON COLUMN "aml_reports"."transfer_ids" IS 'the transfers supporting the report, a transfer supports at most one report of a kind';
+
+COMMENT ON COLUMN "aml_reports"."window_start" IS 'when the first of the transfers was made';
+
+COMMENT ON COLUMN "aml_reports"."window_end" IS 'when the last of the transfers was made';
+
+CREATE INDEX ON "aml_reports" ("username");
+
+CREATE INDEX ON "aml_reports" ("created_at");
+
+CREATE INDEX ON "aml_reports" USING gin ("transfer_ids");
+
+ALTER TABLE "aml_reports" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('aml:read', 'View and export anti-money-laundering reports');
+
+INSERT INTO "role_permissions" ("role", "permission")
+VALUES ('compliance', 'aml:read'),
+       ('admin', 'aml:read');
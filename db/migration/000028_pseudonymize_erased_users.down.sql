@@ -0,0 +1,24 @@
+CREATE OR REPLACE FUNCTION "reject_audit_event_changes"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DO
+$$
+    DECLARE
+        fk record;
+    BEGIN
+        FOR fk IN SELECT conrelid::regclass AS tbl, conname, pg_get_constraintdef(oid) AS def
+                  FROM pg_constraint
+                  WHERE contype = 'f'
+                    AND confrelid = 'users'::regclass
+            LOOP
+                EXECUTE format('ALTER TABLE %s DROP CONSTRAINT %I', fk.tbl, fk.conname);
+                EXECUTE format('ALTER TABLE %s ADD CONSTRAINT %I %s', fk.tbl, fk.conname,
+                               replace(fk.def, ' ON UPDATE CASCADE', ''));
+            END LOOP;
+    END
+$$;
+
+COMMENT ON COLUMN "users"."erased_at" IS 'personal data has been pseudonymized, the username is kept as the ledger refers to it';
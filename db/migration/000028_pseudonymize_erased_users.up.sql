@@ -0,0 +1,33 @@
+-- erasing a user renames them to a pseudonym, which has to follow every reference to the username
+DO
+$$
+    DECLARE
+        fk record;
+    BEGIN
+        FOR fk IN SELECT conrelid::regclass AS tbl, conname, pg_get_constraintdef(oid) AS def
+                  FROM pg_constraint
+                  WHERE contype = 'f'
+                    AND confrelid = 'users'::regclass
+            LOOP
+                EXECUTE format('ALTER TABLE %s DROP CONSTRAINT %I', fk.tbl, fk.conname);
+                EXECUTE format('ALTER TABLE %s ADD CONSTRAINT %I %s ON UPDATE CASCADE', fk.tbl, fk.conname, fk.def);
+            END LOOP;
+    END
+$$;
+
+COMMENT ON COLUMN "users"."erased_at" IS 'personal data has been removed and the user renamed to a pseudonym';
+
+-- the audit log stays append-only, except that erasing a user pseudonymizes the events about them
+CREATE OR REPLACE FUNCTION "reject_audit_event_changes"() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND current_setting('simplebank.erasing_user', TRUE) = 'on'
+        AND NEW.id = OLD.id
+        AND NEW.method = OLD.method
+        AND NEW.status_code = OLD.status_code
+        AND NEW.created_at = OLD.created_at THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
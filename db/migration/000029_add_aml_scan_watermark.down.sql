@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "aml_scan_watermark";
@@ -0,0 +1,9 @@
+CREATE TABLE "aml_scan_watermark"
+(
+    "id"            boolean PRIMARY KEY DEFAULT TRUE CHECK ("id"),
+    "scanned_until" timestamptz NOT NULL
+);
+
+COMMENT ON TABLE "aml_scan_watermark" IS 'progress of the periodic anti-money-laundering scan, it has at most one row';
+
+COMMENT ON COLUMN "aml_scan_watermark"."scanned_until" IS 'when the last successful scan started, large transfers are scanned from there so missed runs lose no reports';
@@ -13,8 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 	db "simplebank/db/sqlc"
+	time "time"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +44,21 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptPaymentRequestTx mocks base method.
+func (m *MockStore) AcceptPaymentRequestTx(ctx context.Context, arg db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequestTx", ctx, arg)
+	ret0, _ := ret[0].(db.AcceptPaymentRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequestTx indicates an expected call of AcceptPaymentRequestTx.
+func (mr *MockStoreMockRecorder) AcceptPaymentRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequestTx", reflect.TypeOf((*MockStore)(nil).AcceptPaymentRequestTx), ctx, arg)
+}
+
 // AddAccountBalance mocks base method.
 func (m *MockStore) AddAccountBalance(ctx context.Context, arg db.AddAccountBalanceParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -51,264 +68,2351 @@ func (m *MockStore) AddAccountBalance(ctx context.Context, arg db.AddAccountBala
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
+// AddAccountBalance indicates an expected call of AddAccountBalance.
+func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
+}
+
+// AllowAuditEventErasure mocks base method.
+func (m *MockStore) AllowAuditEventErasure(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowAuditEventErasure", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllowAuditEventErasure indicates an expected call of AllowAuditEventErasure.
+func (mr *MockStoreMockRecorder) AllowAuditEventErasure(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowAuditEventErasure", reflect.TypeOf((*MockStore)(nil).AllowAuditEventErasure), ctx)
+}
+
+// AssignUserRoleTx mocks base method.
+func (m *MockStore) AssignUserRoleTx(ctx context.Context, arg db.AssignUserRoleTxParams) (db.AssignUserRoleTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignUserRoleTx", ctx, arg)
+	ret0, _ := ret[0].(db.AssignUserRoleTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignUserRoleTx indicates an expected call of AssignUserRoleTx.
+func (mr *MockStoreMockRecorder) AssignUserRoleTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignUserRoleTx", reflect.TypeOf((*MockStore)(nil).AssignUserRoleTx), ctx, arg)
+}
+
+// AttemptMfaChallenge mocks base method.
+func (m *MockStore) AttemptMfaChallenge(ctx context.Context, arg db.AttemptMfaChallengeParams) (db.MfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttemptMfaChallenge", ctx, arg)
+	ret0, _ := ret[0].(db.MfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttemptMfaChallenge indicates an expected call of AttemptMfaChallenge.
+func (mr *MockStoreMockRecorder) AttemptMfaChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttemptMfaChallenge", reflect.TypeOf((*MockStore)(nil).AttemptMfaChallenge), ctx, arg)
+}
+
+// BlockOtherSessions mocks base method.
+func (m *MockStore) BlockOtherSessions(ctx context.Context, arg db.BlockOtherSessionsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOtherSessions", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockOtherSessions indicates an expected call of BlockOtherSessions.
+func (mr *MockStoreMockRecorder) BlockOtherSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOtherSessions", reflect.TypeOf((*MockStore)(nil).BlockOtherSessions), ctx, arg)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(ctx context.Context, arg db.BlockSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", ctx, arg)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), ctx, arg)
+}
+
+// BlockSessions mocks base method.
+func (m *MockStore) BlockSessions(ctx context.Context, username string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessions", ctx, username)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSessions indicates an expected call of BlockSessions.
+func (mr *MockStoreMockRecorder) BlockSessions(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessions", reflect.TypeOf((*MockStore)(nil).BlockSessions), ctx, username)
+}
+
+// ChangeUserStatusTx mocks base method.
+func (m *MockStore) ChangeUserStatusTx(ctx context.Context, arg db.ChangeUserStatusTxParams) (db.ChangeUserStatusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserStatusTx", ctx, arg)
+	ret0, _ := ret[0].(db.ChangeUserStatusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUserStatusTx indicates an expected call of ChangeUserStatusTx.
+func (mr *MockStoreMockRecorder) ChangeUserStatusTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserStatusTx", reflect.TypeOf((*MockStore)(nil).ChangeUserStatusTx), ctx, arg)
+}
+
+// ChargebackDirectDebit mocks base method.
+func (m *MockStore) ChargebackDirectDebit(ctx context.Context, arg db.ChargebackDirectDebitParams) (db.DirectDebit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargebackDirectDebit", ctx, arg)
+	ret0, _ := ret[0].(db.DirectDebit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargebackDirectDebit indicates an expected call of ChargebackDirectDebit.
+func (mr *MockStoreMockRecorder) ChargebackDirectDebit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargebackDirectDebit", reflect.TypeOf((*MockStore)(nil).ChargebackDirectDebit), ctx, arg)
+}
+
+// ChargebackDirectDebitTx mocks base method.
+func (m *MockStore) ChargebackDirectDebitTx(ctx context.Context, arg db.ChargebackDirectDebitTxParams) (db.ChargebackDirectDebitTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargebackDirectDebitTx", ctx, arg)
+	ret0, _ := ret[0].(db.ChargebackDirectDebitTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargebackDirectDebitTx indicates an expected call of ChargebackDirectDebitTx.
+func (mr *MockStoreMockRecorder) ChargebackDirectDebitTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargebackDirectDebitTx", reflect.TypeOf((*MockStore)(nil).ChargebackDirectDebitTx), ctx, arg)
+}
+
+// CloseAccounts mocks base method.
+func (m *MockStore) CloseAccounts(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccounts", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccounts indicates an expected call of CloseAccounts.
+func (mr *MockStoreMockRecorder) CloseAccounts(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccounts", reflect.TypeOf((*MockStore)(nil).CloseAccounts), ctx, owner)
+}
+
+// CollectDirectDebitTx mocks base method.
+func (m *MockStore) CollectDirectDebitTx(ctx context.Context, arg db.CollectDirectDebitTxParams) (db.CollectDirectDebitTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectDirectDebitTx", ctx, arg)
+	ret0, _ := ret[0].(db.CollectDirectDebitTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectDirectDebitTx indicates an expected call of CollectDirectDebitTx.
+func (mr *MockStoreMockRecorder) CollectDirectDebitTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectDirectDebitTx", reflect.TypeOf((*MockStore)(nil).CollectDirectDebitTx), ctx, arg)
+}
+
+// CompleteDataRequest mocks base method.
+func (m *MockStore) CompleteDataRequest(ctx context.Context, id int64) (db.DataRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataRequest", ctx, id)
+	ret0, _ := ret[0].(db.DataRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteDataRequest indicates an expected call of CompleteDataRequest.
+func (mr *MockStoreMockRecorder) CompleteDataRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataRequest", reflect.TypeOf((*MockStore)(nil).CompleteDataRequest), ctx, id)
+}
+
+// CompleteMfaChallenge mocks base method.
+func (m *MockStore) CompleteMfaChallenge(ctx context.Context, id int64) (db.MfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMfaChallenge", ctx, id)
+	ret0, _ := ret[0].(db.MfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMfaChallenge indicates an expected call of CompleteMfaChallenge.
+func (mr *MockStoreMockRecorder) CompleteMfaChallenge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMfaChallenge", reflect.TypeOf((*MockStore)(nil).CompleteMfaChallenge), ctx, id)
+}
+
+// ConfirmTotpCredential mocks base method.
+func (m *MockStore) ConfirmTotpCredential(ctx context.Context, username string) (db.TotpCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTotpCredential", ctx, username)
+	ret0, _ := ret[0].(db.TotpCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTotpCredential indicates an expected call of ConfirmTotpCredential.
+func (mr *MockStoreMockRecorder) ConfirmTotpCredential(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTotpCredential", reflect.TypeOf((*MockStore)(nil).ConfirmTotpCredential), ctx, username)
+}
+
+// ConfirmTotpTx mocks base method.
+func (m *MockStore) ConfirmTotpTx(ctx context.Context, arg db.ConfirmTotpTxParams) (db.ConfirmTotpTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTotpTx", ctx, arg)
+	ret0, _ := ret[0].(db.ConfirmTotpTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTotpTx indicates an expected call of ConfirmTotpTx.
+func (mr *MockStoreMockRecorder) ConfirmTotpTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTotpTx", reflect.TypeOf((*MockStore)(nil).ConfirmTotpTx), ctx, arg)
+}
+
+// CountLoginFailuresByIP mocks base method.
+func (m *MockStore) CountLoginFailuresByIP(ctx context.Context, arg db.CountLoginFailuresByIPParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLoginFailuresByIP", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLoginFailuresByIP indicates an expected call of CountLoginFailuresByIP.
+func (mr *MockStoreMockRecorder) CountLoginFailuresByIP(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLoginFailuresByIP", reflect.TypeOf((*MockStore)(nil).CountLoginFailuresByIP), ctx, arg)
+}
+
+// CountOpenAccountsByOwner mocks base method.
+func (m *MockStore) CountOpenAccountsByOwner(ctx context.Context, owner string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenAccountsByOwner", ctx, owner)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenAccountsByOwner indicates an expected call of CountOpenAccountsByOwner.
+func (mr *MockStoreMockRecorder) CountOpenAccountsByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenAccountsByOwner", reflect.TypeOf((*MockStore)(nil).CountOpenAccountsByOwner), ctx, owner)
+}
+
+// CountRestrictingScreeningCases mocks base method.
+func (m *MockStore) CountRestrictingScreeningCases(ctx context.Context, username string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRestrictingScreeningCases", ctx, username)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRestrictingScreeningCases indicates an expected call of CountRestrictingScreeningCases.
+func (mr *MockStoreMockRecorder) CountRestrictingScreeningCases(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRestrictingScreeningCases", reflect.TypeOf((*MockStore)(nil).CountRestrictingScreeningCases), ctx, username)
+}
+
+// CreateAccount mocks base method.
+func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+}
+
+// CreateAmlReport mocks base method.
+func (m *MockStore) CreateAmlReport(ctx context.Context, arg db.CreateAmlReportParams) (db.AmlReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAmlReport", ctx, arg)
+	ret0, _ := ret[0].(db.AmlReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAmlReport indicates an expected call of CreateAmlReport.
+func (mr *MockStoreMockRecorder) CreateAmlReport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAmlReport", reflect.TypeOf((*MockStore)(nil).CreateAmlReport), ctx, arg)
+}
+
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(ctx context.Context, arg db.CreateAuditEventParams) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", ctx, arg)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), ctx, arg)
+}
+
+// CreateBillSplit mocks base method.
+func (m *MockStore) CreateBillSplit(ctx context.Context, arg db.CreateBillSplitParams) (db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBillSplit", ctx, arg)
+	ret0, _ := ret[0].(db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBillSplit indicates an expected call of CreateBillSplit.
+func (mr *MockStoreMockRecorder) CreateBillSplit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBillSplit", reflect.TypeOf((*MockStore)(nil).CreateBillSplit), ctx, arg)
+}
+
+// CreateBillSplitTx mocks base method.
+func (m *MockStore) CreateBillSplitTx(ctx context.Context, arg db.CreateBillSplitTxParams) (db.CreateBillSplitTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBillSplitTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateBillSplitTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBillSplitTx indicates an expected call of CreateBillSplitTx.
+func (mr *MockStoreMockRecorder) CreateBillSplitTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBillSplitTx", reflect.TypeOf((*MockStore)(nil).CreateBillSplitTx), ctx, arg)
+}
+
+// CreateCategoryRule mocks base method.
+func (m *MockStore) CreateCategoryRule(ctx context.Context, arg db.CreateCategoryRuleParams) (db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", ctx, arg)
+	ret0, _ := ret[0].(db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockStoreMockRecorder) CreateCategoryRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockStore)(nil).CreateCategoryRule), ctx, arg)
+}
+
+// CreateDataErasureRequest mocks base method.
+func (m *MockStore) CreateDataErasureRequest(ctx context.Context, username string) (db.DataRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataErasureRequest", ctx, username)
+	ret0, _ := ret[0].(db.DataRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataErasureRequest indicates an expected call of CreateDataErasureRequest.
+func (mr *MockStoreMockRecorder) CreateDataErasureRequest(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataErasureRequest", reflect.TypeOf((*MockStore)(nil).CreateDataErasureRequest), ctx, username)
+}
+
+// CreateDataExportRequest mocks base method.
+func (m *MockStore) CreateDataExportRequest(ctx context.Context, arg db.CreateDataExportRequestParams) (db.DataRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExportRequest", ctx, arg)
+	ret0, _ := ret[0].(db.DataRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExportRequest indicates an expected call of CreateDataExportRequest.
+func (mr *MockStoreMockRecorder) CreateDataExportRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExportRequest", reflect.TypeOf((*MockStore)(nil).CreateDataExportRequest), ctx, arg)
+}
+
+// CreateDataExportRequestTx mocks base method.
+func (m *MockStore) CreateDataExportRequestTx(ctx context.Context, arg db.CreateDataExportRequestTxParams) (db.CreateDataExportRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExportRequestTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateDataExportRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExportRequestTx indicates an expected call of CreateDataExportRequestTx.
+func (mr *MockStoreMockRecorder) CreateDataExportRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExportRequestTx", reflect.TypeOf((*MockStore)(nil).CreateDataExportRequestTx), ctx, arg)
+}
+
+// CreateDirectDebit mocks base method.
+func (m *MockStore) CreateDirectDebit(ctx context.Context, arg db.CreateDirectDebitParams) (db.DirectDebit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDirectDebit", ctx, arg)
+	ret0, _ := ret[0].(db.DirectDebit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDirectDebit indicates an expected call of CreateDirectDebit.
+func (mr *MockStoreMockRecorder) CreateDirectDebit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDirectDebit", reflect.TypeOf((*MockStore)(nil).CreateDirectDebit), ctx, arg)
+}
+
+// CreateEntry mocks base method.
+func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, arg)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
+}
+
+// CreateFraudCase mocks base method.
+func (m *MockStore) CreateFraudCase(ctx context.Context, arg db.CreateFraudCaseParams) (db.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFraudCase", ctx, arg)
+	ret0, _ := ret[0].(db.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFraudCase indicates an expected call of CreateFraudCase.
+func (mr *MockStoreMockRecorder) CreateFraudCase(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFraudCase", reflect.TypeOf((*MockStore)(nil).CreateFraudCase), ctx, arg)
+}
+
+// CreateImpersonation mocks base method.
+func (m *MockStore) CreateImpersonation(ctx context.Context, arg db.CreateImpersonationParams) (db.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImpersonation", ctx, arg)
+	ret0, _ := ret[0].(db.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImpersonation indicates an expected call of CreateImpersonation.
+func (mr *MockStoreMockRecorder) CreateImpersonation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImpersonation", reflect.TypeOf((*MockStore)(nil).CreateImpersonation), ctx, arg)
+}
+
+// CreateKycDocument mocks base method.
+func (m *MockStore) CreateKycDocument(ctx context.Context, arg db.CreateKycDocumentParams) (db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKycDocument", ctx, arg)
+	ret0, _ := ret[0].(db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKycDocument indicates an expected call of CreateKycDocument.
+func (mr *MockStoreMockRecorder) CreateKycDocument(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKycDocument", reflect.TypeOf((*MockStore)(nil).CreateKycDocument), ctx, arg)
+}
+
+// CreateLoginAttempt mocks base method.
+func (m *MockStore) CreateLoginAttempt(ctx context.Context, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginAttempt", ctx, arg)
+	ret0, _ := ret[0].(db.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoginAttempt indicates an expected call of CreateLoginAttempt.
+func (mr *MockStoreMockRecorder) CreateLoginAttempt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginAttempt", reflect.TypeOf((*MockStore)(nil).CreateLoginAttempt), ctx, arg)
+}
+
+// CreateMandate mocks base method.
+func (m *MockStore) CreateMandate(ctx context.Context, arg db.CreateMandateParams) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMandate", ctx, arg)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMandate indicates an expected call of CreateMandate.
+func (mr *MockStoreMockRecorder) CreateMandate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMandate", reflect.TypeOf((*MockStore)(nil).CreateMandate), ctx, arg)
+}
+
+// CreateMfaChallenge mocks base method.
+func (m *MockStore) CreateMfaChallenge(ctx context.Context, arg db.CreateMfaChallengeParams) (db.MfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMfaChallenge", ctx, arg)
+	ret0, _ := ret[0].(db.MfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMfaChallenge indicates an expected call of CreateMfaChallenge.
+func (mr *MockStoreMockRecorder) CreateMfaChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMfaChallenge", reflect.TypeOf((*MockStore)(nil).CreateMfaChallenge), ctx, arg)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockStore) CreatePasswordReset(ctx context.Context, arg db.CreatePasswordResetParams) (db.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, arg)
+	ret0, _ := ret[0].(db.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockStoreMockRecorder) CreatePasswordReset(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockStore)(nil).CreatePasswordReset), ctx, arg)
+}
+
+// CreatePasswordResetWithExpiry mocks base method.
+func (m *MockStore) CreatePasswordResetWithExpiry(ctx context.Context, arg db.CreatePasswordResetWithExpiryParams) (db.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetWithExpiry", ctx, arg)
+	ret0, _ := ret[0].(db.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetWithExpiry indicates an expected call of CreatePasswordResetWithExpiry.
+func (mr *MockStoreMockRecorder) CreatePasswordResetWithExpiry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetWithExpiry", reflect.TypeOf((*MockStore)(nil).CreatePasswordResetWithExpiry), ctx, arg)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockStore) CreatePaymentRequest(ctx context.Context, arg db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockStoreMockRecorder) CreatePaymentRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequest), ctx, arg)
+}
+
+// CreatePaymentRequestTx mocks base method.
+func (m *MockStore) CreatePaymentRequestTx(ctx context.Context, arg db.CreatePaymentRequestTxParams) (db.CreatePaymentRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequestTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreatePaymentRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequestTx indicates an expected call of CreatePaymentRequestTx.
+func (mr *MockStoreMockRecorder) CreatePaymentRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequestTx), ctx, arg)
+}
+
+// CreateRecoveryCode mocks base method.
+func (m *MockStore) CreateRecoveryCode(ctx context.Context, arg db.CreateRecoveryCodeParams) (db.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCode", ctx, arg)
+	ret0, _ := ret[0].(db.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecoveryCode indicates an expected call of CreateRecoveryCode.
+func (mr *MockStoreMockRecorder) CreateRecoveryCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCode", reflect.TypeOf((*MockStore)(nil).CreateRecoveryCode), ctx, arg)
+}
+
+// CreateScreeningCase mocks base method.
+func (m *MockStore) CreateScreeningCase(ctx context.Context, arg db.CreateScreeningCaseParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScreeningCase", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScreeningCase indicates an expected call of CreateScreeningCase.
+func (mr *MockStoreMockRecorder) CreateScreeningCase(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScreeningCase", reflect.TypeOf((*MockStore)(nil).CreateScreeningCase), ctx, arg)
+}
+
+// CreateServiceClient mocks base method.
+func (m *MockStore) CreateServiceClient(ctx context.Context, arg db.CreateServiceClientParams) (db.ServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceClient", ctx, arg)
+	ret0, _ := ret[0].(db.ServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceClient indicates an expected call of CreateServiceClient.
+func (mr *MockStoreMockRecorder) CreateServiceClient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceClient", reflect.TypeOf((*MockStore)(nil).CreateServiceClient), ctx, arg)
+}
+
+// CreateServiceClientKey mocks base method.
+func (m *MockStore) CreateServiceClientKey(ctx context.Context, arg db.CreateServiceClientKeyParams) (db.ServiceClientKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceClientKey", ctx, arg)
+	ret0, _ := ret[0].(db.ServiceClientKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceClientKey indicates an expected call of CreateServiceClientKey.
+func (mr *MockStoreMockRecorder) CreateServiceClientKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceClientKey", reflect.TypeOf((*MockStore)(nil).CreateServiceClientKey), ctx, arg)
+}
+
+// CreateServiceClientTx mocks base method.
+func (m *MockStore) CreateServiceClientTx(ctx context.Context, arg db.CreateServiceClientTxParams) (db.CreateServiceClientTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceClientTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateServiceClientTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceClientTx indicates an expected call of CreateServiceClientTx.
+func (mr *MockStoreMockRecorder) CreateServiceClientTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceClientTx", reflect.TypeOf((*MockStore)(nil).CreateServiceClientTx), ctx, arg)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+}
+
+// CreateUserStatusChange mocks base method.
+func (m *MockStore) CreateUserStatusChange(ctx context.Context, arg db.CreateUserStatusChangeParams) (db.UserStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserStatusChange", ctx, arg)
+	ret0, _ := ret[0].(db.UserStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserStatusChange indicates an expected call of CreateUserStatusChange.
+func (mr *MockStoreMockRecorder) CreateUserStatusChange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserStatusChange", reflect.TypeOf((*MockStore)(nil).CreateUserStatusChange), ctx, arg)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockStore) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockStoreMockRecorder) CreateUserTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), ctx, arg)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockStore) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", ctx, arg)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockStoreMockRecorder) CreateVerifyEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), ctx, arg)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockStore) DeleteCategoryRule(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockStoreMockRecorder) DeleteCategoryRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockStore)(nil).DeleteCategoryRule), ctx, id)
+}
+
+// DeleteKycDocuments mocks base method.
+func (m *MockStore) DeleteKycDocuments(ctx context.Context, username string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKycDocuments", ctx, username)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKycDocuments indicates an expected call of DeleteKycDocuments.
+func (mr *MockStoreMockRecorder) DeleteKycDocuments(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKycDocuments", reflect.TypeOf((*MockStore)(nil).DeleteKycDocuments), ctx, username)
+}
+
+// DeleteLoginAttempts mocks base method.
+func (m *MockStore) DeleteLoginAttempts(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginAttempts", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginAttempts indicates an expected call of DeleteLoginAttempts.
+func (mr *MockStoreMockRecorder) DeleteLoginAttempts(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginAttempts", reflect.TypeOf((*MockStore)(nil).DeleteLoginAttempts), ctx, username)
+}
+
+// DeleteRecoveryCodes mocks base method.
+func (m *MockStore) DeleteRecoveryCodes(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCodes", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecoveryCodes indicates an expected call of DeleteRecoveryCodes.
+func (mr *MockStoreMockRecorder) DeleteRecoveryCodes(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCodes", reflect.TypeOf((*MockStore)(nil).DeleteRecoveryCodes), ctx, username)
+}
+
+// DeleteTotpCredential mocks base method.
+func (m *MockStore) DeleteTotpCredential(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTotpCredential", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTotpCredential indicates an expected call of DeleteTotpCredential.
+func (mr *MockStoreMockRecorder) DeleteTotpCredential(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTotpCredential", reflect.TypeOf((*MockStore)(nil).DeleteTotpCredential), ctx, username)
+}
+
+// DisableServiceClient mocks base method.
+func (m *MockStore) DisableServiceClient(ctx context.Context, arg db.DisableServiceClientParams) (db.ServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableServiceClient", ctx, arg)
+	ret0, _ := ret[0].(db.ServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableServiceClient indicates an expected call of DisableServiceClient.
+func (mr *MockStoreMockRecorder) DisableServiceClient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableServiceClient", reflect.TypeOf((*MockStore)(nil).DisableServiceClient), ctx, arg)
+}
+
+// DisableTotpTx mocks base method.
+func (m *MockStore) DisableTotpTx(ctx context.Context, arg db.DisableTotpTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTotpTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTotpTx indicates an expected call of DisableTotpTx.
+func (mr *MockStoreMockRecorder) DisableTotpTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTotpTx", reflect.TypeOf((*MockStore)(nil).DisableTotpTx), ctx, arg)
+}
+
+// EraseAuditEvents mocks base method.
+func (m *MockStore) EraseAuditEvents(ctx context.Context, arg db.EraseAuditEventsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseAuditEvents", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseAuditEvents indicates an expected call of EraseAuditEvents.
+func (mr *MockStoreMockRecorder) EraseAuditEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseAuditEvents", reflect.TypeOf((*MockStore)(nil).EraseAuditEvents), ctx, arg)
+}
+
+// EraseScreeningCases mocks base method.
+func (m *MockStore) EraseScreeningCases(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseScreeningCases", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseScreeningCases indicates an expected call of EraseScreeningCases.
+func (mr *MockStoreMockRecorder) EraseScreeningCases(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseScreeningCases", reflect.TypeOf((*MockStore)(nil).EraseScreeningCases), ctx, username)
+}
+
+// EraseSessions mocks base method.
+func (m *MockStore) EraseSessions(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseSessions", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseSessions indicates an expected call of EraseSessions.
+func (mr *MockStoreMockRecorder) EraseSessions(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSessions", reflect.TypeOf((*MockStore)(nil).EraseSessions), ctx, username)
+}
+
+// EraseUser mocks base method.
+func (m *MockStore) EraseUser(ctx context.Context, arg db.EraseUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *MockStoreMockRecorder) EraseUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*MockStore)(nil).EraseUser), ctx, arg)
+}
+
+// EraseUserTx mocks base method.
+func (m *MockStore) EraseUserTx(ctx context.Context, arg db.EraseUserTxParams) (db.EraseUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.EraseUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseUserTx indicates an expected call of EraseUserTx.
+func (mr *MockStoreMockRecorder) EraseUserTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserTx", reflect.TypeOf((*MockStore)(nil).EraseUserTx), ctx, arg)
+}
+
+// EraseVerifyEmails mocks base method.
+func (m *MockStore) EraseVerifyEmails(ctx context.Context, arg db.EraseVerifyEmailsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVerifyEmails", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseVerifyEmails indicates an expected call of EraseVerifyEmails.
+func (mr *MockStoreMockRecorder) EraseVerifyEmails(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVerifyEmails", reflect.TypeOf((*MockStore)(nil).EraseVerifyEmails), ctx, arg)
+}
+
+// ExpireServiceClientKeys mocks base method.
+func (m *MockStore) ExpireServiceClientKeys(ctx context.Context, arg db.ExpireServiceClientKeysParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireServiceClientKeys", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireServiceClientKeys indicates an expected call of ExpireServiceClientKeys.
+func (mr *MockStoreMockRecorder) ExpireServiceClientKeys(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireServiceClientKeys", reflect.TypeOf((*MockStore)(nil).ExpireServiceClientKeys), ctx, arg)
+}
+
+// FlagScreeningHitsTx mocks base method.
+func (m *MockStore) FlagScreeningHitsTx(ctx context.Context, arg db.FlagScreeningHitsTxParams) (db.FlagScreeningHitsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagScreeningHitsTx", ctx, arg)
+	ret0, _ := ret[0].(db.FlagScreeningHitsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlagScreeningHitsTx indicates an expected call of FlagScreeningHitsTx.
+func (mr *MockStoreMockRecorder) FlagScreeningHitsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagScreeningHitsTx", reflect.TypeOf((*MockStore)(nil).FlagScreeningHitsTx), ctx, arg)
+}
+
+// FreezeAccounts mocks base method.
+func (m *MockStore) FreezeAccounts(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccounts", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccounts indicates an expected call of FreezeAccounts.
+func (mr *MockStoreMockRecorder) FreezeAccounts(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccounts", reflect.TypeOf((*MockStore)(nil).FreezeAccounts), ctx, owner)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+}
+
+// GetAccountByNumber mocks base method.
+func (m *MockStore) GetAccountByNumber(ctx context.Context, number string) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByNumber", ctx, number)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByNumber indicates an expected call of GetAccountByNumber.
+func (mr *MockStoreMockRecorder) GetAccountByNumber(ctx, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockStore)(nil).GetAccountByNumber), ctx, number)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+}
+
+// GetAmlReport mocks base method.
+func (m *MockStore) GetAmlReport(ctx context.Context, id int64) (db.AmlReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAmlReport", ctx, id)
+	ret0, _ := ret[0].(db.AmlReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAmlReport indicates an expected call of GetAmlReport.
+func (mr *MockStoreMockRecorder) GetAmlReport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAmlReport", reflect.TypeOf((*MockStore)(nil).GetAmlReport), ctx, id)
+}
+
+// GetAmlScanWatermark mocks base method.
+func (m *MockStore) GetAmlScanWatermark(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAmlScanWatermark", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAmlScanWatermark indicates an expected call of GetAmlScanWatermark.
+func (mr *MockStoreMockRecorder) GetAmlScanWatermark(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAmlScanWatermark", reflect.TypeOf((*MockStore)(nil).GetAmlScanWatermark), ctx)
+}
+
+// GetBillSplit mocks base method.
+func (m *MockStore) GetBillSplit(ctx context.Context, id int64) (db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillSplit", ctx, id)
+	ret0, _ := ret[0].(db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillSplit indicates an expected call of GetBillSplit.
+func (mr *MockStoreMockRecorder) GetBillSplit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillSplit", reflect.TypeOf((*MockStore)(nil).GetBillSplit), ctx, id)
+}
+
+// GetCategoryRule mocks base method.
+func (m *MockStore) GetCategoryRule(ctx context.Context, id int64) (db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRule", ctx, id)
+	ret0, _ := ret[0].(db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRule indicates an expected call of GetCategoryRule.
+func (mr *MockStoreMockRecorder) GetCategoryRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRule", reflect.TypeOf((*MockStore)(nil).GetCategoryRule), ctx, id)
+}
+
+// GetDataRequest mocks base method.
+func (m *MockStore) GetDataRequest(ctx context.Context, id int64) (db.DataRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataRequest", ctx, id)
+	ret0, _ := ret[0].(db.DataRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataRequest indicates an expected call of GetDataRequest.
+func (mr *MockStoreMockRecorder) GetDataRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataRequest", reflect.TypeOf((*MockStore)(nil).GetDataRequest), ctx, id)
+}
+
+// GetDirectDebit mocks base method.
+func (m *MockStore) GetDirectDebit(ctx context.Context, id int64) (db.DirectDebit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectDebit", ctx, id)
+	ret0, _ := ret[0].(db.DirectDebit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectDebit indicates an expected call of GetDirectDebit.
+func (mr *MockStoreMockRecorder) GetDirectDebit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectDebit", reflect.TypeOf((*MockStore)(nil).GetDirectDebit), ctx, id)
+}
+
+// GetDirectDebitForUpdate mocks base method.
+func (m *MockStore) GetDirectDebitForUpdate(ctx context.Context, id int64) (db.DirectDebit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectDebitForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.DirectDebit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectDebitForUpdate indicates an expected call of GetDirectDebitForUpdate.
+func (mr *MockStoreMockRecorder) GetDirectDebitForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectDebitForUpdate", reflect.TypeOf((*MockStore)(nil).GetDirectDebitForUpdate), ctx, id)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+}
+
+// GetFraudCase mocks base method.
+func (m *MockStore) GetFraudCase(ctx context.Context, id int64) (db.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudCase", ctx, id)
+	ret0, _ := ret[0].(db.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudCase indicates an expected call of GetFraudCase.
+func (mr *MockStoreMockRecorder) GetFraudCase(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudCase", reflect.TypeOf((*MockStore)(nil).GetFraudCase), ctx, id)
+}
+
+// GetFraudCaseForUpdate mocks base method.
+func (m *MockStore) GetFraudCaseForUpdate(ctx context.Context, id int64) (db.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudCaseForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudCaseForUpdate indicates an expected call of GetFraudCaseForUpdate.
+func (mr *MockStoreMockRecorder) GetFraudCaseForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudCaseForUpdate", reflect.TypeOf((*MockStore)(nil).GetFraudCaseForUpdate), ctx, id)
+}
+
+// GetImpersonation mocks base method.
+func (m *MockStore) GetImpersonation(ctx context.Context, id uuid.UUID) (db.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImpersonation", ctx, id)
+	ret0, _ := ret[0].(db.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImpersonation indicates an expected call of GetImpersonation.
+func (mr *MockStoreMockRecorder) GetImpersonation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImpersonation", reflect.TypeOf((*MockStore)(nil).GetImpersonation), ctx, id)
+}
+
+// GetKycDocument mocks base method.
+func (m *MockStore) GetKycDocument(ctx context.Context, id int64) (db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKycDocument", ctx, id)
+	ret0, _ := ret[0].(db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKycDocument indicates an expected call of GetKycDocument.
+func (mr *MockStoreMockRecorder) GetKycDocument(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKycDocument", reflect.TypeOf((*MockStore)(nil).GetKycDocument), ctx, id)
+}
+
+// GetKycDocumentForUpdate mocks base method.
+func (m *MockStore) GetKycDocumentForUpdate(ctx context.Context, id int64) (db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKycDocumentForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKycDocumentForUpdate indicates an expected call of GetKycDocumentForUpdate.
+func (mr *MockStoreMockRecorder) GetKycDocumentForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKycDocumentForUpdate", reflect.TypeOf((*MockStore)(nil).GetKycDocumentForUpdate), ctx, id)
+}
+
+// GetLoginFailureStats mocks base method.
+func (m *MockStore) GetLoginFailureStats(ctx context.Context, arg db.GetLoginFailureStatsParams) (db.GetLoginFailureStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginFailureStats", ctx, arg)
+	ret0, _ := ret[0].(db.GetLoginFailureStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginFailureStats indicates an expected call of GetLoginFailureStats.
+func (mr *MockStoreMockRecorder) GetLoginFailureStats(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginFailureStats", reflect.TypeOf((*MockStore)(nil).GetLoginFailureStats), ctx, arg)
+}
+
+// GetMandate mocks base method.
+func (m *MockStore) GetMandate(ctx context.Context, id int64) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandate", ctx, id)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandate indicates an expected call of GetMandate.
+func (mr *MockStoreMockRecorder) GetMandate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandate", reflect.TypeOf((*MockStore)(nil).GetMandate), ctx, id)
+}
+
+// GetMandateForUpdate mocks base method.
+func (m *MockStore) GetMandateForUpdate(ctx context.Context, id int64) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandateForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandateForUpdate indicates an expected call of GetMandateForUpdate.
+func (mr *MockStoreMockRecorder) GetMandateForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandateForUpdate", reflect.TypeOf((*MockStore)(nil).GetMandateForUpdate), ctx, id)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockStore) GetPaymentRequest(ctx context.Context, id int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, id)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockStoreMockRecorder) GetPaymentRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockStore)(nil).GetPaymentRequest), ctx, id)
+}
+
+// GetPaymentRequestForUpdate mocks base method.
+func (m *MockStore) GetPaymentRequestForUpdate(ctx context.Context, id int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequestForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequestForUpdate indicates an expected call of GetPaymentRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetPaymentRequestForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetPaymentRequestForUpdate), ctx, id)
+}
+
+// GetScreeningCase mocks base method.
+func (m *MockStore) GetScreeningCase(ctx context.Context, id int64) (db.ScreeningCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreeningCase", ctx, id)
+	ret0, _ := ret[0].(db.ScreeningCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreeningCase indicates an expected call of GetScreeningCase.
+func (mr *MockStoreMockRecorder) GetScreeningCase(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreeningCase", reflect.TypeOf((*MockStore)(nil).GetScreeningCase), ctx, id)
+}
+
+// GetScreeningCaseForUpdate mocks base method.
+func (m *MockStore) GetScreeningCaseForUpdate(ctx context.Context, id int64) (db.ScreeningCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreeningCaseForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.ScreeningCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreeningCaseForUpdate indicates an expected call of GetScreeningCaseForUpdate.
+func (mr *MockStoreMockRecorder) GetScreeningCaseForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreeningCaseForUpdate", reflect.TypeOf((*MockStore)(nil).GetScreeningCaseForUpdate), ctx, id)
+}
+
+// GetServiceClient mocks base method.
+func (m *MockStore) GetServiceClient(ctx context.Context, id string) (db.ServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceClient", ctx, id)
+	ret0, _ := ret[0].(db.ServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceClient indicates an expected call of GetServiceClient.
+func (mr *MockStoreMockRecorder) GetServiceClient(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceClient", reflect.TypeOf((*MockStore)(nil).GetServiceClient), ctx, id)
+}
+
+// GetServiceClientKey mocks base method.
+func (m *MockStore) GetServiceClientKey(ctx context.Context, arg db.GetServiceClientKeyParams) (db.ServiceClientKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceClientKey", ctx, arg)
+	ret0, _ := ret[0].(db.ServiceClientKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceClientKey indicates an expected call of GetServiceClientKey.
+func (mr *MockStoreMockRecorder) GetServiceClientKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceClientKey", reflect.TypeOf((*MockStore)(nil).GetServiceClientKey), ctx, arg)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, id)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+}
+
+// GetSpendingByCategory mocks base method.
+func (m *MockStore) GetSpendingByCategory(ctx context.Context, arg db.GetSpendingByCategoryParams) ([]db.GetSpendingByCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByCategory", ctx, arg)
+	ret0, _ := ret[0].([]db.GetSpendingByCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByCategory indicates an expected call of GetSpendingByCategory.
+func (mr *MockStoreMockRecorder) GetSpendingByCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByCategory", reflect.TypeOf((*MockStore)(nil).GetSpendingByCategory), ctx, arg)
+}
+
+// GetSpendingByCounterparty mocks base method.
+func (m *MockStore) GetSpendingByCounterparty(ctx context.Context, arg db.GetSpendingByCounterpartyParams) ([]db.GetSpendingByCounterpartyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByCounterparty", ctx, arg)
+	ret0, _ := ret[0].([]db.GetSpendingByCounterpartyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByCounterparty indicates an expected call of GetSpendingByCounterparty.
+func (mr *MockStoreMockRecorder) GetSpendingByCounterparty(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByCounterparty", reflect.TypeOf((*MockStore)(nil).GetSpendingByCounterparty), ctx, arg)
+}
+
+// GetSpendingByMonth mocks base method.
+func (m *MockStore) GetSpendingByMonth(ctx context.Context, arg db.GetSpendingByMonthParams) ([]db.GetSpendingByMonthRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByMonth", ctx, arg)
+	ret0, _ := ret[0].([]db.GetSpendingByMonthRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByMonth indicates an expected call of GetSpendingByMonth.
+func (mr *MockStoreMockRecorder) GetSpendingByMonth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByMonth", reflect.TypeOf((*MockStore)(nil).GetSpendingByMonth), ctx, arg)
+}
+
+// GetTotpCredential mocks base method.
+func (m *MockStore) GetTotpCredential(ctx context.Context, username string) (db.TotpCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotpCredential", ctx, username)
+	ret0, _ := ret[0].(db.TotpCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotpCredential indicates an expected call of GetTotpCredential.
+func (mr *MockStoreMockRecorder) GetTotpCredential(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotpCredential", reflect.TypeOf((*MockStore)(nil).GetTotpCredential), ctx, username)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+}
+
+// GetTransferHistory mocks base method.
+func (m *MockStore) GetTransferHistory(ctx context.Context, arg db.GetTransferHistoryParams) (db.GetTransferHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferHistory", ctx, arg)
+	ret0, _ := ret[0].(db.GetTransferHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferHistory indicates an expected call of GetTransferHistory.
+func (mr *MockStoreMockRecorder) GetTransferHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferHistory", reflect.TypeOf((*MockStore)(nil).GetTransferHistory), ctx, arg)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserForUpdate mocks base method.
+func (m *MockStore) GetUserForUpdate(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserForUpdate", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserForUpdate indicates an expected call of GetUserForUpdate.
+func (mr *MockStoreMockRecorder) GetUserForUpdate(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserForUpdate", reflect.TypeOf((*MockStore)(nil).GetUserForUpdate), ctx, username)
+}
+
+// GetUserStatus mocks base method.
+func (m *MockStore) GetUserStatus(ctx context.Context, username string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStatus", ctx, username)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStatus indicates an expected call of GetUserStatus.
+func (mr *MockStoreMockRecorder) GetUserStatus(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStatus", reflect.TypeOf((*MockStore)(nil).GetUserStatus), ctx, username)
+}
+
+// InvalidatePasswordResets mocks base method.
+func (m *MockStore) InvalidatePasswordResets(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidatePasswordResets", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidatePasswordResets indicates an expected call of InvalidatePasswordResets.
+func (mr *MockStoreMockRecorder) InvalidatePasswordResets(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordResets", reflect.TypeOf((*MockStore)(nil).InvalidatePasswordResets), ctx, username)
+}
+
+// IsNewDeviceSession mocks base method.
+func (m *MockStore) IsNewDeviceSession(ctx context.Context, id uuid.UUID) (pgtype.Bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNewDeviceSession", ctx, id)
+	ret0, _ := ret[0].(pgtype.Bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsNewDeviceSession indicates an expected call of IsNewDeviceSession.
+func (mr *MockStoreMockRecorder) IsNewDeviceSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNewDeviceSession", reflect.TypeOf((*MockStore)(nil).IsNewDeviceSession), ctx, id)
+}
+
+// LiftUserRestriction mocks base method.
+func (m *MockStore) LiftUserRestriction(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiftUserRestriction", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiftUserRestriction indicates an expected call of LiftUserRestriction.
+func (mr *MockStoreMockRecorder) LiftUserRestriction(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiftUserRestriction", reflect.TypeOf((*MockStore)(nil).LiftUserRestriction), ctx, username)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+}
+
+// ListAccountsByOwner mocks base method.
+func (m *MockStore) ListAccountsByOwner(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByOwner", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByOwner indicates an expected call of ListAccountsByOwner.
+func (mr *MockStoreMockRecorder) ListAccountsByOwner(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByOwner", reflect.TypeOf((*MockStore)(nil).ListAccountsByOwner), ctx, owner)
+}
+
+// ListAccountsByOwnerForUpdate mocks base method.
+func (m *MockStore) ListAccountsByOwnerForUpdate(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsByOwnerForUpdate", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByOwnerForUpdate indicates an expected call of ListAccountsByOwnerForUpdate.
+func (mr *MockStoreMockRecorder) ListAccountsByOwnerForUpdate(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByOwnerForUpdate", reflect.TypeOf((*MockStore)(nil).ListAccountsByOwnerForUpdate), ctx, owner)
+}
+
+// ListActiveSessions mocks base method.
+func (m *MockStore) ListActiveSessions(ctx context.Context, username string) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessions", ctx, username)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessions indicates an expected call of ListActiveSessions.
+func (mr *MockStoreMockRecorder) ListActiveSessions(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessions", reflect.TypeOf((*MockStore)(nil).ListActiveSessions), ctx, username)
+}
+
+// ListAmlReports mocks base method.
+func (m *MockStore) ListAmlReports(ctx context.Context, arg db.ListAmlReportsParams) ([]db.AmlReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAmlReports", ctx, arg)
+	ret0, _ := ret[0].([]db.AmlReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAmlReports indicates an expected call of ListAmlReports.
+func (mr *MockStoreMockRecorder) ListAmlReports(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAmlReports", reflect.TypeOf((*MockStore)(nil).ListAmlReports), ctx, arg)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockStore) ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStoreMockRecorder) ListAuditEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStore)(nil).ListAuditEvents), ctx, arg)
+}
+
+// ListBillSplitPaymentRequests mocks base method.
+func (m *MockStore) ListBillSplitPaymentRequests(ctx context.Context, billSplitIds []int64) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillSplitPaymentRequests", ctx, billSplitIds)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillSplitPaymentRequests indicates an expected call of ListBillSplitPaymentRequests.
+func (mr *MockStoreMockRecorder) ListBillSplitPaymentRequests(ctx, billSplitIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillSplitPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListBillSplitPaymentRequests), ctx, billSplitIds)
+}
+
+// ListBillSplits mocks base method.
+func (m *MockStore) ListBillSplits(ctx context.Context, arg db.ListBillSplitsParams) ([]db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillSplits", ctx, arg)
+	ret0, _ := ret[0].([]db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillSplits indicates an expected call of ListBillSplits.
+func (mr *MockStoreMockRecorder) ListBillSplits(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillSplits", reflect.TypeOf((*MockStore)(nil).ListBillSplits), ctx, arg)
+}
+
+// ListCategoryRules mocks base method.
+func (m *MockStore) ListCategoryRules(ctx context.Context, owner string) ([]db.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryRules", ctx, owner)
+	ret0, _ := ret[0].([]db.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryRules indicates an expected call of ListCategoryRules.
+func (mr *MockStoreMockRecorder) ListCategoryRules(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRules", reflect.TypeOf((*MockStore)(nil).ListCategoryRules), ctx, owner)
+}
+
+// ListDirectDebits mocks base method.
+func (m *MockStore) ListDirectDebits(ctx context.Context, arg db.ListDirectDebitsParams) ([]db.DirectDebit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDirectDebits", ctx, arg)
+	ret0, _ := ret[0].([]db.DirectDebit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDirectDebits indicates an expected call of ListDirectDebits.
+func (mr *MockStoreMockRecorder) ListDirectDebits(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDirectDebits", reflect.TypeOf((*MockStore)(nil).ListDirectDebits), ctx, arg)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+}
+
+// ListFraudCases mocks base method.
+func (m *MockStore) ListFraudCases(ctx context.Context, arg db.ListFraudCasesParams) ([]db.FraudCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudCases", ctx, arg)
+	ret0, _ := ret[0].([]db.FraudCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudCases indicates an expected call of ListFraudCases.
+func (mr *MockStoreMockRecorder) ListFraudCases(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudCases", reflect.TypeOf((*MockStore)(nil).ListFraudCases), ctx, arg)
+}
+
+// ListImpersonations mocks base method.
+func (m *MockStore) ListImpersonations(ctx context.Context, arg db.ListImpersonationsParams) ([]db.ListImpersonationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImpersonations", ctx, arg)
+	ret0, _ := ret[0].([]db.ListImpersonationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImpersonations indicates an expected call of ListImpersonations.
+func (mr *MockStoreMockRecorder) ListImpersonations(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImpersonations", reflect.TypeOf((*MockStore)(nil).ListImpersonations), ctx, arg)
+}
+
+// ListKycDocumentsByUsername mocks base method.
+func (m *MockStore) ListKycDocumentsByUsername(ctx context.Context, username string) ([]db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKycDocumentsByUsername", ctx, username)
+	ret0, _ := ret[0].([]db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKycDocumentsByUsername indicates an expected call of ListKycDocumentsByUsername.
+func (mr *MockStoreMockRecorder) ListKycDocumentsByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKycDocumentsByUsername", reflect.TypeOf((*MockStore)(nil).ListKycDocumentsByUsername), ctx, username)
+}
+
+// ListLoginAttempts mocks base method.
+func (m *MockStore) ListLoginAttempts(ctx context.Context, arg db.ListLoginAttemptsParams) ([]db.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoginAttempts", ctx, arg)
+	ret0, _ := ret[0].([]db.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoginAttempts indicates an expected call of ListLoginAttempts.
+func (mr *MockStoreMockRecorder) ListLoginAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoginAttempts", reflect.TypeOf((*MockStore)(nil).ListLoginAttempts), ctx, arg)
+}
+
+// ListMandates mocks base method.
+func (m *MockStore) ListMandates(ctx context.Context, arg db.ListMandatesParams) ([]db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMandates", ctx, arg)
+	ret0, _ := ret[0].([]db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMandates indicates an expected call of ListMandates.
+func (mr *MockStoreMockRecorder) ListMandates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMandates", reflect.TypeOf((*MockStore)(nil).ListMandates), ctx, arg)
+}
+
+// ListPaymentRequests mocks base method.
+func (m *MockStore) ListPaymentRequests(ctx context.Context, arg db.ListPaymentRequestsParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequests", ctx, arg)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequests indicates an expected call of ListPaymentRequests.
+func (mr *MockStoreMockRecorder) ListPaymentRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListPaymentRequests), ctx, arg)
+}
+
+// ListPendingKycDocuments mocks base method.
+func (m *MockStore) ListPendingKycDocuments(ctx context.Context, arg db.ListPendingKycDocumentsParams) ([]db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingKycDocuments", ctx, arg)
+	ret0, _ := ret[0].([]db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingKycDocuments indicates an expected call of ListPendingKycDocuments.
+func (mr *MockStoreMockRecorder) ListPendingKycDocuments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingKycDocuments", reflect.TypeOf((*MockStore)(nil).ListPendingKycDocuments), ctx, arg)
+}
+
+// ListRolePermissions mocks base method.
+func (m *MockStore) ListRolePermissions(ctx context.Context, role string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePermissions", ctx, role)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePermissions indicates an expected call of ListRolePermissions.
+func (mr *MockStoreMockRecorder) ListRolePermissions(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockStore)(nil).ListRolePermissions), ctx, role)
+}
+
+// ListRoles mocks base method.
+func (m *MockStore) ListRoles(ctx context.Context) ([]db.ListRolesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]db.ListRolesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockStoreMockRecorder) ListRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockStore)(nil).ListRoles), ctx)
+}
+
+// ListScreeningCases mocks base method.
+func (m *MockStore) ListScreeningCases(ctx context.Context, arg db.ListScreeningCasesParams) ([]db.ScreeningCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScreeningCases", ctx, arg)
+	ret0, _ := ret[0].([]db.ScreeningCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScreeningCases indicates an expected call of ListScreeningCases.
+func (mr *MockStoreMockRecorder) ListScreeningCases(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScreeningCases", reflect.TypeOf((*MockStore)(nil).ListScreeningCases), ctx, arg)
+}
+
+// ListServiceClientKeys mocks base method.
+func (m *MockStore) ListServiceClientKeys(ctx context.Context, clientID string) ([]db.ServiceClientKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceClientKeys", ctx, clientID)
+	ret0, _ := ret[0].([]db.ServiceClientKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceClientKeys indicates an expected call of ListServiceClientKeys.
+func (mr *MockStoreMockRecorder) ListServiceClientKeys(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceClientKeys", reflect.TypeOf((*MockStore)(nil).ListServiceClientKeys), ctx, clientID)
+}
+
+// ListServiceClients mocks base method.
+func (m *MockStore) ListServiceClients(ctx context.Context, owner string) ([]db.ServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceClients", ctx, owner)
+	ret0, _ := ret[0].([]db.ServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceClients indicates an expected call of ListServiceClients.
+func (mr *MockStoreMockRecorder) ListServiceClients(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceClients", reflect.TypeOf((*MockStore)(nil).ListServiceClients), ctx, owner)
+}
+
+// ListSessionsByUsername mocks base method.
+func (m *MockStore) ListSessionsByUsername(ctx context.Context, username string) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionsByUsername", ctx, username)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionsByUsername indicates an expected call of ListSessionsByUsername.
+func (mr *MockStoreMockRecorder) ListSessionsByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionsByUsername", reflect.TypeOf((*MockStore)(nil).ListSessionsByUsername), ctx, username)
+}
+
+// ListStructuringCandidates mocks base method.
+func (m *MockStore) ListStructuringCandidates(ctx context.Context, arg db.ListStructuringCandidatesParams) ([]db.ListStructuringCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStructuringCandidates", ctx, arg)
+	ret0, _ := ret[0].([]db.ListStructuringCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStructuringCandidates indicates an expected call of ListStructuringCandidates.
+func (mr *MockStoreMockRecorder) ListStructuringCandidates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStructuringCandidates", reflect.TypeOf((*MockStore)(nil).ListStructuringCandidates), ctx, arg)
+}
+
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+}
+
+// ListTransfersByAccounts mocks base method.
+func (m *MockStore) ListTransfersByAccounts(ctx context.Context, accountIds []int64) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfersByAccounts", ctx, accountIds)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfersByAccounts indicates an expected call of ListTransfersByAccounts.
+func (mr *MockStoreMockRecorder) ListTransfersByAccounts(ctx, accountIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByAccounts", reflect.TypeOf((*MockStore)(nil).ListTransfersByAccounts), ctx, accountIds)
+}
+
+// ListTransfersByIDs mocks base method.
+func (m *MockStore) ListTransfersByIDs(ctx context.Context, ids []int64) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfersByIDs indicates an expected call of ListTransfersByIDs.
+func (mr *MockStoreMockRecorder) ListTransfersByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersByIDs", reflect.TypeOf((*MockStore)(nil).ListTransfersByIDs), ctx, ids)
+}
+
+// ListUnreportedLargeTransfers mocks base method.
+func (m *MockStore) ListUnreportedLargeTransfers(ctx context.Context, arg db.ListUnreportedLargeTransfersParams) ([]db.ListUnreportedLargeTransfersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnreportedLargeTransfers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListUnreportedLargeTransfersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnreportedLargeTransfers indicates an expected call of ListUnreportedLargeTransfers.
+func (mr *MockStoreMockRecorder) ListUnreportedLargeTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnreportedLargeTransfers", reflect.TypeOf((*MockStore)(nil).ListUnreportedLargeTransfers), ctx, arg)
+}
+
+// ListUserStatusChanges mocks base method.
+func (m *MockStore) ListUserStatusChanges(ctx context.Context, username string) ([]db.UserStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserStatusChanges", ctx, username)
+	ret0, _ := ret[0].([]db.UserStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserStatusChanges indicates an expected call of ListUserStatusChanges.
+func (mr *MockStoreMockRecorder) ListUserStatusChanges(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserStatusChanges", reflect.TypeOf((*MockStore)(nil).ListUserStatusChanges), ctx, username)
+}
+
+// LockUser mocks base method.
+func (m *MockStore) LockUser(ctx context.Context, arg db.LockUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUser indicates an expected call of LockUser.
+func (mr *MockStoreMockRecorder) LockUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockStore)(nil).LockUser), ctx, arg)
+}
+
+// MarkBillSplitReminded mocks base method.
+func (m *MockStore) MarkBillSplitReminded(ctx context.Context, arg db.MarkBillSplitRemindedParams) (db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBillSplitReminded", ctx, arg)
+	ret0, _ := ret[0].(db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkBillSplitReminded indicates an expected call of MarkBillSplitReminded.
+func (mr *MockStoreMockRecorder) MarkBillSplitReminded(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBillSplitReminded", reflect.TypeOf((*MockStore)(nil).MarkBillSplitReminded), ctx, arg)
 }
 
-// CreateAccount mocks base method.
-func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+// MarkMandateCollected mocks base method.
+func (m *MockStore) MarkMandateCollected(ctx context.Context, id int64) (db.Mandate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "MarkMandateCollected", ctx, id)
+	ret0, _ := ret[0].(db.Mandate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+// MarkMandateCollected indicates an expected call of MarkMandateCollected.
+func (mr *MockStoreMockRecorder) MarkMandateCollected(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMandateCollected", reflect.TypeOf((*MockStore)(nil).MarkMandateCollected), ctx, id)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
+// MarkVerificationEmailSent mocks base method.
+func (m *MockStore) MarkVerificationEmailSent(ctx context.Context, arg db.MarkVerificationEmailSentParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", ctx, arg)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "MarkVerificationEmailSent", ctx, arg)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
+// MarkVerificationEmailSent indicates an expected call of MarkVerificationEmailSent.
+func (mr *MockStoreMockRecorder) MarkVerificationEmailSent(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerificationEmailSent", reflect.TypeOf((*MockStore)(nil).MarkVerificationEmailSent), ctx, arg)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+// RehashUserPassword mocks base method.
+func (m *MockStore) RehashUserPassword(ctx context.Context, arg db.RehashUserPasswordParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "RehashUserPassword", ctx, arg)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+// RehashUserPassword indicates an expected call of RehashUserPassword.
+func (mr *MockStoreMockRecorder) RehashUserPassword(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashUserPassword", reflect.TypeOf((*MockStore)(nil).RehashUserPassword), ctx, arg)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+// ResetPasswordTx mocks base method.
+func (m *MockStore) ResetPasswordTx(ctx context.Context, arg db.ResetPasswordTxParams) (db.ResetPasswordTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ResetPasswordTx", ctx, arg)
+	ret0, _ := ret[0].(db.ResetPasswordTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+// ResetPasswordTx indicates an expected call of ResetPasswordTx.
+func (mr *MockStoreMockRecorder) ResetPasswordTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordTx", reflect.TypeOf((*MockStore)(nil).ResetPasswordTx), ctx, arg)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// ResolveFraudCase mocks base method.
+func (m *MockStore) ResolveFraudCase(ctx context.Context, arg db.ResolveFraudCaseParams) (db.FraudCase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "ResolveFraudCase", ctx, arg)
+	ret0, _ := ret[0].(db.FraudCase)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+// ResolveFraudCase indicates an expected call of ResolveFraudCase.
+func (mr *MockStoreMockRecorder) ResolveFraudCase(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudCase", reflect.TypeOf((*MockStore)(nil).ResolveFraudCase), ctx, arg)
 }
 
-// CreateUserTx mocks base method.
-func (m *MockStore) CreateUserTx(ctx context.Context, arg db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+// ResolveFraudCaseTx mocks base method.
+func (m *MockStore) ResolveFraudCaseTx(ctx context.Context, arg db.ResolveFraudCaseTxParams) (db.ResolveFraudCaseTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserTx", ctx, arg)
-	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret := m.ctrl.Call(m, "ResolveFraudCaseTx", ctx, arg)
+	ret0, _ := ret[0].(db.ResolveFraudCaseTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserTx indicates an expected call of CreateUserTx.
-func (mr *MockStoreMockRecorder) CreateUserTx(ctx, arg any) *gomock.Call {
+// ResolveFraudCaseTx indicates an expected call of ResolveFraudCaseTx.
+func (mr *MockStoreMockRecorder) ResolveFraudCaseTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudCaseTx", reflect.TypeOf((*MockStore)(nil).ResolveFraudCaseTx), ctx, arg)
 }
 
-// CreateVerifyEmail mocks base method.
-func (m *MockStore) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+// ResolvePaymentRequest mocks base method.
+func (m *MockStore) ResolvePaymentRequest(ctx context.Context, arg db.ResolvePaymentRequestParams) (db.PaymentRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerifyEmail", ctx, arg)
-	ret0, _ := ret[0].(db.VerifyEmail)
+	ret := m.ctrl.Call(m, "ResolvePaymentRequest", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
-func (mr *MockStoreMockRecorder) CreateVerifyEmail(ctx, arg any) *gomock.Call {
+// ResolvePaymentRequest indicates an expected call of ResolvePaymentRequest.
+func (mr *MockStoreMockRecorder) ResolvePaymentRequest(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePaymentRequest", reflect.TypeOf((*MockStore)(nil).ResolvePaymentRequest), ctx, arg)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+// ResolveScreeningCase mocks base method.
+func (m *MockStore) ResolveScreeningCase(ctx context.Context, arg db.ResolveScreeningCaseParams) (db.ScreeningCase, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ResolveScreeningCase", ctx, arg)
+	ret0, _ := ret[0].(db.ScreeningCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+// ResolveScreeningCase indicates an expected call of ResolveScreeningCase.
+func (mr *MockStoreMockRecorder) ResolveScreeningCase(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveScreeningCase", reflect.TypeOf((*MockStore)(nil).ResolveScreeningCase), ctx, arg)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+// ResolveScreeningCaseTx mocks base method.
+func (m *MockStore) ResolveScreeningCaseTx(ctx context.Context, arg db.ResolveScreeningCaseTxParams) (db.ResolveScreeningCaseTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ResolveScreeningCaseTx", ctx, arg)
+	ret0, _ := ret[0].(db.ResolveScreeningCaseTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+// ResolveScreeningCaseTx indicates an expected call of ResolveScreeningCaseTx.
+func (mr *MockStoreMockRecorder) ResolveScreeningCaseTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveScreeningCaseTx", reflect.TypeOf((*MockStore)(nil).ResolveScreeningCaseTx), ctx, arg)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+// RestrictUser mocks base method.
+func (m *MockStore) RestrictUser(ctx context.Context, username string) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "RestrictUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+// RestrictUser indicates an expected call of RestrictUser.
+func (mr *MockStoreMockRecorder) RestrictUser(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestrictUser", reflect.TypeOf((*MockStore)(nil).RestrictUser), ctx, username)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+// ReviewKycDocument mocks base method.
+func (m *MockStore) ReviewKycDocument(ctx context.Context, arg db.ReviewKycDocumentParams) (db.KycDocument, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ReviewKycDocument", ctx, arg)
+	ret0, _ := ret[0].(db.KycDocument)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+// ReviewKycDocument indicates an expected call of ReviewKycDocument.
+func (mr *MockStoreMockRecorder) ReviewKycDocument(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewKycDocument", reflect.TypeOf((*MockStore)(nil).ReviewKycDocument), ctx, arg)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+// ReviewKycDocumentTx mocks base method.
+func (m *MockStore) ReviewKycDocumentTx(ctx context.Context, arg db.ReviewKycDocumentTxParams) (db.ReviewKycDocumentTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, id)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ReviewKycDocumentTx", ctx, arg)
+	ret0, _ := ret[0].(db.ReviewKycDocumentTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(ctx, id any) *gomock.Call {
+// ReviewKycDocumentTx indicates an expected call of ReviewKycDocumentTx.
+func (mr *MockStoreMockRecorder) ReviewKycDocumentTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewKycDocumentTx", reflect.TypeOf((*MockStore)(nil).ReviewKycDocumentTx), ctx, arg)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+// RevokeMandate mocks base method.
+func (m *MockStore) RevokeMandate(ctx context.Context, id int64) (db.Mandate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "RevokeMandate", ctx, id)
+	ret0, _ := ret[0].(db.Mandate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+// RevokeMandate indicates an expected call of RevokeMandate.
+func (mr *MockStoreMockRecorder) RevokeMandate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeMandate", reflect.TypeOf((*MockStore)(nil).RevokeMandate), ctx, id)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+// RotateServiceClientKeyTx mocks base method.
+func (m *MockStore) RotateServiceClientKeyTx(ctx context.Context, arg db.RotateServiceClientKeyTxParams) (db.RotateServiceClientKeyTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, username)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "RotateServiceClientKeyTx", ctx, arg)
+	ret0, _ := ret[0].(db.RotateServiceClientKeyTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+// RotateServiceClientKeyTx indicates an expected call of RotateServiceClientKeyTx.
+func (mr *MockStoreMockRecorder) RotateServiceClientKeyTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateServiceClientKeyTx", reflect.TypeOf((*MockStore)(nil).RotateServiceClientKeyTx), ctx, arg)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+// RotateSessionRefreshToken mocks base method.
+func (m *MockStore) RotateSessionRefreshToken(ctx context.Context, arg db.RotateSessionRefreshTokenParams) (db.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "RotateSessionRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(db.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+// RotateSessionRefreshToken indicates an expected call of RotateSessionRefreshToken.
+func (mr *MockStoreMockRecorder) RotateSessionRefreshToken(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSessionRefreshToken", reflect.TypeOf((*MockStore)(nil).RotateSessionRefreshToken), ctx, arg)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(ctx context.Context, arg db.SearchUsersParams) ([]db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), ctx, arg)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+// SetAmlScanWatermark mocks base method.
+func (m *MockStore) SetAmlScanWatermark(ctx context.Context, scannedUntil time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "SetAmlScanWatermark", ctx, scannedUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAmlScanWatermark indicates an expected call of SetAmlScanWatermark.
+func (mr *MockStoreMockRecorder) SetAmlScanWatermark(ctx, scannedUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAmlScanWatermark", reflect.TypeOf((*MockStore)(nil).SetAmlScanWatermark), ctx, scannedUntil)
+}
+
+// SumTransfersFromOwnerSince mocks base method.
+func (m *MockStore) SumTransfersFromOwnerSince(ctx context.Context, arg db.SumTransfersFromOwnerSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumTransfersFromOwnerSince", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+// SumTransfersFromOwnerSince indicates an expected call of SumTransfersFromOwnerSince.
+func (mr *MockStoreMockRecorder) SumTransfersFromOwnerSince(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumTransfersFromOwnerSince", reflect.TypeOf((*MockStore)(nil).SumTransfersFromOwnerSince), ctx, arg)
+}
+
+// TouchServiceClientKey mocks base method.
+func (m *MockStore) TouchServiceClientKey(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchServiceClientKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchServiceClientKey indicates an expected call of TouchServiceClientKey.
+func (mr *MockStoreMockRecorder) TouchServiceClientKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchServiceClientKey", reflect.TypeOf((*MockStore)(nil).TouchServiceClientKey), ctx, id)
 }
 
 // TransferTx mocks base method.
@@ -326,6 +2430,36 @@ func (mr *MockStoreMockRecorder) TransferTx(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), ctx, arg)
 }
 
+// UnfreezeAccounts mocks base method.
+func (m *MockStore) UnfreezeAccounts(ctx context.Context, owner string) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccounts", ctx, owner)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccounts indicates an expected call of UnfreezeAccounts.
+func (mr *MockStoreMockRecorder) UnfreezeAccounts(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccounts", reflect.TypeOf((*MockStore)(nil).UnfreezeAccounts), ctx, owner)
+}
+
+// UnlockUser mocks base method.
+func (m *MockStore) UnlockUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockStoreMockRecorder) UnlockUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockStore)(nil).UnlockUser), ctx, username)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +2475,21 @@ func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
 }
 
+// UpdateTransferCategory mocks base method.
+func (m *MockStore) UpdateTransferCategory(ctx context.Context, arg db.UpdateTransferCategoryParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferCategory", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransferCategory indicates an expected call of UpdateTransferCategory.
+func (mr *MockStoreMockRecorder) UpdateTransferCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferCategory", reflect.TypeOf((*MockStore)(nil).UpdateTransferCategory), ctx, arg)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +2505,66 @@ func (mr *MockStoreMockRecorder) UpdateUser(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), ctx, arg)
 }
 
+// UpdateUserKyc mocks base method.
+func (m *MockStore) UpdateUserKyc(ctx context.Context, arg db.UpdateUserKycParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserKyc", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserKyc indicates an expected call of UpdateUserKyc.
+func (mr *MockStoreMockRecorder) UpdateUserKyc(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserKyc", reflect.TypeOf((*MockStore)(nil).UpdateUserKyc), ctx, arg)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(ctx context.Context, arg db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), ctx, arg)
+}
+
+// UpdateUserStatus mocks base method.
+func (m *MockStore) UpdateUserStatus(ctx context.Context, arg db.UpdateUserStatusParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockStoreMockRecorder) UpdateUserStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockStore)(nil).UpdateUserStatus), ctx, arg)
+}
+
+// UpdateUserTx mocks base method.
+func (m *MockStore) UpdateUserTx(ctx context.Context, arg db.UpdateUserTxParams) (db.UpdateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTx", ctx, arg)
+	ret0, _ := ret[0].(db.UpdateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTx indicates an expected call of UpdateUserTx.
+func (mr *MockStoreMockRecorder) UpdateUserTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTx", reflect.TypeOf((*MockStore)(nil).UpdateUserTx), ctx, arg)
+}
+
 // UpdateVerifyEmail mocks base method.
 func (m *MockStore) UpdateVerifyEmail(ctx context.Context, arg db.UpdateVerifyEmailParams) (db.VerifyEmail, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +2580,81 @@ func (mr *MockStoreMockRecorder) UpdateVerifyEmail(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifyEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerifyEmail), ctx, arg)
 }
 
+// UploadKycDocumentTx mocks base method.
+func (m *MockStore) UploadKycDocumentTx(ctx context.Context, arg db.UploadKycDocumentTxParams) (db.UploadKycDocumentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadKycDocumentTx", ctx, arg)
+	ret0, _ := ret[0].(db.UploadKycDocumentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadKycDocumentTx indicates an expected call of UploadKycDocumentTx.
+func (mr *MockStoreMockRecorder) UploadKycDocumentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadKycDocumentTx", reflect.TypeOf((*MockStore)(nil).UploadKycDocumentTx), ctx, arg)
+}
+
+// UpsertTotpCredential mocks base method.
+func (m *MockStore) UpsertTotpCredential(ctx context.Context, arg db.UpsertTotpCredentialParams) (db.TotpCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTotpCredential", ctx, arg)
+	ret0, _ := ret[0].(db.TotpCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTotpCredential indicates an expected call of UpsertTotpCredential.
+func (mr *MockStoreMockRecorder) UpsertTotpCredential(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTotpCredential", reflect.TypeOf((*MockStore)(nil).UpsertTotpCredential), ctx, arg)
+}
+
+// UsePasswordReset mocks base method.
+func (m *MockStore) UsePasswordReset(ctx context.Context, hashedToken string) (db.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordReset", ctx, hashedToken)
+	ret0, _ := ret[0].(db.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsePasswordReset indicates an expected call of UsePasswordReset.
+func (mr *MockStoreMockRecorder) UsePasswordReset(ctx, hashedToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordReset", reflect.TypeOf((*MockStore)(nil).UsePasswordReset), ctx, hashedToken)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockStore) UseRecoveryCode(ctx context.Context, arg db.UseRecoveryCodeParams) (db.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, arg)
+	ret0, _ := ret[0].(db.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockStoreMockRecorder) UseRecoveryCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockStore)(nil).UseRecoveryCode), ctx, arg)
+}
+
+// UseTotpStep mocks base method.
+func (m *MockStore) UseTotpStep(ctx context.Context, arg db.UseTotpStepParams) (db.TotpCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTotpStep", ctx, arg)
+	ret0, _ := ret[0].(db.TotpCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTotpStep indicates an expected call of UseTotpStep.
+func (mr *MockStoreMockRecorder) UseTotpStep(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTotpStep", reflect.TypeOf((*MockStore)(nil).UseTotpStep), ctx, arg)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(ctx context.Context, arg db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
@@ -385,3 +2669,18 @@ func (mr *MockStoreMockRecorder) VerifyEmailTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailTx", reflect.TypeOf((*MockStore)(nil).VerifyEmailTx), ctx, arg)
 }
+
+// VerifyUserEmail mocks base method.
+func (m *MockStore) VerifyUserEmail(ctx context.Context, arg db.VerifyUserEmailParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyUserEmail", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyUserEmail indicates an expected call of VerifyUserEmail.
+func (mr *MockStoreMockRecorder) VerifyUserEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserEmail", reflect.TypeOf((*MockStore)(nil).VerifyUserEmail), ctx, arg)
+}
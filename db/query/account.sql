@@ -1,6 +1,6 @@
 -- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency)
-VALUES ($1, $2, $3)
+INSERT INTO accounts (owner, balance, currency, number)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetAccount :one
@@ -9,6 +9,12 @@ FROM accounts
 WHERE id = $1
 LIMIT 1;
 
+-- name: GetAccountByNumber :one
+SELECT *
+FROM accounts
+WHERE number = $1
+LIMIT 1;
+
 -- name: GetAccountForUpdate :one
 SELECT *
 FROM accounts
@@ -27,6 +33,8 @@ LIMIT $2 OFFSET $3;
 UPDATE accounts
 SET balance = balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
+  AND closed_at IS NULL
+  AND frozen_at IS NULL
 RETURNING *;
 
 -- name: UpdateAccount :one
@@ -36,4 +44,44 @@ WHERE id = $1
 RETURNING *;
 
 -- name: DeleteAccount :exec
-DELETE FROM accounts WHERE id = $1;
\ No newline at end of file
+DELETE FROM accounts WHERE id = $1;
+-- name: ListAccountsByOwner :many
+SELECT *
+FROM accounts
+WHERE owner = $1
+ORDER BY id;
+
+-- name: ListAccountsByOwnerForUpdate :many
+SELECT *
+FROM accounts
+WHERE owner = $1
+ORDER BY id
+FOR NO KEY UPDATE;
+
+-- name: CloseAccounts :many
+UPDATE accounts
+SET closed_at = now()
+WHERE owner = $1
+  AND closed_at IS NULL
+RETURNING *;
+
+-- name: CountOpenAccountsByOwner :one
+SELECT count(*)
+FROM accounts
+WHERE owner = $1
+  AND closed_at IS NULL;
+
+-- name: FreezeAccounts :many
+UPDATE accounts
+SET frozen_at = now()
+WHERE owner = $1
+  AND closed_at IS NULL
+  AND frozen_at IS NULL
+RETURNING *;
+
+-- name: UnfreezeAccounts :many
+UPDATE accounts
+SET frozen_at = NULL
+WHERE owner = $1
+  AND frozen_at IS NOT NULL
+RETURNING *;
@@ -0,0 +1,72 @@
+-- name: ListUnreportedLargeTransfers :many
+-- Lists transfers to other people of at least the amount that no large transaction report covers yet.
+SELECT t.id, t.amount, t.created_at, a.owner, a.currency
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts b ON b.id = t.to_account_id
+WHERE t.amount >= sqlc.arg(min_amount)
+  AND t.created_at >= sqlc.arg(since)
+  AND a.owner <> b.owner
+  AND NOT EXISTS (SELECT 1
+                  FROM aml_reports r
+                  WHERE r.kind = 'large_transaction'
+                    AND r.transfer_ids @> ARRAY [t.id])
+ORDER BY t.id;
+
+-- name: ListStructuringCandidates :many
+-- Groups the transfers to other people with an amount in [min_amount, max_amount) that no structuring report covers
+-- yet by sender and currency, returning the senders who made at least min_transfers of them.
+SELECT a.owner,
+       a.currency,
+       array_agg(t.id ORDER BY t.id)::bigint[] AS transfer_ids,
+       sum(t.amount)::bigint                   AS total_amount,
+       min(t.created_at)::timestamptz          AS window_start,
+       max(t.created_at)::timestamptz          AS window_end
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts b ON b.id = t.to_account_id
+WHERE t.amount >= sqlc.arg(min_amount)
+  AND t.amount < sqlc.arg(max_amount)
+  AND t.created_at >= sqlc.arg(since)
+  AND a.owner <> b.owner
+  AND NOT EXISTS (SELECT 1
+                  FROM aml_reports r
+                  WHERE r.kind = 'structuring'
+                    AND r.transfer_ids @> ARRAY [t.id])
+GROUP BY a.owner, a.currency
+HAVING count(*) >= sqlc.arg(min_transfers)::bigint
+ORDER BY a.owner, a.currency;
+
+-- name: CreateAmlReport :one
+INSERT INTO aml_reports (kind, username, currency, total_amount, transfer_ids, window_start, window_end)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetAmlReport :one
+SELECT *
+FROM aml_reports
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListAmlReports :many
+-- Lists the newest reports first.
+SELECT *
+FROM aml_reports
+WHERE (sqlc.narg(kind)::varchar IS NULL OR kind = sqlc.narg(kind))
+  AND (sqlc.narg(username)::varchar IS NULL OR username = sqlc.narg(username))
+  AND (sqlc.narg(start_time)::timestamptz IS NULL OR created_at >= sqlc.narg(start_time))
+  AND (sqlc.narg(end_time)::timestamptz IS NULL OR created_at < sqlc.narg(end_time))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: GetAmlScanWatermark :one
+SELECT scanned_until
+FROM aml_scan_watermark
+LIMIT 1;
+
+-- name: SetAmlScanWatermark :exec
+-- Moves the watermark forward only, so a slow run finishing after a later one does not move it back.
+INSERT INTO aml_scan_watermark (scanned_until)
+VALUES (sqlc.arg(scanned_until))
+ON CONFLICT (id) DO UPDATE SET scanned_until = greatest(aml_scan_watermark.scanned_until, excluded.scanned_until);
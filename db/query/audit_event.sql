@@ -0,0 +1,38 @@
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (username, impersonator, impersonation_id, role, client_id, method, resources, request,
+                          status_code, client_ip, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: ListAuditEvents :many
+-- Lists the newest events first, the actor matches both the user and the impersonator of a call.
+SELECT *
+FROM audit_events
+WHERE (sqlc.narg(actor)::varchar IS NULL OR username = sqlc.narg(actor) OR impersonator = sqlc.narg(actor))
+  AND (sqlc.narg(resource)::varchar IS NULL OR sqlc.narg(resource) = ANY (resources))
+  AND (sqlc.narg(start_time)::timestamptz IS NULL OR created_at >= sqlc.narg(start_time))
+  AND (sqlc.narg(end_time)::timestamptz IS NULL OR created_at < sqlc.narg(end_time))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: AllowAuditEventErasure :exec
+-- Lets EraseAuditEvents change the append-only audit log until the end of the transaction.
+SELECT set_config('simplebank.erasing_user', 'on', TRUE);
+
+-- name: EraseAuditEvents :exec
+-- Replaces the user with the pseudonym in the events about them. What the user sent and where from is
+-- removed, events of employees acting on the user keep their actor but not the request.
+UPDATE audit_events
+SET username     = CASE WHEN username = sqlc.arg(username)::varchar THEN sqlc.arg(pseudonym)::varchar ELSE username END,
+    impersonator = CASE WHEN impersonator = sqlc.arg(username)::varchar THEN sqlc.arg(pseudonym)::varchar ELSE impersonator END,
+    resources    = array_replace(resources, 'users/' || sqlc.arg(username)::varchar, 'users/' || sqlc.arg(pseudonym)::varchar),
+    request      = CASE
+                       WHEN username = sqlc.arg(username)::varchar OR request ->> 'username' = sqlc.arg(username)::varchar THEN '{}'::jsonb
+                       ELSE request END,
+    client_ip    = CASE WHEN username = sqlc.arg(username)::varchar THEN '' ELSE client_ip END,
+    user_agent   = CASE WHEN username = sqlc.arg(username)::varchar THEN '' ELSE user_agent END
+WHERE username = sqlc.arg(username)::varchar
+   OR impersonator = sqlc.arg(username)::varchar
+   OR request ->> 'username' = sqlc.arg(username)::varchar
+   OR 'users/' || sqlc.arg(username)::varchar = ANY (resources);
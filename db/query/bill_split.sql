@@ -0,0 +1,25 @@
+-- name: CreateBillSplit :one
+INSERT INTO bill_splits (creator, to_account_id, amount, currency, description, split_method, creator_share)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetBillSplit :one
+SELECT *
+FROM bill_splits
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListBillSplits :many
+SELECT *
+FROM bill_splits
+WHERE creator = sqlc.arg(creator)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: MarkBillSplitReminded :one
+UPDATE bill_splits
+SET reminded_at = now()
+WHERE id = sqlc.arg(id)
+  AND (reminded_at IS NULL OR reminded_at <= sqlc.arg(reminded_before)::timestamptz)
+RETURNING *;
@@ -0,0 +1,21 @@
+-- name: CreateCategoryRule :one
+INSERT INTO category_rules (owner, keyword, counterparty_account_id, category)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetCategoryRule :one
+SELECT *
+FROM category_rules
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListCategoryRules :many
+SELECT *
+FROM category_rules
+WHERE owner = $1
+ORDER BY id DESC;
+
+-- name: DeleteCategoryRule :exec
+DELETE
+FROM category_rules
+WHERE id = $1;
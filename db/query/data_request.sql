@@ -0,0 +1,26 @@
+-- name: CreateDataExportRequest :one
+INSERT INTO data_requests (username, kind)
+SELECT sqlc.arg(username), 'export'
+WHERE NOT EXISTS (SELECT 1
+                  FROM data_requests
+                  WHERE username = sqlc.arg(username)
+                    AND kind = 'export'
+                    AND created_at > sqlc.arg(created_after)::timestamptz)
+RETURNING *;
+
+-- name: CreateDataErasureRequest :one
+INSERT INTO data_requests (username, kind, completed_at)
+VALUES ($1, 'erasure', now())
+RETURNING *;
+
+-- name: GetDataRequest :one
+SELECT *
+FROM data_requests
+WHERE id = $1
+LIMIT 1;
+
+-- name: CompleteDataRequest :one
+UPDATE data_requests
+SET completed_at = now()
+WHERE id = $1
+RETURNING *;
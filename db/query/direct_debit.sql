@@ -0,0 +1,33 @@
+-- name: CreateDirectDebit :one
+INSERT INTO direct_debits (mandate_id, transfer_id, amount)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetDirectDebit :one
+SELECT *
+FROM direct_debits
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetDirectDebitForUpdate :one
+SELECT *
+FROM direct_debits
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListDirectDebits :many
+SELECT *
+FROM direct_debits
+WHERE mandate_id = sqlc.arg(mandate_id)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ChargebackDirectDebit :one
+UPDATE direct_debits
+SET status                 = 'charged_back',
+    chargeback_transfer_id = $2,
+    charged_back_at        = now()
+WHERE id = $1
+RETURNING *;
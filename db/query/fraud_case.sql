@@ -0,0 +1,36 @@
+-- name: CreateFraudCase :one
+INSERT INTO fraud_cases (username, from_account_id, to_account_id, amount, description, rule, features)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetFraudCase :one
+SELECT *
+FROM fraud_cases
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetFraudCaseForUpdate :one
+SELECT *
+FROM fraud_cases
+WHERE id = $1
+LIMIT 1
+    FOR NO KEY UPDATE;
+
+-- name: ListFraudCases :many
+-- Lists the cases with the status, oldest first so that the longest held transfers are reviewed first.
+SELECT *
+FROM fraud_cases
+WHERE status = sqlc.arg(status)
+  AND (sqlc.narg(after_id)::bigint IS NULL OR id > sqlc.narg(after_id))
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ResolveFraudCase :one
+UPDATE fraud_cases
+SET status          = sqlc.arg(status),
+    resolved_by     = sqlc.arg(resolved_by),
+    resolution_note = sqlc.narg(resolution_note),
+    transfer_id     = sqlc.narg(transfer_id),
+    resolved_at     = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
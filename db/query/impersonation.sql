@@ -0,0 +1,20 @@
+-- name: CreateImpersonation :one
+INSERT INTO impersonations (id, impersonator, impersonator_session_id, username, reason, read_only, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetImpersonation :one
+SELECT *
+FROM impersonations
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListImpersonations :many
+-- Includes how many calls were made under each impersonation, so users can tell looking from acting.
+SELECT sqlc.embed(impersonations),
+       (SELECT count(*) FROM audit_events a WHERE a.impersonation_id = impersonations.id) AS calls
+FROM impersonations
+WHERE impersonations.username = sqlc.arg(username)
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR impersonations.created_at < sqlc.narg(created_before))
+ORDER BY impersonations.created_at DESC
+LIMIT sqlc.arg(page_size);
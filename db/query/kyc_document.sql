@@ -0,0 +1,59 @@
+-- name: CreateKycDocument :one
+-- Users can only have one document of each kind waiting for review at a time.
+INSERT INTO kyc_documents (username, kind, blob_key, content_type, size, sha256)
+SELECT sqlc.arg(username),
+       sqlc.arg(kind),
+       sqlc.arg(blob_key),
+       sqlc.arg(content_type),
+       sqlc.arg(size),
+       sqlc.arg(sha256)
+WHERE NOT EXISTS (SELECT 1
+                  FROM kyc_documents
+                  WHERE username = sqlc.arg(username)
+                    AND kind = sqlc.arg(kind)
+                    AND status = 'pending')
+RETURNING *;
+
+-- name: GetKycDocument :one
+SELECT *
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetKycDocumentForUpdate :one
+SELECT *
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListKycDocumentsByUsername :many
+SELECT *
+FROM kyc_documents
+WHERE username = $1
+ORDER BY id DESC;
+
+-- name: ListPendingKycDocuments :many
+-- Lists the documents waiting for review, oldest first.
+SELECT *
+FROM kyc_documents
+WHERE status = 'pending'
+  AND (sqlc.narg(after_id)::bigint IS NULL OR id > sqlc.narg(after_id))
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ReviewKycDocument :one
+UPDATE kyc_documents
+SET status           = sqlc.arg(status),
+    rejection_reason = sqlc.narg(rejection_reason),
+    reviewed_by      = sqlc.arg(reviewed_by),
+    reviewed_at      = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteKycDocuments :many
+-- Returns the keys of the deleted documents, their files have to be removed from the blob store.
+DELETE
+FROM kyc_documents
+WHERE username = $1
+RETURNING blob_key;
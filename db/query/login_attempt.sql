@@ -0,0 +1,38 @@
+-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (username, client_ip, user_agent, succeeded, failure_reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetLoginFailureStats :one
+-- Counts the failures of a username since the start of the window,
+-- ignoring those before its last successful login or unlock.
+SELECT count(*)                                             AS failures,
+       coalesce(max(created_at), 'epoch'::timestamptz)::timestamptz AS last_failed_at
+FROM login_attempts
+WHERE login_attempts.username = sqlc.arg(username)
+  AND NOT succeeded
+  AND created_at > greatest(
+        sqlc.arg(since)::timestamptz,
+        (SELECT max(s.created_at) FROM login_attempts s WHERE s.username = sqlc.arg(username) AND s.succeeded),
+        (SELECT u.unlocked_at FROM users u WHERE u.username = sqlc.arg(username))
+    );
+
+-- name: CountLoginFailuresByIP :one
+SELECT count(*)
+FROM login_attempts
+WHERE client_ip = sqlc.arg(client_ip)
+  AND NOT succeeded
+  AND created_at > sqlc.arg(since);
+
+-- name: ListLoginAttempts :many
+SELECT *
+FROM login_attempts
+WHERE username = sqlc.arg(username)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: DeleteLoginAttempts :exec
+DELETE
+FROM login_attempts
+WHERE username = $1;
@@ -0,0 +1,43 @@
+-- name: CreateMandate :one
+INSERT INTO mandates (debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency,
+                      reference)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetMandate :one
+SELECT *
+FROM mandates
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetMandateForUpdate :one
+SELECT *
+FROM mandates
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListMandates :many
+SELECT *
+FROM mandates
+WHERE (debtor = sqlc.arg(username) OR creditor = sqlc.arg(username))
+  AND (sqlc.narg(account_ids)::bigint[] IS NULL
+    OR (debtor = sqlc.arg(username) AND debtor_account_id = ANY (sqlc.narg(account_ids)::bigint[]))
+    OR (creditor = sqlc.arg(username) AND creditor_account_id = ANY (sqlc.narg(account_ids)::bigint[])))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: RevokeMandate :one
+UPDATE mandates
+SET status     = 'revoked',
+    revoked_at = now()
+WHERE id = $1
+  AND status = 'active'
+RETURNING *;
+
+-- name: MarkMandateCollected :one
+UPDATE mandates
+SET last_collected_at = now()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,73 @@
+-- name: UpsertTotpCredential :one
+INSERT INTO totp_credentials (username, secret)
+VALUES ($1, $2)
+ON CONFLICT (username) DO UPDATE
+    SET secret         = excluded.secret,
+        last_used_step = 0,
+        created_at     = now()
+WHERE totp_credentials.confirmed_at IS NULL
+RETURNING *;
+
+-- name: GetTotpCredential :one
+SELECT *
+FROM totp_credentials
+WHERE username = $1
+LIMIT 1;
+
+-- name: ConfirmTotpCredential :one
+UPDATE totp_credentials
+SET confirmed_at = now()
+WHERE username = $1
+  AND confirmed_at IS NULL
+RETURNING *;
+
+-- name: UseTotpStep :one
+UPDATE totp_credentials
+SET last_used_step = sqlc.arg(step)
+WHERE username = sqlc.arg(username)
+  AND last_used_step < sqlc.arg(step)
+RETURNING *;
+
+-- name: DeleteTotpCredential :exec
+DELETE
+FROM totp_credentials
+WHERE username = $1;
+
+-- name: CreateRecoveryCode :one
+INSERT INTO recovery_codes (username, hashed_code)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UseRecoveryCode :one
+UPDATE recovery_codes
+SET used_at = now()
+WHERE username = $1
+  AND hashed_code = $2
+  AND used_at IS NULL
+RETURNING *;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM recovery_codes
+WHERE username = $1;
+
+-- name: CreateMfaChallenge :one
+INSERT INTO mfa_challenges (username, expires_at)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: AttemptMfaChallenge :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE id = sqlc.arg(id)
+  AND completed_at IS NULL
+  AND expires_at > now()
+  AND attempts < sqlc.arg(max_attempts)::int
+RETURNING *;
+
+-- name: CompleteMfaChallenge :one
+UPDATE mfa_challenges
+SET completed_at = now()
+WHERE id = $1
+  AND completed_at IS NULL
+RETURNING *;
@@ -0,0 +1,23 @@
+-- name: CreatePasswordReset :one
+INSERT INTO "password_resets" (username, hashed_token)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: CreatePasswordResetWithExpiry :one
+INSERT INTO "password_resets" (username, hashed_token, expired_at)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UsePasswordReset :one
+UPDATE "password_resets"
+SET is_used = TRUE
+WHERE hashed_token = $1
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING *;
+
+-- name: InvalidatePasswordResets :exec
+UPDATE "password_resets"
+SET is_used = TRUE
+WHERE username = $1
+  AND is_used = FALSE;
@@ -0,0 +1,44 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (requester, payer, is_link, to_account_id, amount, currency, description, expires_at,
+                              bill_split_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT *
+FROM payment_requests
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT *
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE (requester = sqlc.arg(username) OR payer = sqlc.arg(username))
+  AND (sqlc.narg(status)::varchar IS NULL OR
+       CASE WHEN status = 'pending' AND expires_at <= now() THEN 'expired' ELSE status END = sqlc.narg(status))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListBillSplitPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE bill_split_id = ANY (sqlc.arg(bill_split_ids)::bigint[])
+ORDER BY id;
+
+-- name: ResolvePaymentRequest :one
+UPDATE payment_requests
+SET status      = sqlc.arg(status),
+    payer       = coalesce(sqlc.narg(payer), payer),
+    transfer_id = coalesce(sqlc.narg(transfer_id), transfer_id),
+    resolved_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'pending'
+RETURNING *;
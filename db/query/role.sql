@@ -0,0 +1,15 @@
+-- name: ListRolePermissions :many
+SELECT permission
+FROM role_permissions
+WHERE role = $1
+ORDER BY permission;
+
+-- name: ListRoles :many
+SELECT r.name,
+       r.description,
+       coalesce(array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL),
+                '{}')::varchar[] AS permissions
+FROM roles r
+         LEFT JOIN role_permissions rp ON rp.role = r.name
+GROUP BY r.name
+ORDER BY r.name;
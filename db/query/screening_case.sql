@@ -0,0 +1,49 @@
+-- name: CreateScreeningCase :execrows
+-- Matches that were already recorded for the name, including cleared ones, are not raised again.
+INSERT INTO screening_cases (username, trigger, screened_name, entry_id, entry_name, programs, score)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (username, entry_id, screened_name) DO NOTHING;
+
+-- name: GetScreeningCase :one
+SELECT *
+FROM screening_cases
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetScreeningCaseForUpdate :one
+SELECT *
+FROM screening_cases
+WHERE id = $1
+LIMIT 1
+    FOR NO KEY UPDATE;
+
+-- name: ListScreeningCases :many
+SELECT *
+FROM screening_cases
+WHERE status = sqlc.arg(status)
+  AND (sqlc.narg(after_id)::bigint IS NULL OR id > sqlc.narg(after_id))
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ResolveScreeningCase :one
+UPDATE screening_cases
+SET status          = sqlc.arg(status),
+    resolved_by     = sqlc.arg(resolved_by),
+    resolution_note = sqlc.narg(resolution_note),
+    resolved_at     = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CountRestrictingScreeningCases :one
+-- Counts the open and confirmed cases of a user, the user stays restricted while there are any.
+SELECT count(*)
+FROM screening_cases
+WHERE username = $1
+  AND status IN ('open', 'confirmed');
+
+-- name: EraseScreeningCases :exec
+-- The matched watchlist entry and the review stay as the record of the screening, only the name that was screened
+-- is removed. The case id keeps the placeholder unique for the entry.
+UPDATE screening_cases
+SET screened_name = 'erased-' || id
+WHERE username = $1;
@@ -0,0 +1,56 @@
+-- name: CreateServiceClient :one
+INSERT INTO service_clients (id, owner, name, scopes, account_ids)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetServiceClient :one
+SELECT *
+FROM service_clients
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListServiceClients :many
+SELECT *
+FROM service_clients
+WHERE owner = $1
+ORDER BY created_at DESC;
+
+-- name: DisableServiceClient :one
+UPDATE service_clients
+SET disabled_at = coalesce(disabled_at, now())
+WHERE id = sqlc.arg(id)
+  AND owner = sqlc.arg(owner)
+RETURNING *;
+
+-- name: CreateServiceClientKey :one
+INSERT INTO service_client_keys (client_id, hashed_secret)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetServiceClientKey :one
+-- Returns the key unless it has expired after a rotation.
+SELECT *
+FROM service_client_keys
+WHERE client_id = sqlc.arg(client_id)
+  AND hashed_secret = sqlc.arg(hashed_secret)
+  AND (expires_at IS NULL OR expires_at > now())
+LIMIT 1;
+
+-- name: ListServiceClientKeys :many
+SELECT *
+FROM service_client_keys
+WHERE client_id = $1
+  AND (expires_at IS NULL OR expires_at > now())
+ORDER BY id DESC;
+
+-- name: ExpireServiceClientKeys :execrows
+-- Keeps the current keys of the client valid until the end of the overlap, keys expiring sooner keep their expiry.
+UPDATE service_client_keys
+SET expires_at = least(coalesce(expires_at, sqlc.arg(expires_at)), sqlc.arg(expires_at))
+WHERE client_id = sqlc.arg(client_id)
+  AND (expires_at IS NULL OR expires_at > now());
+
+-- name: TouchServiceClientKey :exec
+UPDATE service_client_keys
+SET last_used_at = now()
+WHERE id = $1;
@@ -1,5 +1,5 @@
 -- name: CreateSession :one
-INSERT INTO sessions (id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at)
+INSERT INTO sessions (id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
@@ -7,4 +7,63 @@ RETURNING *;
 SELECT *
 FROM sessions
 WHERE id = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: RotateSessionRefreshToken :one
+UPDATE sessions
+SET hashed_refresh_token = sqlc.arg(new_hashed_refresh_token)
+WHERE id = sqlc.arg(id)
+  AND hashed_refresh_token = sqlc.arg(hashed_refresh_token)
+  AND is_blocked = FALSE
+RETURNING *;
+
+-- name: ListActiveSessions :many
+SELECT *
+FROM sessions
+WHERE username = $1
+  AND is_blocked = FALSE
+  AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE id = $1
+  AND username = $2
+RETURNING *;
+
+-- name: BlockOtherSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE username = sqlc.arg(username)
+  AND id <> sqlc.arg(current_session_id)
+  AND is_blocked = FALSE;
+
+-- name: BlockSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE username = $1
+  AND is_blocked = FALSE;
+
+-- name: ListSessionsByUsername :many
+SELECT *
+FROM sessions
+WHERE username = $1
+ORDER BY created_at;
+
+-- name: EraseSessions :exec
+UPDATE sessions
+SET user_agent = '',
+    client_ip  = '',
+    is_blocked = TRUE
+WHERE username = $1;
+
+-- name: IsNewDeviceSession :one
+-- Reports whether the user had never logged in with the user agent of the session before it.
+SELECT NOT EXISTS (SELECT 1
+                   FROM sessions earlier
+                   WHERE earlier.username = s.username
+                     AND earlier.user_agent = s.user_agent
+                     AND earlier.created_at < s.created_at)::bool AS new_device
+FROM sessions s
+WHERE s.id = $1;
@@ -0,0 +1,49 @@
+-- name: GetSpendingByCategory :many
+SELECT coalesce(t.category, 'other')::varchar AS category,
+       a.currency,
+       sum(t.amount)::bigint                  AS amount,
+       count(*)::bigint                       AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND (sqlc.narg(account_ids)::bigint[] IS NULL OR a.id = ANY (sqlc.narg(account_ids)::bigint[]))
+  AND c.owner <> sqlc.arg(owner)
+  AND t.created_at >= sqlc.arg(start_time)
+  AND t.created_at < sqlc.arg(end_time)
+GROUP BY 1, a.currency
+ORDER BY amount DESC;
+
+-- name: GetSpendingByMonth :many
+SELECT date_trunc('month', t.created_at)::timestamptz AS month,
+       a.currency,
+       sum(t.amount)::bigint                          AS amount,
+       count(*)::bigint                               AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND (sqlc.narg(account_ids)::bigint[] IS NULL OR a.id = ANY (sqlc.narg(account_ids)::bigint[]))
+  AND c.owner <> sqlc.arg(owner)
+  AND t.created_at >= sqlc.arg(start_time)
+  AND t.created_at < sqlc.arg(end_time)
+GROUP BY 1, a.currency
+ORDER BY month;
+
+-- name: GetSpendingByCounterparty :many
+SELECT c.number                AS counterparty_account_number,
+       c.owner                 AS counterparty,
+       a.currency,
+       sum(t.amount)::bigint   AS amount,
+       count(*)::bigint        AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND (sqlc.narg(account_ids)::bigint[] IS NULL OR a.id = ANY (sqlc.narg(account_ids)::bigint[]))
+  AND c.owner <> sqlc.arg(owner)
+  AND t.created_at >= sqlc.arg(start_time)
+  AND t.created_at < sqlc.arg(end_time)
+GROUP BY c.id, c.number, c.owner, a.currency
+ORDER BY amount DESC
+LIMIT sqlc.arg('limit');
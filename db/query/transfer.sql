@@ -1,6 +1,6 @@
 -- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount, description)
-VALUES ($1, $2, $3, $4)
+INSERT INTO transfers (from_account_id, to_account_id, amount, description, category)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetTransfer :one
@@ -16,3 +16,41 @@ WHERE from_account_id = $1
    OR to_account_id = $2
 ORDER BY id
 LIMIT $3 OFFSET $4;
+
+-- name: UpdateTransferCategory :one
+UPDATE transfers
+SET category = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: ListTransfersByAccounts :many
+SELECT *
+FROM transfers
+WHERE from_account_id = ANY (sqlc.arg(account_ids)::bigint[])
+   OR to_account_id = ANY (sqlc.arg(account_ids)::bigint[])
+ORDER BY id;
+
+-- name: SumTransfersFromOwnerSince :one
+-- Adds up the amounts the owner sent from any of their accounts, regardless of currency.
+SELECT coalesce(sum(t.amount), 0)::bigint
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND t.created_at >= sqlc.arg(since);
+
+-- name: GetTransferHistory :one
+-- Summarizes what the owner sent from any of their accounts in the last 90 days for the fraud rules.
+SELECT count(*) FILTER (WHERE t.created_at >= now() - interval '1 hour')::bigint                   AS transfers_last_hour,
+       coalesce(sum(t.amount) FILTER (WHERE t.created_at >= now() - interval '1 day'), 0)::bigint AS amount_last_day,
+       coalesce(avg(t.amount), 0)::float8                                                        AS average_amount,
+       count(*) FILTER (WHERE t.to_account_id = sqlc.arg(to_account_id))::bigint                 AS transfers_to_payee
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+WHERE a.owner = sqlc.arg(owner)
+  AND t.created_at >= now() - interval '90 days';
+
+-- name: ListTransfersByIDs :many
+SELECT *
+FROM transfers
+WHERE id = ANY (sqlc.arg(ids)::bigint[])
+ORDER BY id;
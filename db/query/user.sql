@@ -1,6 +1,6 @@
 -- name: CreateUser :one
-INSERT INTO users (username, hashed_password, full_name, email)
-VALUES ($1, $2, $3, $4)
+INSERT INTO users (username, hashed_password, full_name, email, invited_by)
+VALUES (sqlc.arg(username), sqlc.arg(hashed_password), sqlc.arg(full_name), sqlc.arg(email), sqlc.narg(invited_by))
 RETURNING *;
 
 -- name: GetUser :one
@@ -17,4 +17,112 @@ SET hashed_password     = coalesce(sqlc.narg(hashed_password), hashed_password),
     email               = coalesce(sqlc.narg(email), email),
     is_email_verified   = coalesce(sqlc.narg(is_email_verified), is_email_verified)
 WHERE username = sqlc.arg(username)
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: GetUserByEmail :one
+SELECT *
+FROM users
+WHERE email = $1
+LIMIT 1;
+
+-- name: LockUser :one
+UPDATE users
+SET locked_until = sqlc.arg(locked_until)
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: UnlockUser :one
+UPDATE users
+SET locked_until = NULL,
+    unlocked_at  = now()
+WHERE username = $1
+RETURNING *;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = sqlc.arg(role)
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: MarkVerificationEmailSent :one
+UPDATE users
+SET verification_email_sent_at = now()
+WHERE username = sqlc.arg(username)
+  AND (verification_email_sent_at IS NULL OR verification_email_sent_at <= sqlc.arg(sent_before)::timestamptz)
+RETURNING *;
+
+-- name: VerifyUserEmail :one
+UPDATE users
+SET is_email_verified = TRUE
+WHERE username = sqlc.arg(username)
+  AND email = sqlc.arg(email)
+RETURNING *;
+
+-- name: GetUserForUpdate :one
+SELECT *
+FROM users
+WHERE username = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: RehashUserPassword :one
+UPDATE users
+SET hashed_password = sqlc.arg(new_hashed_password)
+WHERE username = sqlc.arg(username)
+  AND hashed_password = sqlc.arg(old_hashed_password)
+RETURNING *;
+
+-- name: EraseUser :one
+-- Renaming the user to the pseudonym cascades to every table referring to them.
+UPDATE users
+SET username          = sqlc.arg(pseudonym),
+    full_name         = '',
+    email             = sqlc.arg(email),
+    hashed_password   = '',
+    is_email_verified = FALSE,
+    status            = 'deactivated',
+    erased_at         = now()
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: UpdateUserKyc :one
+UPDATE users
+SET kyc_tier   = sqlc.arg(kyc_tier),
+    kyc_status = sqlc.arg(kyc_status)
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: GetUserStatus :one
+SELECT status
+FROM users
+WHERE username = $1
+LIMIT 1;
+
+-- name: UpdateUserStatus :one
+UPDATE users
+SET status = sqlc.arg(status)
+WHERE username = sqlc.arg(username)
+RETURNING *;
+
+-- name: SearchUsers :many
+-- Lists the users matching all given filters ordered by username, the email has to match exactly.
+SELECT *
+FROM users
+WHERE (sqlc.narg(username_prefix)::varchar IS NULL OR starts_with(username, sqlc.narg(username_prefix)))
+  AND (sqlc.narg(email)::varchar IS NULL OR lower(email) = lower(sqlc.narg(email)))
+  AND (sqlc.narg(full_name)::varchar IS NULL OR full_name ILIKE '%' || sqlc.narg(full_name) || '%')
+  AND (sqlc.narg(after_username)::varchar IS NULL OR username > sqlc.narg(after_username))
+ORDER BY username
+LIMIT sqlc.arg(page_size);
+
+-- name: RestrictUser :one
+UPDATE users
+SET restricted_at = coalesce(restricted_at, now())
+WHERE username = $1
+RETURNING *;
+
+-- name: LiftUserRestriction :one
+UPDATE users
+SET restricted_at = NULL
+WHERE username = $1
+RETURNING *;
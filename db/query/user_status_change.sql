@@ -0,0 +1,10 @@
+-- name: CreateUserStatusChange :one
+INSERT INTO user_status_changes (username, previous_status, status, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListUserStatusChanges :many
+SELECT *
+FROM user_status_changes
+WHERE username = $1
+ORDER BY id DESC;
@@ -10,4 +10,10 @@ WHERE id = @id
   AND secret_code = @secret_code
   AND is_used = FALSE
   AND expired_at > now()
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: EraseVerifyEmails :exec
+UPDATE "verify_emails"
+SET email       = sqlc.arg(email),
+    secret_code = '',
+    is_used     = TRUE
+WHERE username = sqlc.arg(username);
@@ -7,11 +7,22 @@ import (
 )
 
 func (a Account) ToResponse() *pb.Account {
-	return &pb.Account{
+	response := &pb.Account{
 		Id:        a.ID,
 		Owner:     a.Owner,
 		Balance:   a.Balance,
 		Currency:  pb.Currency(pb.Currency_value[a.Currency]),
 		CreatedAt: timestamppb.New(a.CreatedAt),
+		Number:    a.Number,
 	}
+
+	if a.ClosedAt.Valid {
+		response.ClosedAt = timestamppb.New(a.ClosedAt.Time)
+	}
+
+	if a.FrozenAt.Valid {
+		response.FrozenAt = timestamppb.New(a.FrozenAt.Time)
+	}
+
+	return response
 }
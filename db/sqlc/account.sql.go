@@ -13,7 +13,9 @@ const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+  AND closed_at IS NULL
+  AND frozen_at IS NULL
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
 `
 
 type AddAccountBalanceParams struct {
@@ -30,24 +32,84 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
 	)
 	return i, err
 }
 
+const closeAccounts = `-- name: CloseAccounts :many
+UPDATE accounts
+SET closed_at = now()
+WHERE owner = $1
+  AND closed_at IS NULL
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
+`
+
+func (q *Queries) CloseAccounts(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, closeAccounts, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countOpenAccountsByOwner = `-- name: CountOpenAccountsByOwner :one
+SELECT count(*)
+FROM accounts
+WHERE owner = $1
+  AND closed_at IS NULL
+`
+
+func (q *Queries) CountOpenAccountsByOwner(ctx context.Context, owner string) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenAccountsByOwner, owner)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
-INSERT INTO accounts (owner, balance, currency)
-VALUES ($1, $2, $3)
-RETURNING id, owner, balance, currency, created_at
+INSERT INTO accounts (owner, balance, currency, number)
+VALUES ($1, $2, $3, $4)
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
 `
 
 type CreateAccountParams struct {
 	Owner    string `json:"owner"`
 	Balance  int64  `json:"balance"`
 	Currency string `json:"currency"`
+	Number   string `json:"number"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Number,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -55,6 +117,9 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
 	)
 	return i, err
 }
@@ -68,8 +133,46 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const freezeAccounts = `-- name: FreezeAccounts :many
+UPDATE accounts
+SET frozen_at = now()
+WHERE owner = $1
+  AND closed_at IS NULL
+  AND frozen_at IS NULL
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
+`
+
+func (q *Queries) FreezeAccounts(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, freezeAccounts, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
 FROM accounts
 WHERE id = $1
 LIMIT 1
@@ -84,12 +187,38 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
+	)
+	return i, err
+}
+
+const getAccountByNumber = `-- name: GetAccountByNumber :one
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
+FROM accounts
+WHERE number = $1
+LIMIT 1
+`
+
+func (q *Queries) GetAccountByNumber(ctx context.Context, number string) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByNumber, number)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
 FROM accounts
 WHERE id = $1
 LIMIT 1
@@ -105,12 +234,15 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
 FROM accounts
 WHERE owner = $1
 ORDER BY id
@@ -138,6 +270,119 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByOwner = `-- name: ListAccountsByOwner :many
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
+FROM accounts
+WHERE owner = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAccountsByOwner(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsByOwner, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByOwnerForUpdate = `-- name: ListAccountsByOwnerForUpdate :many
+SELECT id, owner, balance, currency, created_at, number, closed_at, frozen_at
+FROM accounts
+WHERE owner = $1
+ORDER BY id
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) ListAccountsByOwnerForUpdate(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsByOwnerForUpdate, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unfreezeAccounts = `-- name: UnfreezeAccounts :many
+UPDATE accounts
+SET frozen_at = NULL
+WHERE owner = $1
+  AND frozen_at IS NOT NULL
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
+`
+
+func (q *Queries) UnfreezeAccounts(ctx context.Context, owner string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, unfreezeAccounts, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Number,
+			&i.ClosedAt,
+			&i.FrozenAt,
 		); err != nil {
 			return nil, err
 		}
@@ -153,7 +398,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, number, closed_at, frozen_at
 `
 
 type UpdateAccountParams struct {
@@ -170,6 +415,9 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Number,
+		&i.ClosedAt,
+		&i.FrozenAt,
 	)
 	return i, err
 }
@@ -10,10 +10,23 @@ import (
 
 func createRandomAccount(t *testing.T) Account {
 	user := createRandomUser(t)
+
+	// holding accounts and sending money needs a verified identity
+	_, err := testStore.UpdateUserKyc(context.Background(), UpdateUserKycParams{
+		Username:  user.Username,
+		KycTier:   1,
+		KycStatus: util.KycStatusApproved,
+	})
+	require.NoError(t, err)
+
+	number, err := util.GenerateAccountNumber("GB", "SMPL")
+	require.NoError(t, err)
+
 	arg := CreateAccountParams{
 		Owner:    user.Username,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Number:   number,
 	}
 
 	account, err := testStore.CreateAccount(context.Background(), arg)
@@ -23,6 +36,7 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.Number, account.Number)
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
@@ -48,6 +62,18 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, createdAccount.CreatedAt, dbRecord.CreatedAt, time.Second)
 }
 
+func TestGetAccountByNumber(t *testing.T) {
+	createdAccount := createRandomAccount(t)
+	dbRecord, err := testStore.GetAccountByNumber(context.Background(), createdAccount.Number)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, dbRecord)
+
+	require.Equal(t, createdAccount.ID, dbRecord.ID)
+	require.Equal(t, createdAccount.Number, dbRecord.Number)
+	require.Equal(t, createdAccount.Owner, dbRecord.Owner)
+}
+
 func TestUpdateAccount(t *testing.T) {
 	createdAccount := createRandomAccount(t)
 
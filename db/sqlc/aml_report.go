@@ -0,0 +1,38 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (report AmlReport) ToResponse() *pb.AmlReport {
+	return &pb.AmlReport{
+		Id:          report.ID,
+		Kind:        report.Kind,
+		Username:    report.Username,
+		Currency:    report.Currency,
+		TotalAmount: report.TotalAmount,
+		TransferIds: report.TransferIds,
+		WindowStart: timestamppb.New(report.WindowStart),
+		WindowEnd:   timestamppb.New(report.WindowEnd),
+		CreatedAt:   timestamppb.New(report.CreatedAt),
+	}
+}
+
+// ToAmlResponse returns the transfer as it supports an AML report, with both accounts instead of the view of one.
+func (t Transfer) ToAmlResponse() *pb.AmlTransfer {
+	response := &pb.AmlTransfer{
+		Id:            t.ID,
+		FromAccountId: t.FromAccountID,
+		ToAccountId:   t.ToAccountID,
+		Amount:        t.Amount,
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+	}
+
+	if t.Description.Valid {
+		response.Description = &t.Description.String
+	}
+
+	return response
+}
@@ -0,0 +1,290 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: aml_report.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAmlReport = `-- name: CreateAmlReport :one
+INSERT INTO aml_reports (kind, username, currency, total_amount, transfer_ids, window_start, window_end)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, kind, username, currency, total_amount, transfer_ids, window_start, window_end, created_at
+`
+
+type CreateAmlReportParams struct {
+	Kind        string    `json:"kind"`
+	Username    string    `json:"username"`
+	Currency    string    `json:"currency"`
+	TotalAmount int64     `json:"total_amount"`
+	TransferIds []int64   `json:"transfer_ids"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+}
+
+func (q *Queries) CreateAmlReport(ctx context.Context, arg CreateAmlReportParams) (AmlReport, error) {
+	row := q.db.QueryRow(ctx, createAmlReport,
+		arg.Kind,
+		arg.Username,
+		arg.Currency,
+		arg.TotalAmount,
+		arg.TransferIds,
+		arg.WindowStart,
+		arg.WindowEnd,
+	)
+	var i AmlReport
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Username,
+		&i.Currency,
+		&i.TotalAmount,
+		&i.TransferIds,
+		&i.WindowStart,
+		&i.WindowEnd,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAmlReport = `-- name: GetAmlReport :one
+SELECT id, kind, username, currency, total_amount, transfer_ids, window_start, window_end, created_at
+FROM aml_reports
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetAmlReport(ctx context.Context, id int64) (AmlReport, error) {
+	row := q.db.QueryRow(ctx, getAmlReport, id)
+	var i AmlReport
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Username,
+		&i.Currency,
+		&i.TotalAmount,
+		&i.TransferIds,
+		&i.WindowStart,
+		&i.WindowEnd,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAmlScanWatermark = `-- name: GetAmlScanWatermark :one
+SELECT scanned_until
+FROM aml_scan_watermark
+LIMIT 1
+`
+
+func (q *Queries) GetAmlScanWatermark(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getAmlScanWatermark)
+	var scanned_until time.Time
+	err := row.Scan(&scanned_until)
+	return scanned_until, err
+}
+
+const listAmlReports = `-- name: ListAmlReports :many
+SELECT id, kind, username, currency, total_amount, transfer_ids, window_start, window_end, created_at
+FROM aml_reports
+WHERE ($1::varchar IS NULL OR kind = $1)
+  AND ($2::varchar IS NULL OR username = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::bigint IS NULL OR id < $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type ListAmlReportsParams struct {
+	Kind      pgtype.Text        `json:"kind"`
+	Username  pgtype.Text        `json:"username"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+	BeforeID  pgtype.Int8        `json:"before_id"`
+	PageSize  int32              `json:"page_size"`
+}
+
+// Lists the newest reports first.
+func (q *Queries) ListAmlReports(ctx context.Context, arg ListAmlReportsParams) ([]AmlReport, error) {
+	rows, err := q.db.Query(ctx, listAmlReports,
+		arg.Kind,
+		arg.Username,
+		arg.StartTime,
+		arg.EndTime,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AmlReport{}
+	for rows.Next() {
+		var i AmlReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Username,
+			&i.Currency,
+			&i.TotalAmount,
+			&i.TransferIds,
+			&i.WindowStart,
+			&i.WindowEnd,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStructuringCandidates = `-- name: ListStructuringCandidates :many
+SELECT a.owner,
+       a.currency,
+       array_agg(t.id ORDER BY t.id)::bigint[] AS transfer_ids,
+       sum(t.amount)::bigint                   AS total_amount,
+       min(t.created_at)::timestamptz          AS window_start,
+       max(t.created_at)::timestamptz          AS window_end
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts b ON b.id = t.to_account_id
+WHERE t.amount >= $1
+  AND t.amount < $2
+  AND t.created_at >= $3
+  AND a.owner <> b.owner
+  AND NOT EXISTS (SELECT 1
+                  FROM aml_reports r
+                  WHERE r.kind = 'structuring'
+                    AND r.transfer_ids @> ARRAY [t.id])
+GROUP BY a.owner, a.currency
+HAVING count(*) >= $4::bigint
+ORDER BY a.owner, a.currency
+`
+
+type ListStructuringCandidatesParams struct {
+	MinAmount    int64     `json:"min_amount"`
+	MaxAmount    int64     `json:"max_amount"`
+	Since        time.Time `json:"since"`
+	MinTransfers int64     `json:"min_transfers"`
+}
+
+type ListStructuringCandidatesRow struct {
+	Owner       string    `json:"owner"`
+	Currency    string    `json:"currency"`
+	TransferIds []int64   `json:"transfer_ids"`
+	TotalAmount int64     `json:"total_amount"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+}
+
+// Groups the transfers to other people with an amount in [min_amount, max_amount) that no structuring report covers
+// yet by sender and currency, returning the senders who made at least min_transfers of them.
+func (q *Queries) ListStructuringCandidates(ctx context.Context, arg ListStructuringCandidatesParams) ([]ListStructuringCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listStructuringCandidates,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Since,
+		arg.MinTransfers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStructuringCandidatesRow{}
+	for rows.Next() {
+		var i ListStructuringCandidatesRow
+		if err := rows.Scan(
+			&i.Owner,
+			&i.Currency,
+			&i.TransferIds,
+			&i.TotalAmount,
+			&i.WindowStart,
+			&i.WindowEnd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnreportedLargeTransfers = `-- name: ListUnreportedLargeTransfers :many
+SELECT t.id, t.amount, t.created_at, a.owner, a.currency
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts b ON b.id = t.to_account_id
+WHERE t.amount >= $1
+  AND t.created_at >= $2
+  AND a.owner <> b.owner
+  AND NOT EXISTS (SELECT 1
+                  FROM aml_reports r
+                  WHERE r.kind = 'large_transaction'
+                    AND r.transfer_ids @> ARRAY [t.id])
+ORDER BY t.id
+`
+
+type ListUnreportedLargeTransfersParams struct {
+	MinAmount int64     `json:"min_amount"`
+	Since     time.Time `json:"since"`
+}
+
+type ListUnreportedLargeTransfersRow struct {
+	ID        int64     `json:"id"`
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+	Owner     string    `json:"owner"`
+	Currency  string    `json:"currency"`
+}
+
+// Lists transfers to other people of at least the amount that no large transaction report covers yet.
+func (q *Queries) ListUnreportedLargeTransfers(ctx context.Context, arg ListUnreportedLargeTransfersParams) ([]ListUnreportedLargeTransfersRow, error) {
+	rows, err := q.db.Query(ctx, listUnreportedLargeTransfers, arg.MinAmount, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnreportedLargeTransfersRow{}
+	for rows.Next() {
+		var i ListUnreportedLargeTransfersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Owner,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAmlScanWatermark = `-- name: SetAmlScanWatermark :exec
+INSERT INTO aml_scan_watermark (scanned_until)
+VALUES ($1)
+ON CONFLICT (id) DO UPDATE SET scanned_until = greatest(aml_scan_watermark.scanned_until, excluded.scanned_until)
+`
+
+// Moves the watermark forward only, so a slow run finishing after a later one does not move it back.
+func (q *Queries) SetAmlScanWatermark(ctx context.Context, scannedUntil time.Time) error {
+	_, err := q.db.Exec(ctx, setAmlScanWatermark, scannedUntil)
+	return err
+}
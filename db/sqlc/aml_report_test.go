@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmlScanQueries(t *testing.T) {
+	from := createRandomAccount(t)
+	to := createRandomAccount(t)
+	since := time.Now().Add(-time.Minute)
+
+	transfer := func(fromAccount, toAccount Account, amount int64) Transfer {
+		created, err := testStore.CreateTransfer(context.Background(), CreateTransferParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+
+		return created
+	}
+
+	large := transfer(from, to, 1500000)
+	structured := []Transfer{transfer(from, to, 900000), transfer(from, to, 950000), transfer(from, to, 990000)}
+	// too small to count towards structuring
+	transfer(from, to, 100000)
+
+	largeTransfers := func() (ids []int64) {
+		rows, err := testStore.ListUnreportedLargeTransfers(context.Background(), ListUnreportedLargeTransfersParams{
+			MinAmount: 1000000,
+			Since:     since,
+		})
+		require.NoError(t, err)
+
+		for _, row := range rows {
+			if row.Owner == from.Owner {
+				require.Equal(t, from.Currency, row.Currency)
+				ids = append(ids, row.ID)
+			}
+		}
+
+		return ids
+	}
+
+	structuringCandidates := func() (candidates []ListStructuringCandidatesRow) {
+		rows, err := testStore.ListStructuringCandidates(context.Background(), ListStructuringCandidatesParams{
+			MinAmount:    800000,
+			MaxAmount:    1000000,
+			Since:        since,
+			MinTransfers: 3,
+		})
+		require.NoError(t, err)
+
+		for _, row := range rows {
+			if row.Owner == from.Owner {
+				candidates = append(candidates, row)
+			}
+		}
+
+		return candidates
+	}
+
+	require.Equal(t, []int64{large.ID}, largeTransfers())
+
+	candidates := structuringCandidates()
+	require.Len(t, candidates, 1)
+	require.Equal(t, []int64{structured[0].ID, structured[1].ID, structured[2].ID}, candidates[0].TransferIds)
+	require.Equal(t, int64(900000+950000+990000), candidates[0].TotalAmount)
+	require.WithinDuration(t, structured[0].CreatedAt, candidates[0].WindowStart, time.Millisecond)
+	require.WithinDuration(t, structured[2].CreatedAt, candidates[0].WindowEnd, time.Millisecond)
+
+	report, err := testStore.CreateAmlReport(context.Background(), CreateAmlReportParams{
+		Kind:        util.AmlReportLargeTransaction,
+		Username:    from.Owner,
+		Currency:    from.Currency,
+		TotalAmount: large.Amount,
+		TransferIds: []int64{large.ID},
+		WindowStart: large.CreatedAt,
+		WindowEnd:   large.CreatedAt,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateAmlReport(context.Background(), CreateAmlReportParams{
+		Kind:        util.AmlReportStructuring,
+		Username:    from.Owner,
+		Currency:    from.Currency,
+		TotalAmount: candidates[0].TotalAmount,
+		TransferIds: candidates[0].TransferIds,
+		WindowStart: candidates[0].WindowStart,
+		WindowEnd:   candidates[0].WindowEnd,
+	})
+	require.NoError(t, err)
+
+	// reported transfers are not reported again
+	require.Empty(t, largeTransfers())
+	require.Empty(t, structuringCandidates())
+
+	// transfers between accounts of the same owner are left out
+	currency := util.USD
+	if from.Currency == util.USD {
+		currency = util.EUR
+	}
+	number, err := util.GenerateAccountNumber("GB", "SMPL")
+	require.NoError(t, err)
+	own, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    from.Owner,
+		Currency: currency,
+		Number:   number,
+	})
+	require.NoError(t, err)
+
+	transfer(from, own, 2000000)
+	require.Empty(t, largeTransfers())
+
+	got, err := testStore.GetAmlReport(context.Background(), report.ID)
+	require.NoError(t, err)
+	require.Equal(t, report.TransferIds, got.TransferIds)
+
+	reports, err := testStore.ListAmlReports(context.Background(), ListAmlReportsParams{
+		Username: pgtype.Text{String: from.Owner, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	require.Equal(t, util.AmlReportStructuring, reports[0].Kind)
+
+	transfers, err := testStore.ListTransfersByIDs(context.Background(), candidates[0].TransferIds)
+	require.NoError(t, err)
+	require.Len(t, transfers, 3)
+}
+
+func TestAmlScanWatermark(t *testing.T) {
+	// later than any watermark set before, so that it moves
+	scannedUntil := time.Now().Add(time.Hour)
+
+	err := testStore.SetAmlScanWatermark(context.Background(), scannedUntil)
+	require.NoError(t, err)
+
+	watermark, err := testStore.GetAmlScanWatermark(context.Background())
+	require.NoError(t, err)
+	require.WithinDuration(t, scannedUntil, watermark, time.Millisecond)
+
+	// a slow scan finishing after a later one
+	err = testStore.SetAmlScanWatermark(context.Background(), scannedUntil.Add(-time.Minute))
+	require.NoError(t, err)
+
+	watermark, err = testStore.GetAmlScanWatermark(context.Background())
+	require.NoError(t, err)
+	require.WithinDuration(t, scannedUntil, watermark, time.Millisecond)
+}
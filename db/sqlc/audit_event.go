@@ -0,0 +1,47 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e AuditEvent) ToResponse() *pb.AuditEvent {
+	response := &pb.AuditEvent{
+		Id:         e.ID,
+		Role:       e.Role,
+		Method:     e.Method,
+		Resources:  e.Resources,
+		StatusCode: e.StatusCode,
+		ClientIp:   e.ClientIp,
+		UserAgent:  e.UserAgent,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+
+	if e.Username.Valid {
+		response.Username = &e.Username.String
+	}
+
+	if e.Impersonator.Valid {
+		response.Impersonator = &e.Impersonator.String
+	}
+
+	if e.ImpersonationID.Valid {
+		impersonationID := uuid.UUID(e.ImpersonationID.Bytes).String()
+		response.ImpersonationId = &impersonationID
+	}
+
+	if e.ClientID.Valid {
+		response.ClientId = &e.ClientID.String
+	}
+
+	request := &structpb.Struct{}
+	if err := protojson.Unmarshal(e.Request, request); err == nil {
+		response.Request = request
+	}
+
+	return response
+}
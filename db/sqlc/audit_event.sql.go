@@ -0,0 +1,167 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_event.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const allowAuditEventErasure = `-- name: AllowAuditEventErasure :exec
+SELECT set_config('simplebank.erasing_user', 'on', TRUE)
+`
+
+// Lets EraseAuditEvents change the append-only audit log until the end of the transaction.
+func (q *Queries) AllowAuditEventErasure(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, allowAuditEventErasure)
+	return err
+}
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO audit_events (username, impersonator, impersonation_id, role, client_id, method, resources, request,
+                          status_code, client_ip, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, username, impersonator, impersonation_id, method, status_code, created_at, role, client_id, resources, request, client_ip, user_agent
+`
+
+type CreateAuditEventParams struct {
+	Username        pgtype.Text `json:"username"`
+	Impersonator    pgtype.Text `json:"impersonator"`
+	ImpersonationID pgtype.UUID `json:"impersonation_id"`
+	Role            string      `json:"role"`
+	ClientID        pgtype.Text `json:"client_id"`
+	Method          string      `json:"method"`
+	Resources       []string    `json:"resources"`
+	Request         []byte      `json:"request"`
+	StatusCode      string      `json:"status_code"`
+	ClientIp        string      `json:"client_ip"`
+	UserAgent       string      `json:"user_agent"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	row := q.db.QueryRow(ctx, createAuditEvent,
+		arg.Username,
+		arg.Impersonator,
+		arg.ImpersonationID,
+		arg.Role,
+		arg.ClientID,
+		arg.Method,
+		arg.Resources,
+		arg.Request,
+		arg.StatusCode,
+		arg.ClientIp,
+		arg.UserAgent,
+	)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Impersonator,
+		&i.ImpersonationID,
+		&i.Method,
+		&i.StatusCode,
+		&i.CreatedAt,
+		&i.Role,
+		&i.ClientID,
+		&i.Resources,
+		&i.Request,
+		&i.ClientIp,
+		&i.UserAgent,
+	)
+	return i, err
+}
+
+const eraseAuditEvents = `-- name: EraseAuditEvents :exec
+UPDATE audit_events
+SET username     = CASE WHEN username = $1::varchar THEN $2::varchar ELSE username END,
+    impersonator = CASE WHEN impersonator = $1::varchar THEN $2::varchar ELSE impersonator END,
+    resources    = array_replace(resources, 'users/' || $1::varchar, 'users/' || $2::varchar),
+    request      = CASE
+                       WHEN username = $1::varchar OR request ->> 'username' = $1::varchar THEN '{}'::jsonb
+                       ELSE request END,
+    client_ip    = CASE WHEN username = $1::varchar THEN '' ELSE client_ip END,
+    user_agent   = CASE WHEN username = $1::varchar THEN '' ELSE user_agent END
+WHERE username = $1::varchar
+   OR impersonator = $1::varchar
+   OR request ->> 'username' = $1::varchar
+   OR 'users/' || $1::varchar = ANY (resources)
+`
+
+type EraseAuditEventsParams struct {
+	Username  string `json:"username"`
+	Pseudonym string `json:"pseudonym"`
+}
+
+// Replaces the user with the pseudonym in the events about them. What the user sent and where from is
+// removed, events of employees acting on the user keep their actor but not the request.
+func (q *Queries) EraseAuditEvents(ctx context.Context, arg EraseAuditEventsParams) error {
+	_, err := q.db.Exec(ctx, eraseAuditEvents, arg.Username, arg.Pseudonym)
+	return err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, username, impersonator, impersonation_id, method, status_code, created_at, role, client_id, resources, request, client_ip, user_agent
+FROM audit_events
+WHERE ($1::varchar IS NULL OR username = $1 OR impersonator = $1)
+  AND ($2::varchar IS NULL OR $2 = ANY (resources))
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::bigint IS NULL OR id < $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type ListAuditEventsParams struct {
+	Actor     pgtype.Text        `json:"actor"`
+	Resource  pgtype.Text        `json:"resource"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+	BeforeID  pgtype.Int8        `json:"before_id"`
+	PageSize  int32              `json:"page_size"`
+}
+
+// Lists the newest events first, the actor matches both the user and the impersonator of a call.
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.Actor,
+		arg.Resource,
+		arg.StartTime,
+		arg.EndTime,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Impersonator,
+			&i.ImpersonationID,
+			&i.Method,
+			&i.StatusCode,
+			&i.CreatedAt,
+			&i.Role,
+			&i.ClientID,
+			&i.Resources,
+			&i.Request,
+			&i.ClientIp,
+			&i.UserAgent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
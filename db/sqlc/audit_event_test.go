@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomAuditEvent(t *testing.T, username string, resources ...string) AuditEvent {
+	arg := CreateAuditEventParams{
+		Username:   pgtype.Text{String: username, Valid: true},
+		Role:       util.DepositorRole,
+		Method:     "/pb.Simplebank/CreateTransfer",
+		Resources:  resources,
+		Request:    []byte(`{"amount":"10"}`),
+		StatusCode: "OK",
+		ClientIp:   "203.0.113.7",
+		UserAgent:  "simplebank-test",
+	}
+
+	event, err := testStore.CreateAuditEvent(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, event.ID)
+	require.Equal(t, arg.Username, event.Username)
+	require.Equal(t, arg.Resources, event.Resources)
+	require.JSONEq(t, string(arg.Request), string(event.Request))
+	require.WithinDuration(t, time.Now(), event.CreatedAt, time.Second)
+
+	return event
+}
+
+func TestListAuditEvents(t *testing.T) {
+	username := util.RandomOwner()
+	resource := "accounts/" + util.RandomString(8)
+
+	first := createRandomAuditEvent(t, username, resource)
+	second := createRandomAuditEvent(t, username)
+	third := createRandomAuditEvent(t, username, resource, "transfers/1")
+
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: username, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{third.ID, second.ID, first.ID}, eventIDs(events))
+
+	events, err = testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Resource: pgtype.Text{String: resource, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{third.ID, first.ID}, eventIDs(events))
+
+	events, err = testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: username, Valid: true},
+		BeforeID: pgtype.Int8{Int64: third.ID, Valid: true},
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{second.ID}, eventIDs(events))
+
+	events, err = testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:     pgtype.Text{String: username, Valid: true},
+		StartTime: pgtype.Timestamptz{Time: time.Now().Add(time.Minute), Valid: true},
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestAuditEventsAreAppendOnly(t *testing.T) {
+	event := createRandomAuditEvent(t, util.RandomOwner())
+	store := testStore.(*SQLStore)
+
+	_, err := store.connPool.Exec(context.Background(), "UPDATE audit_events SET status_code = 'OK' WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "append-only")
+
+	_, err = store.connPool.Exec(context.Background(), "DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.ErrorContains(t, err, "append-only")
+}
+
+func eventIDs(events []AuditEvent) []int64 {
+	ids := make([]int64, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	return ids
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"simplebank/pb"
+	"simplebank/util"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const splitMethodPrefix = "SPLIT_METHOD_"
+
+// ToResponse includes a participant for every payment request linked to the split. The creator's own share,
+// if any, is listed first and is always settled.
+func (b BillSplit) ToResponse(paymentRequests []PaymentRequest) *pb.BillSplit {
+	response := &pb.BillSplit{
+		Id:          b.ID,
+		Creator:     b.Creator,
+		Amount:      b.Amount,
+		Currency:    pb.Currency(pb.Currency_value[b.Currency]),
+		SplitMethod: pb.SplitMethod(pb.SplitMethod_value[splitMethodPrefix+strings.ToUpper(b.SplitMethod)]),
+		Settled:     true,
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+	}
+
+	if b.Description.Valid {
+		response.Description = &b.Description.String
+	}
+
+	if b.RemindedAt.Valid {
+		response.RemindedAt = timestamppb.New(b.RemindedAt.Time)
+	}
+
+	if b.CreatorShare > 0 {
+		response.Participants = append(response.Participants, &pb.BillSplitParticipant{
+			Username: b.Creator,
+			Amount:   b.CreatorShare,
+			Status:   PaymentRequestStatusToResponse(util.PaymentRequestAccepted),
+		})
+	}
+
+	for _, paymentRequest := range paymentRequests {
+		if paymentRequest.BillSplitID.Int64 != b.ID {
+			continue
+		}
+
+		response.Participants = append(response.Participants, &pb.BillSplitParticipant{
+			Username:         paymentRequest.Payer.String,
+			Amount:           paymentRequest.Amount,
+			Status:           PaymentRequestStatusToResponse(paymentRequest.EffectiveStatus()),
+			PaymentRequestId: &paymentRequest.ID,
+		})
+
+		if paymentRequest.Status != util.PaymentRequestAccepted {
+			response.Settled = false
+		}
+	}
+
+	return response
+}
+
+// SplitMethodFromRequest maps an API split method to its stored representation.
+func SplitMethodFromRequest(method pb.SplitMethod) string {
+	return strings.ToLower(strings.TrimPrefix(method.String(), splitMethodPrefix))
+}
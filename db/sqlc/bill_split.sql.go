@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: bill_split.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createBillSplit = `-- name: CreateBillSplit :one
+INSERT INTO bill_splits (creator, to_account_id, amount, currency, description, split_method, creator_share)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, creator, to_account_id, amount, currency, description, split_method, creator_share, created_at, reminded_at
+`
+
+type CreateBillSplitParams struct {
+	Creator      string      `json:"creator"`
+	ToAccountID  int64       `json:"to_account_id"`
+	Amount       int64       `json:"amount"`
+	Currency     string      `json:"currency"`
+	Description  pgtype.Text `json:"description"`
+	SplitMethod  string      `json:"split_method"`
+	CreatorShare int64       `json:"creator_share"`
+}
+
+func (q *Queries) CreateBillSplit(ctx context.Context, arg CreateBillSplitParams) (BillSplit, error) {
+	row := q.db.QueryRow(ctx, createBillSplit,
+		arg.Creator,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.SplitMethod,
+		arg.CreatorShare,
+	)
+	var i BillSplit
+	err := row.Scan(
+		&i.ID,
+		&i.Creator,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.SplitMethod,
+		&i.CreatorShare,
+		&i.CreatedAt,
+		&i.RemindedAt,
+	)
+	return i, err
+}
+
+const getBillSplit = `-- name: GetBillSplit :one
+SELECT id, creator, to_account_id, amount, currency, description, split_method, creator_share, created_at, reminded_at
+FROM bill_splits
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetBillSplit(ctx context.Context, id int64) (BillSplit, error) {
+	row := q.db.QueryRow(ctx, getBillSplit, id)
+	var i BillSplit
+	err := row.Scan(
+		&i.ID,
+		&i.Creator,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.SplitMethod,
+		&i.CreatorShare,
+		&i.CreatedAt,
+		&i.RemindedAt,
+	)
+	return i, err
+}
+
+const listBillSplits = `-- name: ListBillSplits :many
+SELECT id, creator, to_account_id, amount, currency, description, split_method, creator_share, created_at, reminded_at
+FROM bill_splits
+WHERE creator = $1
+  AND ($2::bigint IS NULL OR id < $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListBillSplitsParams struct {
+	Creator  string      `json:"creator"`
+	BeforeID pgtype.Int8 `json:"before_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+func (q *Queries) ListBillSplits(ctx context.Context, arg ListBillSplitsParams) ([]BillSplit, error) {
+	rows, err := q.db.Query(ctx, listBillSplits, arg.Creator, arg.BeforeID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BillSplit{}
+	for rows.Next() {
+		var i BillSplit
+		if err := rows.Scan(
+			&i.ID,
+			&i.Creator,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.SplitMethod,
+			&i.CreatorShare,
+			&i.CreatedAt,
+			&i.RemindedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markBillSplitReminded = `-- name: MarkBillSplitReminded :one
+UPDATE bill_splits
+SET reminded_at = now()
+WHERE id = $1
+  AND (reminded_at IS NULL OR reminded_at <= $2::timestamptz)
+RETURNING id, creator, to_account_id, amount, currency, description, split_method, creator_share, created_at, reminded_at
+`
+
+type MarkBillSplitRemindedParams struct {
+	ID             int64     `json:"id"`
+	RemindedBefore time.Time `json:"reminded_before"`
+}
+
+func (q *Queries) MarkBillSplitReminded(ctx context.Context, arg MarkBillSplitRemindedParams) (BillSplit, error) {
+	row := q.db.QueryRow(ctx, markBillSplitReminded, arg.ID, arg.RemindedBefore)
+	var i BillSplit
+	err := row.Scan(
+		&i.ID,
+		&i.Creator,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.SplitMethod,
+		&i.CreatorShare,
+		&i.CreatedAt,
+		&i.RemindedAt,
+	)
+	return i, err
+}
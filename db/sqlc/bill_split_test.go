@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestCreateBillSplitTx(t *testing.T) {
+	toAccount := createRandomAccount(t)
+	participant1 := createRandomUser(t)
+	participant2 := createRandomUser(t)
+
+	paymentRequest := func(payer string, amount int64) CreatePaymentRequestParams {
+		return CreatePaymentRequestParams{
+			Requester:   toAccount.Owner,
+			Payer:       pgtype.Text{String: payer, Valid: true},
+			ToAccountID: toAccount.ID,
+			Amount:      amount,
+			Currency:    toAccount.Currency,
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	}
+
+	result, err := testStore.CreateBillSplitTx(context.Background(), CreateBillSplitTxParams{
+		CreateBillSplitParams: CreateBillSplitParams{
+			Creator:      toAccount.Owner,
+			ToAccountID:  toAccount.ID,
+			Amount:       300,
+			Currency:     toAccount.Currency,
+			SplitMethod:  util.SplitEven,
+			CreatorShare: 100,
+		},
+		PaymentRequests: []CreatePaymentRequestParams{
+			paymentRequest(participant1.Username, 100),
+			paymentRequest(participant2.Username, 100),
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.BillSplit.ID)
+	require.Equal(t, int64(100), result.BillSplit.CreatorShare)
+	require.Len(t, result.PaymentRequests, 2)
+
+	for _, paymentRequest := range result.PaymentRequests {
+		require.Equal(t, result.BillSplit.ID, paymentRequest.BillSplitID.Int64)
+	}
+
+	paymentRequests, err := testStore.ListBillSplitPaymentRequests(context.Background(), []int64{result.BillSplit.ID})
+	require.NoError(t, err)
+	require.Equal(t, result.PaymentRequests, paymentRequests)
+
+	// Reminders are throttled
+	_, err = testStore.MarkBillSplitReminded(context.Background(), MarkBillSplitRemindedParams{
+		ID:             result.BillSplit.ID,
+		RemindedBefore: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.MarkBillSplitReminded(context.Background(), MarkBillSplitRemindedParams{
+		ID:             result.BillSplit.ID,
+		RemindedBefore: time.Now().Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"simplebank/pb"
+	"simplebank/util"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const spendingCategoryPrefix = "SPENDING_CATEGORY_"
+
+func (r CategoryRule) ToResponse() *pb.CategoryRule {
+	response := &pb.CategoryRule{
+		Id:        r.ID,
+		Category:  SpendingCategoryToResponse(r.Category),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+
+	if r.Keyword.Valid {
+		response.Keyword = &r.Keyword.String
+	}
+
+	if r.CounterpartyAccountID.Valid {
+		response.CounterpartyAccountId = &r.CounterpartyAccountID.Int64
+	}
+
+	return response
+}
+
+// SpendingCategoryToResponse maps a stored spending category to its API representation.
+// Transfers made before categorization have no category and are reported as other.
+func SpendingCategoryToResponse(category string) pb.SpendingCategory {
+	if category == "" {
+		category = util.CategoryOther
+	}
+
+	return pb.SpendingCategory(pb.SpendingCategory_value[spendingCategoryPrefix+strings.ToUpper(category)])
+}
+
+// SpendingCategoryFromRequest maps an API spending category to its stored representation.
+func SpendingCategoryFromRequest(category pb.SpendingCategory) string {
+	return strings.ToLower(strings.TrimPrefix(category.String(), spendingCategoryPrefix))
+}
+
+// categorize assigns a spending category to the transfer using the category rules of the sender.
+func categorize(ctx context.Context, q *Queries, arg TransferTxParams) (string, error) {
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return "", err
+	}
+
+	rules, err := q.ListCategoryRules(ctx, fromAccount.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	categoryRules := make([]util.CategoryRule, len(rules))
+	for i, rule := range rules {
+		categoryRules[i] = util.CategoryRule{
+			Keyword:               rule.Keyword.String,
+			CounterpartyAccountID: rule.CounterpartyAccountID.Int64,
+			Category:              rule.Category,
+		}
+	}
+
+	return util.Categorize(arg.Description, arg.ToAccountID, categoryRules), nil
+}
@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: category_rule.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCategoryRule = `-- name: CreateCategoryRule :one
+INSERT INTO category_rules (owner, keyword, counterparty_account_id, category)
+VALUES ($1, $2, $3, $4)
+RETURNING id, owner, keyword, counterparty_account_id, category, created_at
+`
+
+type CreateCategoryRuleParams struct {
+	Owner                 string      `json:"owner"`
+	Keyword               pgtype.Text `json:"keyword"`
+	CounterpartyAccountID pgtype.Int8 `json:"counterparty_account_id"`
+	Category              string      `json:"category"`
+}
+
+func (q *Queries) CreateCategoryRule(ctx context.Context, arg CreateCategoryRuleParams) (CategoryRule, error) {
+	row := q.db.QueryRow(ctx, createCategoryRule,
+		arg.Owner,
+		arg.Keyword,
+		arg.CounterpartyAccountID,
+		arg.Category,
+	)
+	var i CategoryRule
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Keyword,
+		&i.CounterpartyAccountID,
+		&i.Category,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteCategoryRule = `-- name: DeleteCategoryRule :exec
+DELETE
+FROM category_rules
+WHERE id = $1
+`
+
+func (q *Queries) DeleteCategoryRule(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteCategoryRule, id)
+	return err
+}
+
+const getCategoryRule = `-- name: GetCategoryRule :one
+SELECT id, owner, keyword, counterparty_account_id, category, created_at
+FROM category_rules
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetCategoryRule(ctx context.Context, id int64) (CategoryRule, error) {
+	row := q.db.QueryRow(ctx, getCategoryRule, id)
+	var i CategoryRule
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Keyword,
+		&i.CounterpartyAccountID,
+		&i.Category,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCategoryRules = `-- name: ListCategoryRules :many
+SELECT id, owner, keyword, counterparty_account_id, category, created_at
+FROM category_rules
+WHERE owner = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListCategoryRules(ctx context.Context, owner string) ([]CategoryRule, error) {
+	rows, err := q.db.Query(ctx, listCategoryRules, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CategoryRule{}
+	for rows.Next() {
+		var i CategoryRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Keyword,
+			&i.CounterpartyAccountID,
+			&i.Category,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestTransferTxCategory(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	// default rules apply without any user rules
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+		Description:   "Tesco weekly shop",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CategoryGroceries, result.Transfer.Category.String)
+
+	rule, err := testStore.CreateCategoryRule(context.Background(), CreateCategoryRuleParams{
+		Owner:                 account1.Owner,
+		CounterpartyAccountID: pgtype.Int8{Int64: account2.ID, Valid: true},
+		Category:              util.CategoryHousing,
+	})
+	require.NoError(t, err)
+
+	// counterparty rules take precedence over default keywords
+	result, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+		Description:   "Tesco weekly shop",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CategoryHousing, result.Transfer.Category.String)
+
+	// explicit categories are kept
+	result, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+		Category:      util.CategoryDining,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CategoryDining, result.Transfer.Category.String)
+
+	err = testStore.DeleteCategoryRule(context.Background(), rule.ID)
+	require.NoError(t, err)
+
+	rules, err := testStore.ListCategoryRules(context.Background(), account1.Owner)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+}
+
+func TestGetSpendingByCategory(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	for _, description := range []string{"Lidl", "Aldi", "Cinema"} {
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        10,
+			Description:   description,
+		})
+		require.NoError(t, err)
+	}
+
+	arg := GetSpendingByCategoryParams{
+		Owner:     account1.Owner,
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now().Add(time.Hour),
+	}
+
+	rows, err := testStore.GetSpendingByCategory(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	require.Equal(t, util.CategoryGroceries, rows[0].Category)
+	require.Equal(t, account1.Currency, rows[0].Currency)
+	require.Equal(t, int64(20), rows[0].Amount)
+	require.Equal(t, int64(2), rows[0].Count)
+
+	require.Equal(t, util.CategoryEntertainment, rows[1].Category)
+	require.Equal(t, int64(10), rows[1].Amount)
+
+	// the recipient has no spending
+	arg.Owner = account2.Owner
+	rows, err = testStore.GetSpendingByCategory(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
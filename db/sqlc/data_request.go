@@ -0,0 +1,22 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (r DataRequest) ToResponse() *pb.DataRequest {
+	response := &pb.DataRequest{
+		Id:        r.ID,
+		Username:  r.Username,
+		Kind:      r.Kind,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+
+	if r.CompletedAt.Valid {
+		response.CompletedAt = timestamppb.New(r.CompletedAt.Time)
+	}
+
+	return response
+}
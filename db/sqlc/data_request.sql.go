@@ -0,0 +1,99 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: data_request.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const completeDataRequest = `-- name: CompleteDataRequest :one
+UPDATE data_requests
+SET completed_at = now()
+WHERE id = $1
+RETURNING id, username, kind, created_at, completed_at
+`
+
+func (q *Queries) CompleteDataRequest(ctx context.Context, id int64) (DataRequest, error) {
+	row := q.db.QueryRow(ctx, completeDataRequest, id)
+	var i DataRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createDataErasureRequest = `-- name: CreateDataErasureRequest :one
+INSERT INTO data_requests (username, kind, completed_at)
+VALUES ($1, 'erasure', now())
+RETURNING id, username, kind, created_at, completed_at
+`
+
+func (q *Queries) CreateDataErasureRequest(ctx context.Context, username string) (DataRequest, error) {
+	row := q.db.QueryRow(ctx, createDataErasureRequest, username)
+	var i DataRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const createDataExportRequest = `-- name: CreateDataExportRequest :one
+INSERT INTO data_requests (username, kind)
+SELECT $1, 'export'
+WHERE NOT EXISTS (SELECT 1
+                  FROM data_requests
+                  WHERE username = $1
+                    AND kind = 'export'
+                    AND created_at > $2::timestamptz)
+RETURNING id, username, kind, created_at, completed_at
+`
+
+type CreateDataExportRequestParams struct {
+	Username     string    `json:"username"`
+	CreatedAfter time.Time `json:"created_after"`
+}
+
+func (q *Queries) CreateDataExportRequest(ctx context.Context, arg CreateDataExportRequestParams) (DataRequest, error) {
+	row := q.db.QueryRow(ctx, createDataExportRequest, arg.Username, arg.CreatedAfter)
+	var i DataRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getDataRequest = `-- name: GetDataRequest :one
+SELECT id, username, kind, created_at, completed_at
+FROM data_requests
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetDataRequest(ctx context.Context, id int64) (DataRequest, error) {
+	row := q.db.QueryRow(ctx, getDataRequest, id)
+	var i DataRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
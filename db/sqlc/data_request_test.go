@@ -0,0 +1,195 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"simplebank/util"
+	"simplebank/val"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDataExportRequest(t *testing.T) {
+	user := createRandomUser(t)
+
+	dataRequest, err := testStore.CreateDataExportRequest(context.Background(), CreateDataExportRequestParams{
+		Username:     user.Username,
+		CreatedAfter: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DataRequestExport, dataRequest.Kind)
+	require.False(t, dataRequest.CompletedAt.Valid)
+
+	// a second export within the interval is refused
+	_, err = testStore.CreateDataExportRequest(context.Background(), CreateDataExportRequestParams{
+		Username:     user.Username,
+		CreatedAfter: time.Now().Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	completed, err := testStore.CompleteDataRequest(context.Background(), dataRequest.ID)
+	require.NoError(t, err)
+	require.True(t, completed.CompletedAt.Valid)
+}
+
+func TestEraseUserTx(t *testing.T) {
+	account := createRandomAccount(t)
+	other := createRandomAccount(t)
+	transfer := createRandomTransfer(t, account, other)
+	createRandomSession(t, account.Owner)
+
+	user, err := testStore.GetUser(context.Background(), account.Owner)
+	require.NoError(t, err)
+
+	clientIP := "198.51.100." + util.RandomString(6)
+	createRandomLoginAttempt(t, account.Owner, clientIP, "")
+
+	ownEvent := createRandomAuditEvent(t, account.Owner, "users/"+account.Owner)
+	bankerEvent := createRandomAuditEvent(t, util.RandomOwner(), "users/"+account.Owner, "accounts/1")
+
+	_, err = testStore.CreateKycDocument(context.Background(), CreateKycDocumentParams{
+		Username:    account.Owner,
+		Kind:        util.KycDocumentIdentity,
+		BlobKey:     "kyc/" + account.Owner + "/" + util.RandomString(8),
+		ContentType: "image/png",
+		Size:        100,
+		Sha256:      util.RandomString(64),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateScreeningCase(context.Background(), CreateScreeningCaseParams{
+		Username:     account.Owner,
+		Trigger:      util.ScreeningTriggerSignup,
+		ScreenedName: user.FullName,
+		EntryID:      util.RandomString(8),
+		EntryName:    util.RandomString(12),
+		Programs:     []string{"SDGT"},
+		Score:        0.95,
+	})
+	require.NoError(t, err)
+
+	verifyEmail, err := testStore.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   account.Owner,
+		Email:      util.RandomEmail(),
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	// the account still holds money
+	_, err = testStore.EraseUserTx(context.Background(), EraseUserTxParams{Username: account.Owner})
+	require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+	_, err = testStore.UpdateAccount(context.Background(), UpdateAccountParams{ID: account.ID, Balance: 0})
+	require.NoError(t, err)
+
+	result, err := testStore.EraseUserTx(context.Background(), EraseUserTxParams{Username: account.Owner})
+	require.NoError(t, err)
+	require.Equal(t, util.DataRequestErasure, result.DataRequest.Kind)
+	require.True(t, result.DataRequest.CompletedAt.Valid)
+	require.Len(t, result.ClosedAccounts, 1)
+	require.True(t, result.ClosedAccounts[0].ClosedAt.Valid)
+
+	pseudonym := result.User.Username
+	require.NotEqual(t, account.Owner, pseudonym)
+	require.Error(t, val.ValidateUsername(pseudonym))
+	require.Equal(t, pseudonym, result.DataRequest.Username)
+	require.Empty(t, result.User.FullName)
+	require.Empty(t, result.User.HashedPassword)
+	require.Contains(t, result.User.Email, "@simplebank.invalid")
+	require.Equal(t, util.UserStatusDeactivated, result.User.Status)
+	require.True(t, result.User.ErasedAt.Valid)
+	require.Len(t, result.KycBlobKeys, 1)
+
+	// nothing identifying the user is left anywhere
+	for _, identifier := range []string{account.Owner, user.Email, user.FullName, clientIP} {
+		require.Empty(t, tablesContaining(t, identifier), identifier)
+	}
+
+	storedAccount, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, pseudonym, storedAccount.Owner)
+
+	events, err := testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Actor:    pgtype.Text{String: pseudonym, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, ownEvent.ID, events[0].ID)
+	require.Equal(t, []string{"users/" + pseudonym}, events[0].Resources)
+	require.Empty(t, events[0].ClientIp)
+
+	events, err = testStore.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		Resource: pgtype.Text{String: "users/" + pseudonym, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, bankerEvent.ID, events[0].ID)
+	require.Equal(t, bankerEvent.Username, events[0].Username)
+
+	// the audit log is only open to changes while a user is erased
+	_, err = testStore.(*SQLStore).connPool.Exec(context.Background(), "UPDATE audit_events SET client_ip = '' WHERE id = $1", bankerEvent.ID)
+	require.Error(t, err)
+
+	sessions, err := testStore.ListSessionsByUsername(context.Background(), pseudonym)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].IsBlocked)
+	require.Empty(t, sessions[0].ClientIp)
+	require.Empty(t, sessions[0].UserAgent)
+
+	// the screening is kept, without the name that was screened
+	var screenedName string
+	err = testStore.(*SQLStore).connPool.QueryRow(context.Background(), "SELECT screened_name FROM screening_cases WHERE username = $1", pseudonym).Scan(&screenedName)
+	require.NoError(t, err)
+	require.NotEqual(t, user.FullName, screenedName)
+
+	var email string
+	err = testStore.(*SQLStore).connPool.QueryRow(context.Background(), "SELECT email FROM verify_emails WHERE id = $1", verifyEmail.ID).Scan(&email)
+	require.NoError(t, err)
+	require.Equal(t, result.User.Email, email)
+
+	// the ledger is kept, but no more money can move through the closed account
+	storedTransfer, err := testStore.GetTransfer(context.Background(), transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, transfer.Amount, storedTransfer.Amount)
+
+	_, err = testStore.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: account.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+// tablesContaining lists the tables with a column holding the value, on its own or quoted in an array or JSON.
+func tablesContaining(t *testing.T, value string) []string {
+	pool := testStore.(*SQLStore).connPool
+
+	rows, err := pool.Query(context.Background(),
+		"SELECT table_name FROM information_schema.tables WHERE table_schema = 'public' AND table_type = 'BASE TABLE'")
+	require.NoError(t, err)
+
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	require.NoError(t, err)
+
+	var found []string
+	for _, table := range tables {
+		query := fmt.Sprintf(`SELECT EXISTS (SELECT 1
+                                  FROM %s t, jsonb_each_text(to_jsonb(t)) c
+                                  WHERE c.value = $1
+                                     OR strpos(c.value, to_json($1::text)::text) > 0
+                                     OR strpos(c.value, '/' || $1 || '"') > 0)`, pgx.Identifier{table}.Sanitize())
+
+		var exists bool
+		err = pool.QueryRow(context.Background(), query, value).Scan(&exists)
+		require.NoError(t, err)
+
+		if exists {
+			found = append(found, table)
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"simplebank/pb"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const directDebitStatusPrefix = "DIRECT_DEBIT_STATUS_"
+
+func (d DirectDebit) ToResponse() *pb.DirectDebit {
+	response := &pb.DirectDebit{
+		Id:          d.ID,
+		MandateId:   d.MandateID,
+		TransferId:  d.TransferID,
+		Amount:      d.Amount,
+		Status:      pb.DirectDebitStatus(pb.DirectDebitStatus_value[directDebitStatusPrefix+strings.ToUpper(d.Status)]),
+		CollectedAt: timestamppb.New(d.CollectedAt),
+	}
+
+	if d.ChargebackTransferID.Valid {
+		response.ChargebackTransferId = &d.ChargebackTransferID.Int64
+	}
+
+	if d.ChargedBackAt.Valid {
+		response.ChargedBackAt = timestamppb.New(d.ChargedBackAt.Time)
+	}
+
+	return response
+}
@@ -0,0 +1,161 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: direct_debit.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const chargebackDirectDebit = `-- name: ChargebackDirectDebit :one
+UPDATE direct_debits
+SET status                 = 'charged_back',
+    chargeback_transfer_id = $2,
+    charged_back_at        = now()
+WHERE id = $1
+RETURNING id, mandate_id, transfer_id, amount, status, chargeback_transfer_id, collected_at, charged_back_at
+`
+
+type ChargebackDirectDebitParams struct {
+	ID                   int64       `json:"id"`
+	ChargebackTransferID pgtype.Int8 `json:"chargeback_transfer_id"`
+}
+
+func (q *Queries) ChargebackDirectDebit(ctx context.Context, arg ChargebackDirectDebitParams) (DirectDebit, error) {
+	row := q.db.QueryRow(ctx, chargebackDirectDebit, arg.ID, arg.ChargebackTransferID)
+	var i DirectDebit
+	err := row.Scan(
+		&i.ID,
+		&i.MandateID,
+		&i.TransferID,
+		&i.Amount,
+		&i.Status,
+		&i.ChargebackTransferID,
+		&i.CollectedAt,
+		&i.ChargedBackAt,
+	)
+	return i, err
+}
+
+const createDirectDebit = `-- name: CreateDirectDebit :one
+INSERT INTO direct_debits (mandate_id, transfer_id, amount)
+VALUES ($1, $2, $3)
+RETURNING id, mandate_id, transfer_id, amount, status, chargeback_transfer_id, collected_at, charged_back_at
+`
+
+type CreateDirectDebitParams struct {
+	MandateID  int64 `json:"mandate_id"`
+	TransferID int64 `json:"transfer_id"`
+	Amount     int64 `json:"amount"`
+}
+
+func (q *Queries) CreateDirectDebit(ctx context.Context, arg CreateDirectDebitParams) (DirectDebit, error) {
+	row := q.db.QueryRow(ctx, createDirectDebit, arg.MandateID, arg.TransferID, arg.Amount)
+	var i DirectDebit
+	err := row.Scan(
+		&i.ID,
+		&i.MandateID,
+		&i.TransferID,
+		&i.Amount,
+		&i.Status,
+		&i.ChargebackTransferID,
+		&i.CollectedAt,
+		&i.ChargedBackAt,
+	)
+	return i, err
+}
+
+const getDirectDebit = `-- name: GetDirectDebit :one
+SELECT id, mandate_id, transfer_id, amount, status, chargeback_transfer_id, collected_at, charged_back_at
+FROM direct_debits
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetDirectDebit(ctx context.Context, id int64) (DirectDebit, error) {
+	row := q.db.QueryRow(ctx, getDirectDebit, id)
+	var i DirectDebit
+	err := row.Scan(
+		&i.ID,
+		&i.MandateID,
+		&i.TransferID,
+		&i.Amount,
+		&i.Status,
+		&i.ChargebackTransferID,
+		&i.CollectedAt,
+		&i.ChargedBackAt,
+	)
+	return i, err
+}
+
+const getDirectDebitForUpdate = `-- name: GetDirectDebitForUpdate :one
+SELECT id, mandate_id, transfer_id, amount, status, chargeback_transfer_id, collected_at, charged_back_at
+FROM direct_debits
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetDirectDebitForUpdate(ctx context.Context, id int64) (DirectDebit, error) {
+	row := q.db.QueryRow(ctx, getDirectDebitForUpdate, id)
+	var i DirectDebit
+	err := row.Scan(
+		&i.ID,
+		&i.MandateID,
+		&i.TransferID,
+		&i.Amount,
+		&i.Status,
+		&i.ChargebackTransferID,
+		&i.CollectedAt,
+		&i.ChargedBackAt,
+	)
+	return i, err
+}
+
+const listDirectDebits = `-- name: ListDirectDebits :many
+SELECT id, mandate_id, transfer_id, amount, status, chargeback_transfer_id, collected_at, charged_back_at
+FROM direct_debits
+WHERE mandate_id = $1
+  AND ($2::bigint IS NULL OR id < $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListDirectDebitsParams struct {
+	MandateID int64       `json:"mandate_id"`
+	BeforeID  pgtype.Int8 `json:"before_id"`
+	PageSize  int32       `json:"page_size"`
+}
+
+func (q *Queries) ListDirectDebits(ctx context.Context, arg ListDirectDebitsParams) ([]DirectDebit, error) {
+	rows, err := q.db.Query(ctx, listDirectDebits, arg.MandateID, arg.BeforeID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DirectDebit{}
+	for rows.Next() {
+		var i DirectDebit
+		if err := rows.Scan(
+			&i.ID,
+			&i.MandateID,
+			&i.TransferID,
+			&i.Amount,
+			&i.Status,
+			&i.ChargebackTransferID,
+			&i.CollectedAt,
+			&i.ChargedBackAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
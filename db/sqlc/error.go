@@ -12,7 +12,26 @@ const (
 	UniqueViolation     = "23505"
 )
 
-var ErrRecordNotFound = pgx.ErrNoRows
+// AccountNumberConstraint is the unique index on account numbers, hit when a generated number is already taken.
+const AccountNumberConstraint = "accounts_number_idx"
+
+var (
+	ErrRecordNotFound           = pgx.ErrNoRows
+	ErrPaymentRequestNotPending = errors.New("payment request is not pending")
+	ErrMandateNotActive         = errors.New("mandate is not active")
+	ErrMandateAmountExceeded    = errors.New("amount exceeds the mandate limit")
+	ErrCollectionTooSoon        = errors.New("mandate has already been collected in this period")
+	ErrAlreadyChargedBack       = errors.New("direct debit has already been charged back")
+	ErrChargebackWindowClosed   = errors.New("chargeback window has closed")
+	ErrAccountNotEmpty          = errors.New("account balance is not zero")
+	ErrKycDocumentNotPending    = errors.New("document has already been reviewed")
+	ErrUserStatusUnchanged      = errors.New("user already has the status")
+	ErrFraudCaseNotOpen         = errors.New("fraud case has already been resolved")
+	ErrScreeningCaseNotOpen     = errors.New("screening case has already been resolved")
+	ErrAccountClosed            = errors.New("account is closed")
+	ErrAccountFrozen            = errors.New("account is frozen")
+	ErrKycLimitExceeded         = errors.New("transfer exceeds the KYC limits")
+)
 
 // ErrorCode extracts and returns the error code from a PostgreSQL error, or an empty string if the error type does not match.
 func ErrorCode(err error) string {
@@ -24,3 +43,14 @@ func ErrorCode(err error) string {
 
 	return ""
 }
+
+// ConstraintName returns the name of the constraint a PostgreSQL error violated, or an empty string if there is none.
+func ConstraintName(err error) string {
+	var pgErr *pgconn.PgError
+
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+
+	return ""
+}
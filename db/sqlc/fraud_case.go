@@ -0,0 +1,46 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (fraudCase FraudCase) ToResponse() *pb.FraudCase {
+	response := &pb.FraudCase{
+		Id:            fraudCase.ID,
+		Username:      fraudCase.Username,
+		FromAccountId: fraudCase.FromAccountID,
+		ToAccountId:   fraudCase.ToAccountID,
+		Amount:        fraudCase.Amount,
+		Description:   fraudCase.Description,
+		Rule:          fraudCase.Rule,
+		Status:        fraudCase.Status,
+		CreatedAt:     timestamppb.New(fraudCase.CreatedAt),
+	}
+
+	features := &structpb.Struct{}
+	if err := protojson.Unmarshal(fraudCase.Features, features); err == nil {
+		response.Features = features
+	}
+
+	if fraudCase.ResolvedBy.Valid {
+		response.ResolvedBy = &fraudCase.ResolvedBy.String
+	}
+
+	if fraudCase.ResolutionNote.Valid {
+		response.ResolutionNote = &fraudCase.ResolutionNote.String
+	}
+
+	if fraudCase.ResolvedAt.Valid {
+		response.ResolvedAt = timestamppb.New(fraudCase.ResolvedAt.Time)
+	}
+
+	if fraudCase.TransferID.Valid {
+		response.TransferId = &fraudCase.TransferID.Int64
+	}
+
+	return response
+}
@@ -0,0 +1,215 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: fraud_case.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createFraudCase = `-- name: CreateFraudCase :one
+INSERT INTO fraud_cases (username, from_account_id, to_account_id, amount, description, rule, features)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, username, from_account_id, to_account_id, amount, description, rule, features, status, resolved_by, resolution_note, resolved_at, transfer_id, created_at
+`
+
+type CreateFraudCaseParams struct {
+	Username      string `json:"username"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Description   string `json:"description"`
+	Rule          string `json:"rule"`
+	Features      []byte `json:"features"`
+}
+
+func (q *Queries) CreateFraudCase(ctx context.Context, arg CreateFraudCaseParams) (FraudCase, error) {
+	row := q.db.QueryRow(ctx, createFraudCase,
+		arg.Username,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Description,
+		arg.Rule,
+		arg.Features,
+	)
+	var i FraudCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Description,
+		&i.Rule,
+		&i.Features,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFraudCase = `-- name: GetFraudCase :one
+SELECT id, username, from_account_id, to_account_id, amount, description, rule, features, status, resolved_by, resolution_note, resolved_at, transfer_id, created_at
+FROM fraud_cases
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetFraudCase(ctx context.Context, id int64) (FraudCase, error) {
+	row := q.db.QueryRow(ctx, getFraudCase, id)
+	var i FraudCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Description,
+		&i.Rule,
+		&i.Features,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFraudCaseForUpdate = `-- name: GetFraudCaseForUpdate :one
+SELECT id, username, from_account_id, to_account_id, amount, description, rule, features, status, resolved_by, resolution_note, resolved_at, transfer_id, created_at
+FROM fraud_cases
+WHERE id = $1
+LIMIT 1
+    FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetFraudCaseForUpdate(ctx context.Context, id int64) (FraudCase, error) {
+	row := q.db.QueryRow(ctx, getFraudCaseForUpdate, id)
+	var i FraudCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Description,
+		&i.Rule,
+		&i.Features,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listFraudCases = `-- name: ListFraudCases :many
+SELECT id, username, from_account_id, to_account_id, amount, description, rule, features, status, resolved_by, resolution_note, resolved_at, transfer_id, created_at
+FROM fraud_cases
+WHERE status = $1
+  AND ($2::bigint IS NULL OR id > $2)
+ORDER BY id
+LIMIT $3
+`
+
+type ListFraudCasesParams struct {
+	Status   string      `json:"status"`
+	AfterID  pgtype.Int8 `json:"after_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+// Lists the cases with the status, oldest first so that the longest held transfers are reviewed first.
+func (q *Queries) ListFraudCases(ctx context.Context, arg ListFraudCasesParams) ([]FraudCase, error) {
+	rows, err := q.db.Query(ctx, listFraudCases, arg.Status, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FraudCase{}
+	for rows.Next() {
+		var i FraudCase
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Description,
+			&i.Rule,
+			&i.Features,
+			&i.Status,
+			&i.ResolvedBy,
+			&i.ResolutionNote,
+			&i.ResolvedAt,
+			&i.TransferID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveFraudCase = `-- name: ResolveFraudCase :one
+UPDATE fraud_cases
+SET status          = $1,
+    resolved_by     = $2,
+    resolution_note = $3,
+    transfer_id     = $4,
+    resolved_at     = now()
+WHERE id = $5
+RETURNING id, username, from_account_id, to_account_id, amount, description, rule, features, status, resolved_by, resolution_note, resolved_at, transfer_id, created_at
+`
+
+type ResolveFraudCaseParams struct {
+	Status         string      `json:"status"`
+	ResolvedBy     pgtype.Text `json:"resolved_by"`
+	ResolutionNote pgtype.Text `json:"resolution_note"`
+	TransferID     pgtype.Int8 `json:"transfer_id"`
+	ID             int64       `json:"id"`
+}
+
+func (q *Queries) ResolveFraudCase(ctx context.Context, arg ResolveFraudCaseParams) (FraudCase, error) {
+	row := q.db.QueryRow(ctx, resolveFraudCase,
+		arg.Status,
+		arg.ResolvedBy,
+		arg.ResolutionNote,
+		arg.TransferID,
+		arg.ID,
+	)
+	var i FraudCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Description,
+		&i.Rule,
+		&i.Features,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.TransferID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
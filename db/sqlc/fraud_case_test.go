@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomFraudCase(t *testing.T, fromAccount, toAccount Account) FraudCase {
+	arg := CreateFraudCaseParams{
+		Username:      fromAccount.Owner,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        10,
+		Description:   util.RandomString(12),
+		Rule:          "unusual_amount_new_payee",
+		Features:      []byte(`{"amount":10,"new_payee":true}`),
+	}
+
+	fraudCase, err := testStore.CreateFraudCase(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, fraudCase.Username)
+	require.Equal(t, arg.Rule, fraudCase.Rule)
+	require.Equal(t, util.FraudCaseOpen, fraudCase.Status)
+	require.False(t, fraudCase.TransferID.Valid)
+
+	return fraudCase
+}
+
+func TestResolveFraudCaseTx(t *testing.T) {
+	banker := createRandomUser(t)
+	fromAccount := createRandomAccount(t)
+	toAccount := createRandomAccount(t)
+
+	approved := createRandomFraudCase(t, fromAccount, toAccount)
+	rejected := createRandomFraudCase(t, fromAccount, toAccount)
+
+	result, err := testStore.ResolveFraudCaseTx(context.Background(), ResolveFraudCaseTxParams{
+		ID:         approved.ID,
+		Approve:    true,
+		ResolvedBy: banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.FraudCaseApproved, result.FraudCase.Status)
+	require.Equal(t, banker.Username, result.FraudCase.ResolvedBy.String)
+	require.True(t, result.FraudCase.ResolvedAt.Valid)
+	require.Equal(t, result.Transfer.ID, result.FraudCase.TransferID.Int64)
+	require.Equal(t, approved.Amount, result.Transfer.Amount)
+	require.Equal(t, fromAccount.Balance-approved.Amount, result.FromAccount.Balance)
+
+	result, err = testStore.ResolveFraudCaseTx(context.Background(), ResolveFraudCaseTxParams{
+		ID:         rejected.ID,
+		ResolvedBy: banker.Username,
+		Note:       pgtype.Text{String: "not sent by the customer", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.FraudCaseRejected, result.FraudCase.Status)
+	require.Equal(t, "not sent by the customer", result.FraudCase.ResolutionNote.String)
+	require.False(t, result.FraudCase.TransferID.Valid)
+	require.Zero(t, result.Transfer.ID)
+
+	// a case can only be resolved once
+	_, err = testStore.ResolveFraudCaseTx(context.Background(), ResolveFraudCaseTxParams{
+		ID:         approved.ID,
+		ResolvedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrFraudCaseNotOpen)
+
+	openCases, err := testStore.ListFraudCases(context.Background(), ListFraudCasesParams{
+		Status:   util.FraudCaseOpen,
+		AfterID:  pgtype.Int8{Int64: approved.ID - 1, Valid: true},
+		PageSize: 100,
+	})
+	require.NoError(t, err)
+	for _, fraudCase := range openCases {
+		require.NotEqual(t, approved.ID, fraudCase.ID)
+		require.NotEqual(t, rejected.ID, fraudCase.ID)
+	}
+}
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i Impersonation) ToResponse(calls int64) *pb.Impersonation {
+	return &pb.Impersonation{
+		Id:           i.ID.String(),
+		Impersonator: i.Impersonator,
+		Username:     i.Username,
+		Reason:       i.Reason,
+		ReadOnly:     i.ReadOnly,
+		Calls:        calls,
+		ExpiresAt:    timestamppb.New(i.ExpiresAt),
+		CreatedAt:    timestamppb.New(i.CreatedAt),
+	}
+}
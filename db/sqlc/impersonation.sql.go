@@ -0,0 +1,129 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: impersonation.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createImpersonation = `-- name: CreateImpersonation :one
+INSERT INTO impersonations (id, impersonator, impersonator_session_id, username, reason, read_only, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, impersonator, impersonator_session_id, username, reason, read_only, expires_at, created_at
+`
+
+type CreateImpersonationParams struct {
+	ID                    uuid.UUID `json:"id"`
+	Impersonator          string    `json:"impersonator"`
+	ImpersonatorSessionID uuid.UUID `json:"impersonator_session_id"`
+	Username              string    `json:"username"`
+	Reason                string    `json:"reason"`
+	ReadOnly              bool      `json:"read_only"`
+	ExpiresAt             time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (Impersonation, error) {
+	row := q.db.QueryRow(ctx, createImpersonation,
+		arg.ID,
+		arg.Impersonator,
+		arg.ImpersonatorSessionID,
+		arg.Username,
+		arg.Reason,
+		arg.ReadOnly,
+		arg.ExpiresAt,
+	)
+	var i Impersonation
+	err := row.Scan(
+		&i.ID,
+		&i.Impersonator,
+		&i.ImpersonatorSessionID,
+		&i.Username,
+		&i.Reason,
+		&i.ReadOnly,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getImpersonation = `-- name: GetImpersonation :one
+SELECT id, impersonator, impersonator_session_id, username, reason, read_only, expires_at, created_at
+FROM impersonations
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetImpersonation(ctx context.Context, id uuid.UUID) (Impersonation, error) {
+	row := q.db.QueryRow(ctx, getImpersonation, id)
+	var i Impersonation
+	err := row.Scan(
+		&i.ID,
+		&i.Impersonator,
+		&i.ImpersonatorSessionID,
+		&i.Username,
+		&i.Reason,
+		&i.ReadOnly,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listImpersonations = `-- name: ListImpersonations :many
+SELECT impersonations.id, impersonations.impersonator, impersonations.impersonator_session_id, impersonations.username, impersonations.reason, impersonations.read_only, impersonations.expires_at, impersonations.created_at,
+       (SELECT count(*) FROM audit_events a WHERE a.impersonation_id = impersonations.id) AS calls
+FROM impersonations
+WHERE impersonations.username = $1
+  AND ($2::timestamptz IS NULL OR impersonations.created_at < $2)
+ORDER BY impersonations.created_at DESC
+LIMIT $3
+`
+
+type ListImpersonationsParams struct {
+	Username      string             `json:"username"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	PageSize      int32              `json:"page_size"`
+}
+
+type ListImpersonationsRow struct {
+	Impersonation Impersonation `json:"impersonation"`
+	Calls         int64         `json:"calls"`
+}
+
+// Includes how many calls were made under each impersonation, so users can tell looking from acting.
+func (q *Queries) ListImpersonations(ctx context.Context, arg ListImpersonationsParams) ([]ListImpersonationsRow, error) {
+	rows, err := q.db.Query(ctx, listImpersonations, arg.Username, arg.CreatedBefore, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListImpersonationsRow{}
+	for rows.Next() {
+		var i ListImpersonationsRow
+		if err := rows.Scan(
+			&i.Impersonation.ID,
+			&i.Impersonation.Impersonator,
+			&i.Impersonation.ImpersonatorSessionID,
+			&i.Impersonation.Username,
+			&i.Impersonation.Reason,
+			&i.Impersonation.ReadOnly,
+			&i.Impersonation.ExpiresAt,
+			&i.Impersonation.CreatedAt,
+			&i.Calls,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestListImpersonations(t *testing.T) {
+	user := createRandomUser(t)
+	agent := createRandomUser(t)
+	session := createRandomSession(t, agent.Username)
+
+	impersonation, err := testStore.CreateImpersonation(context.Background(), CreateImpersonationParams{
+		ID:                    uuid.New(),
+		Impersonator:          agent.Username,
+		ImpersonatorSessionID: session.ID,
+		Username:              user.Username,
+		Reason:                util.RandomString(20),
+		ReadOnly:              true,
+		ExpiresAt:             time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	got, err := testStore.GetImpersonation(context.Background(), impersonation.ID)
+	require.NoError(t, err)
+	require.Equal(t, impersonation, got)
+
+	for range 2 {
+		_, err = testStore.CreateAuditEvent(context.Background(), CreateAuditEventParams{
+			Username:        pgtype.Text{String: user.Username, Valid: true},
+			Impersonator:    pgtype.Text{String: agent.Username, Valid: true},
+			ImpersonationID: pgtype.UUID{Bytes: impersonation.ID, Valid: true},
+			Method:          "/pb.Simplebank/ListAccounts",
+			StatusCode:      "OK",
+		})
+		require.NoError(t, err)
+	}
+
+	rows, err := testStore.ListImpersonations(context.Background(), ListImpersonationsParams{
+		Username: user.Username,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, impersonation.ID, rows[0].Impersonation.ID)
+	require.Equal(t, int64(2), rows[0].Calls)
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"simplebank/pb"
+	"simplebank/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (document KycDocument) ToResponse() *pb.KycDocument {
+	response := &pb.KycDocument{
+		Id:          document.ID,
+		Username:    document.Username,
+		Kind:        document.Kind,
+		ContentType: document.ContentType,
+		Size:        document.Size,
+		Sha256:      document.Sha256,
+		Status:      document.Status,
+		CreatedAt:   timestamppb.New(document.CreatedAt),
+	}
+
+	if document.RejectionReason.Valid {
+		response.RejectionReason = &document.RejectionReason.String
+	}
+
+	if document.ReviewedBy.Valid {
+		response.ReviewedBy = &document.ReviewedBy.String
+	}
+
+	if document.ReviewedAt.Valid {
+		response.ReviewedAt = timestamppb.New(document.ReviewedAt.Time)
+	}
+
+	return response
+}
+
+// KycState derives the KYC tier and status of a user from all of their documents. The tier only counts
+// approved documents, so a user keeps their tier while a newer document is waiting for review.
+func KycState(documents []KycDocument) (tier int32, status string) {
+	approved := make(map[string]bool)
+	var pending, rejected bool
+
+	for _, document := range documents {
+		switch document.Status {
+		case util.KycDocumentApproved:
+			approved[document.Kind] = true
+		case util.KycDocumentPending:
+			pending = true
+		case util.KycDocumentRejected:
+			rejected = true
+		}
+	}
+
+	if approved[util.KycDocumentIdentity] {
+		tier = 1
+		if approved[util.KycDocumentProofOfAddress] {
+			tier = 2
+		}
+	}
+
+	switch {
+	case pending:
+		status = util.KycStatusPendingReview
+	case tier > 0:
+		status = util.KycStatusApproved
+	case rejected:
+		status = util.KycStatusRejected
+	default:
+		status = util.KycStatusNone
+	}
+
+	return tier, status
+}
+
+// refreshUserKyc recomputes the KYC tier and status of a user whose row is locked by the transaction.
+func refreshUserKyc(ctx context.Context, q *Queries, username string) (User, error) {
+	documents, err := q.ListKycDocumentsByUsername(ctx, username)
+	if err != nil {
+		return User{}, err
+	}
+
+	tier, status := KycState(documents)
+
+	return q.UpdateUserKyc(ctx, UpdateUserKycParams{
+		Username:  username,
+		KycTier:   tier,
+		KycStatus: status,
+	})
+}
@@ -0,0 +1,278 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: kyc_document.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createKycDocument = `-- name: CreateKycDocument :one
+INSERT INTO kyc_documents (username, kind, blob_key, content_type, size, sha256)
+SELECT $1,
+       $2,
+       $3,
+       $4,
+       $5,
+       $6
+WHERE NOT EXISTS (SELECT 1
+                  FROM kyc_documents
+                  WHERE username = $1
+                    AND kind = $2
+                    AND status = 'pending')
+RETURNING id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+`
+
+type CreateKycDocumentParams struct {
+	Username    string `json:"username"`
+	Kind        string `json:"kind"`
+	BlobKey     string `json:"blob_key"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+}
+
+// Users can only have one document of each kind waiting for review at a time.
+func (q *Queries) CreateKycDocument(ctx context.Context, arg CreateKycDocumentParams) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, createKycDocument,
+		arg.Username,
+		arg.Kind,
+		arg.BlobKey,
+		arg.ContentType,
+		arg.Size,
+		arg.Sha256,
+	)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.BlobKey,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteKycDocuments = `-- name: DeleteKycDocuments :many
+DELETE
+FROM kyc_documents
+WHERE username = $1
+RETURNING blob_key
+`
+
+// Returns the keys of the deleted documents, their files have to be removed from the blob store.
+func (q *Queries) DeleteKycDocuments(ctx context.Context, username string) ([]string, error) {
+	rows, err := q.db.Query(ctx, deleteKycDocuments, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var blob_key string
+		if err := rows.Scan(&blob_key); err != nil {
+			return nil, err
+		}
+		items = append(items, blob_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getKycDocument = `-- name: GetKycDocument :one
+SELECT id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetKycDocument(ctx context.Context, id int64) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, getKycDocument, id)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.BlobKey,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getKycDocumentForUpdate = `-- name: GetKycDocumentForUpdate :one
+SELECT id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetKycDocumentForUpdate(ctx context.Context, id int64) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, getKycDocumentForUpdate, id)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.BlobKey,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listKycDocumentsByUsername = `-- name: ListKycDocumentsByUsername :many
+SELECT id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+FROM kyc_documents
+WHERE username = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListKycDocumentsByUsername(ctx context.Context, username string) ([]KycDocument, error) {
+	rows, err := q.db.Query(ctx, listKycDocumentsByUsername, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []KycDocument{}
+	for rows.Next() {
+		var i KycDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Kind,
+			&i.BlobKey,
+			&i.ContentType,
+			&i.Size,
+			&i.Sha256,
+			&i.Status,
+			&i.RejectionReason,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingKycDocuments = `-- name: ListPendingKycDocuments :many
+SELECT id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+FROM kyc_documents
+WHERE status = 'pending'
+  AND ($1::bigint IS NULL OR id > $1)
+ORDER BY id
+LIMIT $2
+`
+
+type ListPendingKycDocumentsParams struct {
+	AfterID  pgtype.Int8 `json:"after_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+// Lists the documents waiting for review, oldest first.
+func (q *Queries) ListPendingKycDocuments(ctx context.Context, arg ListPendingKycDocumentsParams) ([]KycDocument, error) {
+	rows, err := q.db.Query(ctx, listPendingKycDocuments, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []KycDocument{}
+	for rows.Next() {
+		var i KycDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Kind,
+			&i.BlobKey,
+			&i.ContentType,
+			&i.Size,
+			&i.Sha256,
+			&i.Status,
+			&i.RejectionReason,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewKycDocument = `-- name: ReviewKycDocument :one
+UPDATE kyc_documents
+SET status           = $1,
+    rejection_reason = $2,
+    reviewed_by      = $3,
+    reviewed_at      = now()
+WHERE id = $4
+RETURNING id, username, kind, blob_key, content_type, size, sha256, status, rejection_reason, reviewed_by, reviewed_at, created_at
+`
+
+type ReviewKycDocumentParams struct {
+	Status          string      `json:"status"`
+	RejectionReason pgtype.Text `json:"rejection_reason"`
+	ReviewedBy      pgtype.Text `json:"reviewed_by"`
+	ID              int64       `json:"id"`
+}
+
+func (q *Queries) ReviewKycDocument(ctx context.Context, arg ReviewKycDocumentParams) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, reviewKycDocument,
+		arg.Status,
+		arg.RejectionReason,
+		arg.ReviewedBy,
+		arg.ID,
+	)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Kind,
+		&i.BlobKey,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func uploadRandomKycDocument(t *testing.T, username string, kind string) UploadKycDocumentTxResult {
+	result, err := testStore.UploadKycDocumentTx(context.Background(), UploadKycDocumentTxParams{
+		CreateKycDocumentParams: CreateKycDocumentParams{
+			Username:    username,
+			Kind:        kind,
+			BlobKey:     "kyc/" + username + "/" + util.RandomString(12),
+			ContentType: "application/pdf",
+			Size:        util.RandomInt(1, 1<<20),
+			Sha256:      util.RandomString(64),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.KycDocumentPending, result.Document.Status)
+	require.Equal(t, util.KycStatusPendingReview, result.User.KycStatus)
+
+	return result
+}
+
+func TestKycReviewFlow(t *testing.T) {
+	user := createRandomUser(t)
+	reviewer := createRandomUser(t)
+	require.Equal(t, int32(0), user.KycTier)
+	require.Equal(t, util.KycStatusNone, user.KycStatus)
+
+	identity := uploadRandomKycDocument(t, user.Username, util.KycDocumentIdentity)
+
+	// only one document of a kind can wait for review
+	_, err := testStore.UploadKycDocumentTx(context.Background(), UploadKycDocumentTxParams{
+		CreateKycDocumentParams: CreateKycDocumentParams{
+			Username: user.Username,
+			Kind:     util.KycDocumentIdentity,
+			BlobKey:  "kyc/" + user.Username + "/again",
+		},
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	var notified []int64
+	review := func(document KycDocument, status string, reason string) (ReviewKycDocumentTxResult, error) {
+		return testStore.ReviewKycDocumentTx(context.Background(), ReviewKycDocumentTxParams{
+			ReviewKycDocumentParams: ReviewKycDocumentParams{
+				ID:              document.ID,
+				Status:          status,
+				RejectionReason: pgtype.Text{String: reason, Valid: reason != ""},
+				ReviewedBy:      pgtype.Text{String: reviewer.Username, Valid: true},
+			},
+			AfterReview: func(document KycDocument) error {
+				notified = append(notified, document.ID)
+				return nil
+			},
+		})
+	}
+
+	result, err := review(identity.Document, util.KycDocumentRejected, "expired passport")
+	require.NoError(t, err)
+	require.Equal(t, "expired passport", result.Document.RejectionReason.String)
+	require.True(t, result.Document.ReviewedAt.Valid)
+	require.Equal(t, int32(0), result.User.KycTier)
+	require.Equal(t, util.KycStatusRejected, result.User.KycStatus)
+
+	_, err = review(identity.Document, util.KycDocumentApproved, "")
+	require.ErrorIs(t, err, ErrKycDocumentNotPending)
+
+	rejectedID := identity.Document.ID
+	identity = uploadRandomKycDocument(t, user.Username, util.KycDocumentIdentity)
+	result, err = review(identity.Document, util.KycDocumentApproved, "")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.User.KycTier)
+	require.Equal(t, util.KycStatusApproved, result.User.KycStatus)
+
+	address := uploadRandomKycDocument(t, user.Username, util.KycDocumentProofOfAddress)
+	require.Equal(t, int32(1), address.User.KycTier)
+
+	result, err = review(address.Document, util.KycDocumentApproved, "")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), result.User.KycTier)
+	require.Equal(t, util.KycStatusApproved, result.User.KycStatus)
+
+	require.Equal(t, []int64{rejectedID, identity.Document.ID, address.Document.ID}, notified)
+
+	documents, err := testStore.ListKycDocumentsByUsername(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, documents, 3)
+	require.Equal(t, address.Document.ID, documents[0].ID)
+}
+
+func TestKycState(t *testing.T) {
+	document := func(kind string, status string) KycDocument {
+		return KycDocument{Kind: kind, Status: status}
+	}
+
+	testCases := []struct {
+		name      string
+		documents []KycDocument
+		tier      int32
+		status    string
+	}{
+		{"NoDocuments", nil, 0, util.KycStatusNone},
+		{"Pending", []KycDocument{document(util.KycDocumentIdentity, util.KycDocumentPending)}, 0, util.KycStatusPendingReview},
+		{"Rejected", []KycDocument{document(util.KycDocumentIdentity, util.KycDocumentRejected)}, 0, util.KycStatusRejected},
+		{"Identity", []KycDocument{
+			document(util.KycDocumentIdentity, util.KycDocumentRejected),
+			document(util.KycDocumentIdentity, util.KycDocumentApproved),
+		}, 1, util.KycStatusApproved},
+		{"AddressWithoutIdentity", []KycDocument{document(util.KycDocumentProofOfAddress, util.KycDocumentApproved)}, 0, util.KycStatusNone},
+		{"IdentityAndAddress", []KycDocument{
+			document(util.KycDocumentIdentity, util.KycDocumentApproved),
+			document(util.KycDocumentProofOfAddress, util.KycDocumentApproved),
+		}, 2, util.KycStatusApproved},
+		{"TierKeptInReview", []KycDocument{
+			document(util.KycDocumentIdentity, util.KycDocumentApproved),
+			document(util.KycDocumentProofOfAddress, util.KycDocumentPending),
+		}, 1, util.KycStatusPendingReview},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tier, status := KycState(tc.documents)
+			require.Equal(t, tc.tier, tier)
+			require.Equal(t, tc.status, status)
+		})
+	}
+}
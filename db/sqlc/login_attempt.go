@@ -0,0 +1,24 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (a LoginAttempt) ToResponse() *pb.LoginAttempt {
+	response := &pb.LoginAttempt{
+		Id:        a.ID,
+		Username:  a.Username,
+		ClientIp:  a.ClientIp,
+		UserAgent: a.UserAgent,
+		Succeeded: a.Succeeded,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+
+	if a.FailureReason.Valid {
+		response.FailureReason = &a.FailureReason.String
+	}
+
+	return response
+}
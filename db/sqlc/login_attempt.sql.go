@@ -0,0 +1,154 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: login_attempt.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countLoginFailuresByIP = `-- name: CountLoginFailuresByIP :one
+SELECT count(*)
+FROM login_attempts
+WHERE client_ip = $1
+  AND NOT succeeded
+  AND created_at > $2
+`
+
+type CountLoginFailuresByIPParams struct {
+	ClientIp string    `json:"client_ip"`
+	Since    time.Time `json:"since"`
+}
+
+func (q *Queries) CountLoginFailuresByIP(ctx context.Context, arg CountLoginFailuresByIPParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLoginFailuresByIP, arg.ClientIp, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLoginAttempt = `-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (username, client_ip, user_agent, succeeded, failure_reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, username, client_ip, user_agent, succeeded, failure_reason, created_at
+`
+
+type CreateLoginAttemptParams struct {
+	Username      string      `json:"username"`
+	ClientIp      string      `json:"client_ip"`
+	UserAgent     string      `json:"user_agent"`
+	Succeeded     bool        `json:"succeeded"`
+	FailureReason pgtype.Text `json:"failure_reason"`
+}
+
+func (q *Queries) CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error) {
+	row := q.db.QueryRow(ctx, createLoginAttempt,
+		arg.Username,
+		arg.ClientIp,
+		arg.UserAgent,
+		arg.Succeeded,
+		arg.FailureReason,
+	)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.Succeeded,
+		&i.FailureReason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteLoginAttempts = `-- name: DeleteLoginAttempts :exec
+DELETE
+FROM login_attempts
+WHERE username = $1
+`
+
+func (q *Queries) DeleteLoginAttempts(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteLoginAttempts, username)
+	return err
+}
+
+const getLoginFailureStats = `-- name: GetLoginFailureStats :one
+SELECT count(*)                                             AS failures,
+       coalesce(max(created_at), 'epoch'::timestamptz)::timestamptz AS last_failed_at
+FROM login_attempts
+WHERE login_attempts.username = $1
+  AND NOT succeeded
+  AND created_at > greatest(
+        $2::timestamptz,
+        (SELECT max(s.created_at) FROM login_attempts s WHERE s.username = $1 AND s.succeeded),
+        (SELECT u.unlocked_at FROM users u WHERE u.username = $1)
+    )
+`
+
+type GetLoginFailureStatsParams struct {
+	Username string    `json:"username"`
+	Since    time.Time `json:"since"`
+}
+
+type GetLoginFailureStatsRow struct {
+	Failures     int64     `json:"failures"`
+	LastFailedAt time.Time `json:"last_failed_at"`
+}
+
+// Counts the failures of a username since the start of the window,
+// ignoring those before its last successful login or unlock.
+func (q *Queries) GetLoginFailureStats(ctx context.Context, arg GetLoginFailureStatsParams) (GetLoginFailureStatsRow, error) {
+	row := q.db.QueryRow(ctx, getLoginFailureStats, arg.Username, arg.Since)
+	var i GetLoginFailureStatsRow
+	err := row.Scan(&i.Failures, &i.LastFailedAt)
+	return i, err
+}
+
+const listLoginAttempts = `-- name: ListLoginAttempts :many
+SELECT id, username, client_ip, user_agent, succeeded, failure_reason, created_at
+FROM login_attempts
+WHERE username = $1
+  AND ($2::bigint IS NULL OR id < $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListLoginAttemptsParams struct {
+	Username string      `json:"username"`
+	BeforeID pgtype.Int8 `json:"before_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+func (q *Queries) ListLoginAttempts(ctx context.Context, arg ListLoginAttemptsParams) ([]LoginAttempt, error) {
+	rows, err := q.db.Query(ctx, listLoginAttempts, arg.Username, arg.BeforeID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoginAttempt{}
+	for rows.Next() {
+		var i LoginAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.ClientIp,
+			&i.UserAgent,
+			&i.Succeeded,
+			&i.FailureReason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
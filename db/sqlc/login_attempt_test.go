@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomLoginAttempt(t *testing.T, username, clientIP string, failureReason string) LoginAttempt {
+	attempt, err := testStore.CreateLoginAttempt(context.Background(), CreateLoginAttemptParams{
+		Username:  username,
+		ClientIp:  clientIP,
+		UserAgent: "test",
+		Succeeded: failureReason == "",
+		FailureReason: pgtype.Text{
+			String: failureReason,
+			Valid:  failureReason != "",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, username, attempt.Username)
+
+	return attempt
+}
+
+func TestGetLoginFailureStats(t *testing.T) {
+	user := createRandomUser(t)
+	clientIP := util.RandomString(12)
+	since := time.Now().Add(-time.Hour)
+
+	createRandomLoginAttempt(t, user.Username, clientIP, "wrong_password")
+	createRandomLoginAttempt(t, user.Username, clientIP, "")
+	createRandomLoginAttempt(t, user.Username, clientIP, "wrong_password")
+	last := createRandomLoginAttempt(t, user.Username, clientIP, "wrong_password")
+
+	// failures before the successful login no longer count
+	stats, err := testStore.GetLoginFailureStats(context.Background(), GetLoginFailureStatsParams{
+		Username: user.Username,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.Failures)
+	require.WithinDuration(t, last.CreatedAt, stats.LastFailedAt, time.Millisecond)
+
+	ipFailures, err := testStore.CountLoginFailuresByIP(context.Background(), CountLoginFailuresByIPParams{
+		ClientIp: clientIP,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ipFailures)
+
+	// and neither do those before an unlock
+	unlocked, err := testStore.UnlockUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.True(t, unlocked.UnlockedAt.Valid)
+	require.False(t, unlocked.LockedUntil.Valid)
+
+	stats, err = testStore.GetLoginFailureStats(context.Background(), GetLoginFailureStatsParams{
+		Username: user.Username,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Zero(t, stats.Failures)
+}
+
+func TestGetLoginFailureStatsUnknownUser(t *testing.T) {
+	username := util.RandomOwner()
+	createRandomLoginAttempt(t, username, util.RandomString(12), "unknown_user")
+
+	stats, err := testStore.GetLoginFailureStats(context.Background(), GetLoginFailureStatsParams{
+		Username: username,
+		Since:    time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Failures)
+}
+
+func TestLockUser(t *testing.T) {
+	user := createRandomUser(t)
+	lockedUntil := time.Now().Add(time.Hour)
+
+	locked, err := testStore.LockUser(context.Background(), LockUserParams{
+		Username:    user.Username,
+		LockedUntil: pgtype.Timestamptz{Time: lockedUntil, Valid: true},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, lockedUntil, locked.LockedUntil.Time, time.Second)
+
+	attempts, err := testStore.ListLoginAttempts(context.Background(), ListLoginAttemptsParams{
+		Username: user.Username,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, attempts)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"simplebank/pb"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mandateFrequencyPrefix = "MANDATE_FREQUENCY_"
+	mandateStatusPrefix    = "MANDATE_STATUS_"
+)
+
+func (m Mandate) ToResponse() *pb.Mandate {
+	response := &pb.Mandate{
+		Id:                m.ID,
+		Debtor:            m.Debtor,
+		DebtorAccountId:   m.DebtorAccountID,
+		Creditor:          m.Creditor,
+		CreditorAccountId: m.CreditorAccountID,
+		MaxAmount:         m.MaxAmount,
+		Currency:          pb.Currency(pb.Currency_value[m.Currency]),
+		Frequency:         pb.MandateFrequency(pb.MandateFrequency_value[mandateFrequencyPrefix+strings.ToUpper(m.Frequency)]),
+		Status:            pb.MandateStatus(pb.MandateStatus_value[mandateStatusPrefix+strings.ToUpper(m.Status)]),
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+	}
+
+	if m.Reference.Valid {
+		response.Reference = &m.Reference.String
+	}
+
+	if m.LastCollectedAt.Valid {
+		response.LastCollectedAt = timestamppb.New(m.LastCollectedAt.Time)
+	}
+
+	if m.RevokedAt.Valid {
+		response.RevokedAt = timestamppb.New(m.RevokedAt.Time)
+	}
+
+	return response
+}
+
+// MandateFrequencyFromRequest maps an API mandate frequency to its stored representation.
+func MandateFrequencyFromRequest(frequency pb.MandateFrequency) string {
+	return strings.ToLower(strings.TrimPrefix(frequency.String(), mandateFrequencyPrefix))
+}
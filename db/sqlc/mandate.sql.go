@@ -0,0 +1,233 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mandate.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMandate = `-- name: CreateMandate :one
+INSERT INTO mandates (debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency,
+                      reference)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+`
+
+type CreateMandateParams struct {
+	Debtor            string      `json:"debtor"`
+	DebtorAccountID   int64       `json:"debtor_account_id"`
+	Creditor          string      `json:"creditor"`
+	CreditorAccountID int64       `json:"creditor_account_id"`
+	MaxAmount         int64       `json:"max_amount"`
+	Currency          string      `json:"currency"`
+	Frequency         string      `json:"frequency"`
+	Reference         pgtype.Text `json:"reference"`
+}
+
+func (q *Queries) CreateMandate(ctx context.Context, arg CreateMandateParams) (Mandate, error) {
+	row := q.db.QueryRow(ctx, createMandate,
+		arg.Debtor,
+		arg.DebtorAccountID,
+		arg.Creditor,
+		arg.CreditorAccountID,
+		arg.MaxAmount,
+		arg.Currency,
+		arg.Frequency,
+		arg.Reference,
+	)
+	var i Mandate
+	err := row.Scan(
+		&i.ID,
+		&i.Debtor,
+		&i.DebtorAccountID,
+		&i.Creditor,
+		&i.CreditorAccountID,
+		&i.MaxAmount,
+		&i.Currency,
+		&i.Frequency,
+		&i.Reference,
+		&i.Status,
+		&i.LastCollectedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getMandate = `-- name: GetMandate :one
+SELECT id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+FROM mandates
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetMandate(ctx context.Context, id int64) (Mandate, error) {
+	row := q.db.QueryRow(ctx, getMandate, id)
+	var i Mandate
+	err := row.Scan(
+		&i.ID,
+		&i.Debtor,
+		&i.DebtorAccountID,
+		&i.Creditor,
+		&i.CreditorAccountID,
+		&i.MaxAmount,
+		&i.Currency,
+		&i.Frequency,
+		&i.Reference,
+		&i.Status,
+		&i.LastCollectedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getMandateForUpdate = `-- name: GetMandateForUpdate :one
+SELECT id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+FROM mandates
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetMandateForUpdate(ctx context.Context, id int64) (Mandate, error) {
+	row := q.db.QueryRow(ctx, getMandateForUpdate, id)
+	var i Mandate
+	err := row.Scan(
+		&i.ID,
+		&i.Debtor,
+		&i.DebtorAccountID,
+		&i.Creditor,
+		&i.CreditorAccountID,
+		&i.MaxAmount,
+		&i.Currency,
+		&i.Frequency,
+		&i.Reference,
+		&i.Status,
+		&i.LastCollectedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listMandates = `-- name: ListMandates :many
+SELECT id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+FROM mandates
+WHERE (debtor = $1 OR creditor = $1)
+  AND ($2::bigint[] IS NULL
+    OR (debtor = $1 AND debtor_account_id = ANY ($2::bigint[]))
+    OR (creditor = $1 AND creditor_account_id = ANY ($2::bigint[])))
+  AND ($3::bigint IS NULL OR id < $3)
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListMandatesParams struct {
+	Username   string      `json:"username"`
+	AccountIds []int64     `json:"account_ids"`
+	BeforeID   pgtype.Int8 `json:"before_id"`
+	PageSize   int32       `json:"page_size"`
+}
+
+func (q *Queries) ListMandates(ctx context.Context, arg ListMandatesParams) ([]Mandate, error) {
+	rows, err := q.db.Query(ctx, listMandates,
+		arg.Username,
+		arg.AccountIds,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Mandate{}
+	for rows.Next() {
+		var i Mandate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Debtor,
+			&i.DebtorAccountID,
+			&i.Creditor,
+			&i.CreditorAccountID,
+			&i.MaxAmount,
+			&i.Currency,
+			&i.Frequency,
+			&i.Reference,
+			&i.Status,
+			&i.LastCollectedAt,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markMandateCollected = `-- name: MarkMandateCollected :one
+UPDATE mandates
+SET last_collected_at = now()
+WHERE id = $1
+RETURNING id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+`
+
+func (q *Queries) MarkMandateCollected(ctx context.Context, id int64) (Mandate, error) {
+	row := q.db.QueryRow(ctx, markMandateCollected, id)
+	var i Mandate
+	err := row.Scan(
+		&i.ID,
+		&i.Debtor,
+		&i.DebtorAccountID,
+		&i.Creditor,
+		&i.CreditorAccountID,
+		&i.MaxAmount,
+		&i.Currency,
+		&i.Frequency,
+		&i.Reference,
+		&i.Status,
+		&i.LastCollectedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const revokeMandate = `-- name: RevokeMandate :one
+UPDATE mandates
+SET status     = 'revoked',
+    revoked_at = now()
+WHERE id = $1
+  AND status = 'active'
+RETURNING id, debtor, debtor_account_id, creditor, creditor_account_id, max_amount, currency, frequency, reference, status, last_collected_at, created_at, revoked_at
+`
+
+func (q *Queries) RevokeMandate(ctx context.Context, id int64) (Mandate, error) {
+	row := q.db.QueryRow(ctx, revokeMandate, id)
+	var i Mandate
+	err := row.Scan(
+		&i.ID,
+		&i.Debtor,
+		&i.DebtorAccountID,
+		&i.Creditor,
+		&i.CreditorAccountID,
+		&i.MaxAmount,
+		&i.Currency,
+		&i.Frequency,
+		&i.Reference,
+		&i.Status,
+		&i.LastCollectedAt,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
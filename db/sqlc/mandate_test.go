@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomMandate(t *testing.T, debtorAccount, creditorAccount Account) Mandate {
+	arg := CreateMandateParams{
+		Debtor:            debtorAccount.Owner,
+		DebtorAccountID:   debtorAccount.ID,
+		Creditor:          creditorAccount.Owner,
+		CreditorAccountID: creditorAccount.ID,
+		MaxAmount:         100,
+		Currency:          debtorAccount.Currency,
+		Frequency:         util.FrequencyMonthly,
+	}
+
+	mandate, err := testStore.CreateMandate(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, mandate)
+
+	require.Equal(t, arg.Debtor, mandate.Debtor)
+	require.Equal(t, arg.Creditor, mandate.Creditor)
+	require.Equal(t, arg.MaxAmount, mandate.MaxAmount)
+	require.Equal(t, util.MandateActive, mandate.Status)
+	require.False(t, mandate.LastCollectedAt.Valid)
+
+	return mandate
+}
+
+func TestRevokeMandate(t *testing.T) {
+	mandate1 := createRandomMandate(t, createRandomAccount(t), createRandomAccount(t))
+
+	mandate2, err := testStore.RevokeMandate(context.Background(), mandate1.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.MandateRevoked, mandate2.Status)
+	require.True(t, mandate2.RevokedAt.Valid)
+
+	_, err = testStore.RevokeMandate(context.Background(), mandate1.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.CollectDirectDebitTx(context.Background(), CollectDirectDebitTxParams{
+		MandateID: mandate1.ID,
+		Amount:    10,
+	})
+	require.ErrorIs(t, err, ErrMandateNotActive)
+}
+
+func TestCollectDirectDebitTx(t *testing.T) {
+	debtorAccount := createRandomAccount(t)
+	creditorAccount := createRandomAccount(t)
+	mandate := createRandomMandate(t, debtorAccount, creditorAccount)
+
+	_, err := testStore.CollectDirectDebitTx(context.Background(), CollectDirectDebitTxParams{
+		MandateID: mandate.ID,
+		Amount:    mandate.MaxAmount + 1,
+	})
+	require.ErrorIs(t, err, ErrMandateAmountExceeded)
+
+	result, err := testStore.CollectDirectDebitTx(context.Background(), CollectDirectDebitTxParams{
+		MandateID:   mandate.ID,
+		Amount:      mandate.MaxAmount,
+		Description: "Direct debit",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DirectDebitCollected, result.DirectDebit.Status)
+	require.Equal(t, result.Transfer.ID, result.DirectDebit.TransferID)
+	require.Equal(t, debtorAccount.Balance-mandate.MaxAmount, result.FromAccount.Balance)
+	require.Equal(t, creditorAccount.Balance+mandate.MaxAmount, result.ToAccount.Balance)
+	require.WithinDuration(t, time.Now(), result.Mandate.LastCollectedAt.Time, time.Second)
+
+	// Monthly mandates can only be collected once a month
+	_, err = testStore.CollectDirectDebitTx(context.Background(), CollectDirectDebitTxParams{
+		MandateID: mandate.ID,
+		Amount:    1,
+	})
+	require.ErrorIs(t, err, ErrCollectionTooSoon)
+
+	chargeback, err := testStore.ChargebackDirectDebitTx(context.Background(), ChargebackDirectDebitTxParams{
+		DirectDebitID: result.DirectDebit.ID,
+		Window:        time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DirectDebitChargedBack, chargeback.DirectDebit.Status)
+	require.Equal(t, chargeback.Transfer.ID, chargeback.DirectDebit.ChargebackTransferID.Int64)
+	require.Equal(t, debtorAccount.Balance, chargeback.ToAccount.Balance)
+	require.Equal(t, creditorAccount.Balance, chargeback.FromAccount.Balance)
+
+	_, err = testStore.ChargebackDirectDebitTx(context.Background(), ChargebackDirectDebitTxParams{
+		DirectDebitID: result.DirectDebit.ID,
+		Window:        time.Hour,
+	})
+	require.ErrorIs(t, err, ErrAlreadyChargedBack)
+}
@@ -0,0 +1,256 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mfa.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const attemptMfaChallenge = `-- name: AttemptMfaChallenge :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE id = $1
+  AND completed_at IS NULL
+  AND expires_at > now()
+  AND attempts < $2::int
+RETURNING id, username, attempts, expires_at, completed_at, created_at
+`
+
+type AttemptMfaChallengeParams struct {
+	ID          int64 `json:"id"`
+	MaxAttempts int32 `json:"max_attempts"`
+}
+
+func (q *Queries) AttemptMfaChallenge(ctx context.Context, arg AttemptMfaChallengeParams) (MfaChallenge, error) {
+	row := q.db.QueryRow(ctx, attemptMfaChallenge, arg.ID, arg.MaxAttempts)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const completeMfaChallenge = `-- name: CompleteMfaChallenge :one
+UPDATE mfa_challenges
+SET completed_at = now()
+WHERE id = $1
+  AND completed_at IS NULL
+RETURNING id, username, attempts, expires_at, completed_at, created_at
+`
+
+func (q *Queries) CompleteMfaChallenge(ctx context.Context, id int64) (MfaChallenge, error) {
+	row := q.db.QueryRow(ctx, completeMfaChallenge, id)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const confirmTotpCredential = `-- name: ConfirmTotpCredential :one
+UPDATE totp_credentials
+SET confirmed_at = now()
+WHERE username = $1
+  AND confirmed_at IS NULL
+RETURNING username, secret, last_used_step, confirmed_at, created_at
+`
+
+func (q *Queries) ConfirmTotpCredential(ctx context.Context, username string) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, confirmTotpCredential, username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createMfaChallenge = `-- name: CreateMfaChallenge :one
+INSERT INTO mfa_challenges (username, expires_at)
+VALUES ($1, $2)
+RETURNING id, username, attempts, expires_at, completed_at, created_at
+`
+
+type CreateMfaChallengeParams struct {
+	Username  string    `json:"username"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateMfaChallenge(ctx context.Context, arg CreateMfaChallengeParams) (MfaChallenge, error) {
+	row := q.db.QueryRow(ctx, createMfaChallenge, arg.Username, arg.ExpiresAt)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :one
+INSERT INTO recovery_codes (username, hashed_code)
+VALUES ($1, $2)
+RETURNING id, username, hashed_code, used_at, created_at
+`
+
+type CreateRecoveryCodeParams struct {
+	Username   string `json:"username"`
+	HashedCode string `json:"hashed_code"`
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (RecoveryCode, error) {
+	row := q.db.QueryRow(ctx, createRecoveryCode, arg.Username, arg.HashedCode)
+	var i RecoveryCode
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedCode,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE
+FROM recovery_codes
+WHERE username = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, username)
+	return err
+}
+
+const deleteTotpCredential = `-- name: DeleteTotpCredential :exec
+DELETE
+FROM totp_credentials
+WHERE username = $1
+`
+
+func (q *Queries) DeleteTotpCredential(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteTotpCredential, username)
+	return err
+}
+
+const getTotpCredential = `-- name: GetTotpCredential :one
+SELECT username, secret, last_used_step, confirmed_at, created_at
+FROM totp_credentials
+WHERE username = $1
+LIMIT 1
+`
+
+func (q *Queries) GetTotpCredential(ctx context.Context, username string) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, getTotpCredential, username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertTotpCredential = `-- name: UpsertTotpCredential :one
+INSERT INTO totp_credentials (username, secret)
+VALUES ($1, $2)
+ON CONFLICT (username) DO UPDATE
+    SET secret         = excluded.secret,
+        last_used_step = 0,
+        created_at     = now()
+WHERE totp_credentials.confirmed_at IS NULL
+RETURNING username, secret, last_used_step, confirmed_at, created_at
+`
+
+type UpsertTotpCredentialParams struct {
+	Username string `json:"username"`
+	Secret   string `json:"secret"`
+}
+
+func (q *Queries) UpsertTotpCredential(ctx context.Context, arg UpsertTotpCredentialParams) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, upsertTotpCredential, arg.Username, arg.Secret)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :one
+UPDATE recovery_codes
+SET used_at = now()
+WHERE username = $1
+  AND hashed_code = $2
+  AND used_at IS NULL
+RETURNING id, username, hashed_code, used_at, created_at
+`
+
+type UseRecoveryCodeParams struct {
+	Username   string `json:"username"`
+	HashedCode string `json:"hashed_code"`
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (RecoveryCode, error) {
+	row := q.db.QueryRow(ctx, useRecoveryCode, arg.Username, arg.HashedCode)
+	var i RecoveryCode
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedCode,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useTotpStep = `-- name: UseTotpStep :one
+UPDATE totp_credentials
+SET last_used_step = $1
+WHERE username = $2
+  AND last_used_step < $1
+RETURNING username, secret, last_used_step, confirmed_at, created_at
+`
+
+type UseTotpStepParams struct {
+	Step     int64  `json:"step"`
+	Username string `json:"username"`
+}
+
+func (q *Queries) UseTotpStep(ctx context.Context, arg UseTotpStepParams) (TotpCredential, error) {
+	row := q.db.QueryRow(ctx, useTotpStep, arg.Step, arg.Username)
+	var i TotpCredential
+	err := row.Scan(
+		&i.Username,
+		&i.Secret,
+		&i.LastUsedStep,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createConfirmedTotpCredential(t *testing.T, username string, hashedRecoveryCodes []string) TotpCredential {
+	secret, err := util.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	credential, err := testStore.UpsertTotpCredential(context.Background(), UpsertTotpCredentialParams{
+		Username: username,
+		Secret:   secret,
+	})
+	require.NoError(t, err)
+	require.False(t, credential.ConfirmedAt.Valid)
+
+	result, err := testStore.ConfirmTotpTx(context.Background(), ConfirmTotpTxParams{
+		Username:            username,
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	require.NoError(t, err)
+	require.True(t, result.TotpCredential.ConfirmedAt.Valid)
+	require.Len(t, result.RecoveryCodes, len(hashedRecoveryCodes))
+
+	return result.TotpCredential
+}
+
+func TestTotpCredential(t *testing.T) {
+	user := createRandomUser(t)
+	credential := createConfirmedTotpCredential(t, user.Username, nil)
+
+	// a confirmed secret cannot be replaced by enrolling again
+	_, err := testStore.UpsertTotpCredential(context.Background(), UpsertTotpCredentialParams{
+		Username: user.Username,
+		Secret:   util.RandomString(32),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.UseTotpStep(context.Background(), UseTotpStepParams{Username: credential.Username, Step: 100})
+	require.NoError(t, err)
+
+	// steps cannot be reused or go backwards
+	_, err = testStore.UseTotpStep(context.Background(), UseTotpStepParams{Username: credential.Username, Step: 100})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.UseTotpStep(context.Background(), UseTotpStepParams{Username: credential.Username, Step: 99})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	err = testStore.DisableTotpTx(context.Background(), DisableTotpTxParams{Username: user.Username})
+	require.NoError(t, err)
+
+	_, err = testStore.GetTotpCredential(context.Background(), user.Username)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	user := createRandomUser(t)
+	hashedCode := util.HashToken(util.RandomString(10))
+	createConfirmedTotpCredential(t, user.Username, []string{hashedCode})
+
+	arg := UseRecoveryCodeParams{
+		Username:   user.Username,
+		HashedCode: hashedCode,
+	}
+
+	recoveryCode, err := testStore.UseRecoveryCode(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, recoveryCode.UsedAt.Valid)
+
+	_, err = testStore.UseRecoveryCode(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestAttemptMfaChallenge(t *testing.T) {
+	user := createRandomUser(t)
+
+	challenge, err := testStore.CreateMfaChallenge(context.Background(), CreateMfaChallengeParams{
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	arg := AttemptMfaChallengeParams{
+		ID:          challenge.ID,
+		MaxAttempts: 2,
+	}
+
+	for i := 1; i <= 2; i++ {
+		challenge, err = testStore.AttemptMfaChallenge(context.Background(), arg)
+		require.NoError(t, err)
+		require.Equal(t, int32(i), challenge.Attempts)
+	}
+
+	_, err = testStore.AttemptMfaChallenge(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	challenge, err = testStore.CompleteMfaChallenge(context.Background(), challenge.ID)
+	require.NoError(t, err)
+	require.True(t, challenge.CompletedAt.Valid)
+
+	_, err = testStore.CompleteMfaChallenge(context.Background(), challenge.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -12,13 +12,107 @@ import (
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
-	Balance   int64     `json:"balance"`
-	Currency  string    `json:"currency"`
+	ID        int64              `json:"id"`
+	Owner     string             `json:"owner"`
+	Balance   int64              `json:"balance"`
+	Currency  string             `json:"currency"`
+	CreatedAt time.Time          `json:"created_at"`
+	Number    string             `json:"number"`
+	ClosedAt  pgtype.Timestamptz `json:"closed_at"`
+	// no money moves in or out of frozen accounts
+	FrozenAt pgtype.Timestamptz `json:"frozen_at"`
+}
+
+// transfers the periodic anti-money-laundering scan found suspicious
+type AmlReport struct {
+	ID int64 `json:"id"`
+	// large_transaction or structuring
+	Kind string `json:"kind"`
+	// the sender of the transfers
+	Username    string `json:"username"`
+	Currency    string `json:"currency"`
+	TotalAmount int64  `json:"total_amount"`
+	// the transfers supporting the report, a transfer supports at most one report of a kind
+	TransferIds []int64 `json:"transfer_ids"`
+	// when the first of the transfers was made
+	WindowStart time.Time `json:"window_start"`
+	// when the last of the transfers was made
+	WindowEnd time.Time `json:"window_end"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// progress of the periodic anti-money-laundering scan, it has at most one row
+type AmlScanWatermark struct {
+	ID bool `json:"id"`
+	// when the last successful scan started, large transfers are scanned from there so missed runs lose no reports
+	ScannedUntil time.Time `json:"scanned_until"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// the user the call was made as, null for calls without an access token
+	Username pgtype.Text `json:"username"`
+	// the employee acting as the user, if the call was made under impersonation
+	Impersonator    pgtype.Text `json:"impersonator"`
+	ImpersonationID pgtype.UUID `json:"impersonation_id"`
+	Method          string      `json:"method"`
+	StatusCode      string      `json:"status_code"`
+	CreatedAt       time.Time   `json:"created_at"`
+	Role            string      `json:"role"`
+	ClientID        pgtype.Text `json:"client_id"`
+	// resources the call acted on as type/id, e.g. accounts/42
+	Resources []string `json:"resources"`
+	// the request with its sensitive fields redacted
+	Request   []byte `json:"request"`
+	ClientIp  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+type BillSplit struct {
+	ID          int64  `json:"id"`
+	Creator     string `json:"creator"`
+	ToAccountID int64  `json:"to_account_id"`
+	// total amount of the expense, must be positive
+	Amount      int64       `json:"amount"`
+	Currency    string      `json:"currency"`
+	Description pgtype.Text `json:"description"`
+	SplitMethod string      `json:"split_method"`
+	// part of the expense paid by the creator, not requested from anyone
+	CreatorShare int64              `json:"creator_share"`
+	CreatedAt    time.Time          `json:"created_at"`
+	RemindedAt   pgtype.Timestamptz `json:"reminded_at"`
+}
+
+type CategoryRule struct {
+	ID    int64  `json:"id"`
+	Owner string `json:"owner"`
+	// matched case-insensitively against the transfer description
+	Keyword               pgtype.Text `json:"keyword"`
+	CounterpartyAccountID pgtype.Int8 `json:"counterparty_account_id"`
+	Category              string      `json:"category"`
+	CreatedAt             time.Time   `json:"created_at"`
+}
+
+type DataRequest struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// export or erasure
+	Kind        string             `json:"kind"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+}
+
+type DirectDebit struct {
+	ID                   int64              `json:"id"`
+	MandateID            int64              `json:"mandate_id"`
+	TransferID           int64              `json:"transfer_id"`
+	Amount               int64              `json:"amount"`
+	Status               string             `json:"status"`
+	ChargebackTransferID pgtype.Int8        `json:"chargeback_transfer_id"`
+	CollectedAt          time.Time          `json:"collected_at"`
+	ChargedBackAt        pgtype.Timestamptz `json:"charged_back_at"`
+}
+
 type Entry struct {
 	ID        int64 `json:"id"`
 	AccountID int64 `json:"account_id"`
@@ -27,15 +121,215 @@ type Entry struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// transfers held back by a fraud rule until a banker reviews them
+type FraudCase struct {
+	ID            int64  `json:"id"`
+	Username      string `json:"username"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Description   string `json:"description"`
+	// name of the fraud rule that held back the transfer
+	Rule string `json:"rule"`
+	// what the rules were evaluated against
+	Features []byte `json:"features"`
+	// open, approved or rejected
+	Status         string             `json:"status"`
+	ResolvedBy     pgtype.Text        `json:"resolved_by"`
+	ResolutionNote pgtype.Text        `json:"resolution_note"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+	// the transfer made once the case was approved
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type Impersonation struct {
+	ID           uuid.UUID `json:"id"`
+	Impersonator string    `json:"impersonator"`
+	// the impersonation ends when the session of the impersonator is blocked
+	ImpersonatorSessionID uuid.UUID `json:"impersonator_session_id"`
+	Username              string    `json:"username"`
+	Reason                string    `json:"reason"`
+	ReadOnly              bool      `json:"read_only"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+type KycDocument struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// identity or proof_of_address
+	Kind string `json:"kind"`
+	// key of the uploaded file in the blob store
+	BlobKey     string `json:"blob_key"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+	// pending, approved or rejected
+	Status          string             `json:"status"`
+	RejectionReason pgtype.Text        `json:"rejection_reason"`
+	ReviewedBy      pgtype.Text        `json:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt       time.Time          `json:"created_at"`
+}
+
+type LoginAttempt struct {
+	ID int64 `json:"id"`
+	// as entered, attempts for unknown usernames are recorded too
+	Username      string      `json:"username"`
+	ClientIp      string      `json:"client_ip"`
+	UserAgent     string      `json:"user_agent"`
+	Succeeded     bool        `json:"succeeded"`
+	FailureReason pgtype.Text `json:"failure_reason"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type Mandate struct {
+	ID                int64  `json:"id"`
+	Debtor            string `json:"debtor"`
+	DebtorAccountID   int64  `json:"debtor_account_id"`
+	Creditor          string `json:"creditor"`
+	CreditorAccountID int64  `json:"creditor_account_id"`
+	// maximum amount per collection, must be positive
+	MaxAmount int64  `json:"max_amount"`
+	Currency  string `json:"currency"`
+	// at most one collection per daily, weekly, monthly or yearly period
+	Frequency       string             `json:"frequency"`
+	Reference       pgtype.Text        `json:"reference"`
+	Status          string             `json:"status"`
+	LastCollectedAt pgtype.Timestamptz `json:"last_collected_at"`
+	CreatedAt       time.Time          `json:"created_at"`
+	RevokedAt       pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type MfaChallenge struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// number of codes submitted, limited to prevent guessing
+	Attempts    int32              `json:"attempts"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type PasswordReset struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// SHA-256 of the token sent by email, the token itself is never stored
+	HashedToken string    `json:"hashed_token"`
+	IsUsed      bool      `json:"is_used"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+type PaymentRequest struct {
+	ID        int64  `json:"id"`
+	Requester string `json:"requester"`
+	// null for shareable links until someone pays
+	Payer       pgtype.Text `json:"payer"`
+	IsLink      bool        `json:"is_link"`
+	ToAccountID int64       `json:"to_account_id"`
+	// must be positive
+	Amount      int64              `json:"amount"`
+	Currency    string             `json:"currency"`
+	Description pgtype.Text        `json:"description"`
+	Status      string             `json:"status"`
+	TransferID  pgtype.Int8        `json:"transfer_id"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+	ResolvedAt  pgtype.Timestamptz `json:"resolved_at"`
+	BillSplitID pgtype.Int8        `json:"bill_split_id"`
+}
+
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RecoveryCode struct {
+	ID         int64              `json:"id"`
+	Username   string             `json:"username"`
+	HashedCode string             `json:"hashed_code"`
+	UsedAt     pgtype.Timestamptz `json:"used_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type Role struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// permissions are resolved on every request, changes take effect immediately
+type RolePermission struct {
+	Role       string `json:"role"`
+	Permission string `json:"permission"`
+}
+
+// users whose name matched the sanctions watchlist, waiting for a banker to review them
+type ScreeningCase struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// signup, name_change or payee
+	Trigger      string `json:"trigger"`
+	ScreenedName string `json:"screened_name"`
+	// id of the matched watchlist entry
+	EntryID string `json:"entry_id"`
+	// name or alias of the watchlist entry that matched
+	EntryName string   `json:"entry_name"`
+	Programs  []string `json:"programs"`
+	// Jaro-Winkler similarity of the names, between 0 and 1
+	Score float64 `json:"score"`
+	// open, cleared as a false positive or confirmed as a true match
+	Status         string             `json:"status"`
+	ResolvedBy     pgtype.Text        `json:"resolved_by"`
+	ResolutionNote pgtype.Text        `json:"resolution_note"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+type ServiceClient struct {
+	ID    string `json:"id"`
+	Owner string `json:"owner"`
+	Name  string `json:"name"`
+	// permissions of the owner the client can use
+	Scopes []string `json:"scopes"`
+	// accounts of the owner the client can use, empty for all of them
+	AccountIds []int64            `json:"account_ids"`
+	DisabledAt pgtype.Timestamptz `json:"disabled_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type ServiceClientKey struct {
+	ID           int64  `json:"id"`
+	ClientID     string `json:"client_id"`
+	HashedSecret string `json:"hashed_secret"`
+	// set when the key is rotated, so integrations can switch to the new key in the meantime
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
 type Session struct {
-	ID           uuid.UUID        `json:"id"`
-	Username     string           `json:"username"`
-	RefreshToken string           `json:"refresh_token"`
-	UserAgent    string           `json:"user_agent"`
-	ClientIp     string           `json:"client_ip"`
-	IsBlocked    bool             `json:"is_blocked"`
-	ExpiresAt    pgtype.Timestamp `json:"expires_at"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	// SHA-256 of the latest refresh token of the session, rotated on every renewal
+	HashedRefreshToken string           `json:"hashed_refresh_token"`
+	UserAgent          string           `json:"user_agent"`
+	ClientIp           string           `json:"client_ip"`
+	IsBlocked          bool             `json:"is_blocked"`
+	ExpiresAt          pgtype.Timestamp `json:"expires_at"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+}
+
+type TotpCredential struct {
+	Username string `json:"username"`
+	Secret   string `json:"secret"`
+	// time step of the last accepted code, codes cannot be replayed
+	LastUsedStep int64 `json:"last_used_step"`
+	// two-factor authentication is enabled once enrollment is confirmed with a code
+	ConfirmedAt pgtype.Timestamptz `json:"confirmed_at"`
+	CreatedAt   time.Time          `json:"created_at"`
 }
 
 type Transfer struct {
@@ -46,17 +340,45 @@ type Transfer struct {
 	Amount      int64       `json:"amount"`
 	CreatedAt   time.Time   `json:"created_at"`
 	Description pgtype.Text `json:"description"`
+	// spending category of the sender, null for transfers made before categorization
+	Category pgtype.Text `json:"category"`
 }
 
 type User struct {
-	Username          string    `json:"username"`
-	HashedPassword    string    `json:"hashed_password"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	CreatedAt         time.Time `json:"created_at"`
-	PasswordChangedAt time.Time `json:"password_changed_at"`
-	IsEmailVerified   bool      `json:"is_email_verified"`
-	Role              string    `json:"role"`
+	Username          string             `json:"username"`
+	HashedPassword    string             `json:"hashed_password"`
+	FullName          string             `json:"full_name"`
+	Email             string             `json:"email"`
+	CreatedAt         time.Time          `json:"created_at"`
+	PasswordChangedAt time.Time          `json:"password_changed_at"`
+	IsEmailVerified   bool               `json:"is_email_verified"`
+	Role              string             `json:"role"`
+	LockedUntil       pgtype.Timestamptz `json:"locked_until"`
+	// failed logins before a banker unlocked the user no longer count towards a lockout
+	UnlockedAt              pgtype.Timestamptz `json:"unlocked_at"`
+	VerificationEmailSentAt pgtype.Timestamptz `json:"verification_email_sent_at"`
+	// personal data has been removed and the user renamed to a pseudonym
+	ErasedAt pgtype.Timestamptz `json:"erased_at"`
+	// limits of what the user can do, raised by approved documents
+	KycTier int32 `json:"kyc_tier"`
+	// none, pending_review, approved or rejected
+	KycStatus string `json:"kyc_status"`
+	// active, suspended or deactivated, only active users can log in
+	Status string `json:"status"`
+	// banker who created the user on behalf of the customer
+	InvitedBy pgtype.Text `json:"invited_by"`
+	// set while the user matches the sanctions watchlist, restricted users cannot move money
+	RestrictedAt pgtype.Timestamptz `json:"restricted_at"`
+}
+
+type UserStatusChange struct {
+	ID             int64     `json:"id"`
+	Username       string    `json:"username"`
+	PreviousStatus string    `json:"previous_status"`
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason"`
+	ChangedBy      string    `json:"changed_by"`
+	CreatedAt      time.Time `json:"created_at"`
 }
 
 type VerifyEmail struct {
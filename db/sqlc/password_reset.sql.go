@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: password_reset.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createPasswordReset = `-- name: CreatePasswordReset :one
+INSERT INTO "password_resets" (username, hashed_token)
+VALUES ($1, $2)
+RETURNING id, username, hashed_token, is_used, created_at, expired_at
+`
+
+type CreatePasswordResetParams struct {
+	Username    string `json:"username"`
+	HashedToken string `json:"hashed_token"`
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, createPasswordReset, arg.Username, arg.HashedToken)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedToken,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const createPasswordResetWithExpiry = `-- name: CreatePasswordResetWithExpiry :one
+INSERT INTO "password_resets" (username, hashed_token, expired_at)
+VALUES ($1, $2, $3)
+RETURNING id, username, hashed_token, is_used, created_at, expired_at
+`
+
+type CreatePasswordResetWithExpiryParams struct {
+	Username    string    `json:"username"`
+	HashedToken string    `json:"hashed_token"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+func (q *Queries) CreatePasswordResetWithExpiry(ctx context.Context, arg CreatePasswordResetWithExpiryParams) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, createPasswordResetWithExpiry, arg.Username, arg.HashedToken, arg.ExpiredAt)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedToken,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const invalidatePasswordResets = `-- name: InvalidatePasswordResets :exec
+UPDATE "password_resets"
+SET is_used = TRUE
+WHERE username = $1
+  AND is_used = FALSE
+`
+
+func (q *Queries) InvalidatePasswordResets(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, invalidatePasswordResets, username)
+	return err
+}
+
+const usePasswordReset = `-- name: UsePasswordReset :one
+UPDATE "password_resets"
+SET is_used = TRUE
+WHERE hashed_token = $1
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING id, username, hashed_token, is_used, created_at, expired_at
+`
+
+func (q *Queries) UsePasswordReset(ctx context.Context, hashedToken string) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, usePasswordReset, hashedToken)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedToken,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
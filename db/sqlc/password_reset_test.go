@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestResetPasswordTx(t *testing.T) {
+	user := createRandomUser(t)
+
+	session, err := testStore.CreateSession(context.Background(), CreateSessionParams{
+		ID:                 uuid.New(),
+		Username:           user.Username,
+		HashedRefreshToken: util.HashToken(util.RandomString(32)),
+		UserAgent:          "test",
+		ClientIp:           "127.0.0.1",
+		ExpiresAt:          pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	hashedTokens := []string{util.HashToken(util.RandomString(32)), util.HashToken(util.RandomString(32))}
+	for _, hashedToken := range hashedTokens {
+		_, err = testStore.CreatePasswordReset(context.Background(), CreatePasswordResetParams{
+			Username:    user.Username,
+			HashedToken: hashedToken,
+		})
+		require.NoError(t, err)
+	}
+
+	hashedPassword, err := util.HashPassword(util.RandomString(8))
+	require.NoError(t, err)
+
+	result, err := testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		HashedToken:    hashedTokens[0],
+		HashedPassword: hashedPassword,
+	})
+	require.NoError(t, err)
+	require.Equal(t, hashedPassword, result.User.HashedPassword)
+	require.True(t, result.User.PasswordChangedAt.After(user.PasswordChangedAt))
+	require.True(t, result.PasswordReset.IsUsed)
+
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+
+	// neither the used token nor any other outstanding token can be used again
+	for _, hashedToken := range hashedTokens {
+		_, err = testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+			HashedToken:    hashedToken,
+			HashedPassword: hashedPassword,
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	}
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"simplebank/pb"
+	"simplebank/util"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const paymentRequestStatusPrefix = "PAYMENT_REQUEST_STATUS_"
+
+// EffectiveStatus returns the stored status, reporting pending requests past their expiry as expired.
+func (p PaymentRequest) EffectiveStatus() string {
+	if p.Status == util.PaymentRequestPending && time.Now().After(p.ExpiresAt) {
+		return util.PaymentRequestExpired
+	}
+
+	return p.Status
+}
+
+func (p PaymentRequest) ToResponse() *pb.PaymentRequest {
+	response := &pb.PaymentRequest{
+		Id:        p.ID,
+		Requester: p.Requester,
+		IsLink:    p.IsLink,
+		Amount:    p.Amount,
+		Currency:  pb.Currency(pb.Currency_value[p.Currency]),
+		Status:    PaymentRequestStatusToResponse(p.EffectiveStatus()),
+		ExpiresAt: timestamppb.New(p.ExpiresAt),
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+
+	if p.Payer.Valid {
+		response.Payer = &p.Payer.String
+	}
+
+	if p.Description.Valid {
+		response.Description = &p.Description.String
+	}
+
+	if p.TransferID.Valid {
+		response.TransferId = &p.TransferID.Int64
+	}
+
+	if p.ResolvedAt.Valid {
+		response.ResolvedAt = timestamppb.New(p.ResolvedAt.Time)
+	}
+
+	if p.BillSplitID.Valid {
+		response.BillSplitId = &p.BillSplitID.Int64
+	}
+
+	return response
+}
+
+// PaymentRequestStatusToResponse maps a stored payment request status to its API representation.
+func PaymentRequestStatusToResponse(status string) pb.PaymentRequestStatus {
+	return pb.PaymentRequestStatus(pb.PaymentRequestStatus_value[paymentRequestStatusPrefix+strings.ToUpper(status)])
+}
+
+// PaymentRequestStatusFromRequest maps an API payment request status to its stored representation.
+func PaymentRequestStatusFromRequest(status pb.PaymentRequestStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), paymentRequestStatusPrefix))
+}
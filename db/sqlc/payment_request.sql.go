@@ -0,0 +1,268 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payment_request.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPaymentRequest = `-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (requester, payer, is_link, to_account_id, amount, currency, description, expires_at,
+                              bill_split_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+`
+
+type CreatePaymentRequestParams struct {
+	Requester   string      `json:"requester"`
+	Payer       pgtype.Text `json:"payer"`
+	IsLink      bool        `json:"is_link"`
+	ToAccountID int64       `json:"to_account_id"`
+	Amount      int64       `json:"amount"`
+	Currency    string      `json:"currency"`
+	Description pgtype.Text `json:"description"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	BillSplitID pgtype.Int8 `json:"bill_split_id"`
+}
+
+func (q *Queries) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, createPaymentRequest,
+		arg.Requester,
+		arg.Payer,
+		arg.IsLink,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Description,
+		arg.ExpiresAt,
+		arg.BillSplitID,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.IsLink,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.BillSplitID,
+	)
+	return i, err
+}
+
+const getPaymentRequest = `-- name: GetPaymentRequest :one
+SELECT id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, getPaymentRequest, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.IsLink,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.BillSplitID,
+	)
+	return i, err
+}
+
+const getPaymentRequestForUpdate = `-- name: GetPaymentRequestForUpdate :one
+SELECT id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, getPaymentRequestForUpdate, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.IsLink,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.BillSplitID,
+	)
+	return i, err
+}
+
+const listBillSplitPaymentRequests = `-- name: ListBillSplitPaymentRequests :many
+SELECT id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+FROM payment_requests
+WHERE bill_split_id = ANY ($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListBillSplitPaymentRequests(ctx context.Context, billSplitIds []int64) ([]PaymentRequest, error) {
+	rows, err := q.db.Query(ctx, listBillSplitPaymentRequests, billSplitIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.IsLink,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.BillSplitID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentRequests = `-- name: ListPaymentRequests :many
+SELECT id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+FROM payment_requests
+WHERE (requester = $1 OR payer = $1)
+  AND ($2::varchar IS NULL OR
+       CASE WHEN status = 'pending' AND expires_at <= now() THEN 'expired' ELSE status END = $2)
+  AND ($3::bigint IS NULL OR id < $3)
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListPaymentRequestsParams struct {
+	Username string      `json:"username"`
+	Status   pgtype.Text `json:"status"`
+	BeforeID pgtype.Int8 `json:"before_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+func (q *Queries) ListPaymentRequests(ctx context.Context, arg ListPaymentRequestsParams) ([]PaymentRequest, error) {
+	rows, err := q.db.Query(ctx, listPaymentRequests,
+		arg.Username,
+		arg.Status,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.IsLink,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Description,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.BillSplitID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolvePaymentRequest = `-- name: ResolvePaymentRequest :one
+UPDATE payment_requests
+SET status      = $1,
+    payer       = coalesce($2, payer),
+    transfer_id = coalesce($3, transfer_id),
+    resolved_at = now()
+WHERE id = $4
+  AND status = 'pending'
+RETURNING id, requester, payer, is_link, to_account_id, amount, currency, description, status, transfer_id, expires_at, created_at, resolved_at, bill_split_id
+`
+
+type ResolvePaymentRequestParams struct {
+	Status     string      `json:"status"`
+	Payer      pgtype.Text `json:"payer"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
+	ID         int64       `json:"id"`
+}
+
+func (q *Queries) ResolvePaymentRequest(ctx context.Context, arg ResolvePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRow(ctx, resolvePaymentRequest,
+		arg.Status,
+		arg.Payer,
+		arg.TransferID,
+		arg.ID,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.IsLink,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Description,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+		&i.BillSplitID,
+	)
+	return i, err
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomPaymentRequest(t *testing.T, toAccount Account, payer string) PaymentRequest {
+	arg := CreatePaymentRequestParams{
+		Requester:   toAccount.Owner,
+		Payer:       pgtype.Text{String: payer, Valid: payer != ""},
+		IsLink:      payer == "",
+		ToAccountID: toAccount.ID,
+		Amount:      util.RandomMoney(),
+		Currency:    toAccount.Currency,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	paymentRequest, err := testStore.CreatePaymentRequest(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, paymentRequest)
+
+	require.Equal(t, arg.Requester, paymentRequest.Requester)
+	require.Equal(t, arg.Payer, paymentRequest.Payer)
+	require.Equal(t, arg.IsLink, paymentRequest.IsLink)
+	require.Equal(t, arg.ToAccountID, paymentRequest.ToAccountID)
+	require.Equal(t, arg.Amount, paymentRequest.Amount)
+	require.Equal(t, util.PaymentRequestPending, paymentRequest.Status)
+	require.False(t, paymentRequest.ResolvedAt.Valid)
+
+	require.NotZero(t, paymentRequest.ID)
+	require.NotZero(t, paymentRequest.CreatedAt)
+
+	return paymentRequest
+}
+
+func TestCreatePaymentRequest(t *testing.T) {
+	toAccount := createRandomAccount(t)
+	payer := createRandomUser(t)
+	createRandomPaymentRequest(t, toAccount, payer.Username)
+}
+
+func TestResolvePaymentRequest(t *testing.T) {
+	toAccount := createRandomAccount(t)
+	payer := createRandomUser(t)
+	paymentRequest1 := createRandomPaymentRequest(t, toAccount, payer.Username)
+
+	paymentRequest2, err := testStore.ResolvePaymentRequest(context.Background(), ResolvePaymentRequestParams{
+		ID:     paymentRequest1.ID,
+		Status: util.PaymentRequestDeclined,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestDeclined, paymentRequest2.Status)
+	require.Equal(t, paymentRequest1.Payer, paymentRequest2.Payer)
+	require.True(t, paymentRequest2.ResolvedAt.Valid)
+
+	// Resolved requests cannot be resolved again
+	_, err = testStore.ResolvePaymentRequest(context.Background(), ResolvePaymentRequestParams{
+		ID:     paymentRequest1.ID,
+		Status: util.PaymentRequestCancelled,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	toAccount := createRandomAccount(t)
+	fromAccount := createRandomAccount(t)
+	paymentRequest := createRandomPaymentRequest(t, toAccount, "")
+
+	result, err := testStore.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            paymentRequest.ID,
+		Payer:         fromAccount.Owner,
+		FromAccountID: fromAccount.ID,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, util.PaymentRequestAccepted, result.PaymentRequest.Status)
+	require.Equal(t, fromAccount.Owner, result.PaymentRequest.Payer.String)
+	require.Equal(t, result.Transfer.ID, result.PaymentRequest.TransferID.Int64)
+	require.Equal(t, paymentRequest.Amount, result.Transfer.Amount)
+	require.Equal(t, fromAccount.Balance-paymentRequest.Amount, result.FromAccount.Balance)
+	require.Equal(t, toAccount.Balance+paymentRequest.Amount, result.ToAccount.Balance)
+
+	_, err = testStore.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            paymentRequest.ID,
+		Payer:         fromAccount.Owner,
+		FromAccountID: fromAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+}
@@ -6,31 +6,194 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	// Lets EraseAuditEvents change the append-only audit log until the end of the transaction.
+	AllowAuditEventErasure(ctx context.Context) error
+	AttemptMfaChallenge(ctx context.Context, arg AttemptMfaChallengeParams) (MfaChallenge, error)
+	BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error)
+	BlockSession(ctx context.Context, arg BlockSessionParams) (Session, error)
+	BlockSessions(ctx context.Context, username string) (int64, error)
+	ChargebackDirectDebit(ctx context.Context, arg ChargebackDirectDebitParams) (DirectDebit, error)
+	CloseAccounts(ctx context.Context, owner string) ([]Account, error)
+	CompleteDataRequest(ctx context.Context, id int64) (DataRequest, error)
+	CompleteMfaChallenge(ctx context.Context, id int64) (MfaChallenge, error)
+	ConfirmTotpCredential(ctx context.Context, username string) (TotpCredential, error)
+	CountLoginFailuresByIP(ctx context.Context, arg CountLoginFailuresByIPParams) (int64, error)
+	CountOpenAccountsByOwner(ctx context.Context, owner string) (int64, error)
+	// Counts the open and confirmed cases of a user, the user stays restricted while there are any.
+	CountRestrictingScreeningCases(ctx context.Context, username string) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAmlReport(ctx context.Context, arg CreateAmlReportParams) (AmlReport, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	CreateBillSplit(ctx context.Context, arg CreateBillSplitParams) (BillSplit, error)
+	CreateCategoryRule(ctx context.Context, arg CreateCategoryRuleParams) (CategoryRule, error)
+	CreateDataErasureRequest(ctx context.Context, username string) (DataRequest, error)
+	CreateDataExportRequest(ctx context.Context, arg CreateDataExportRequestParams) (DataRequest, error)
+	CreateDirectDebit(ctx context.Context, arg CreateDirectDebitParams) (DirectDebit, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFraudCase(ctx context.Context, arg CreateFraudCaseParams) (FraudCase, error)
+	CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (Impersonation, error)
+	// Users can only have one document of each kind waiting for review at a time.
+	CreateKycDocument(ctx context.Context, arg CreateKycDocumentParams) (KycDocument, error)
+	CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error)
+	CreateMandate(ctx context.Context, arg CreateMandateParams) (Mandate, error)
+	CreateMfaChallenge(ctx context.Context, arg CreateMfaChallengeParams) (MfaChallenge, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
+	CreatePasswordResetWithExpiry(ctx context.Context, arg CreatePasswordResetWithExpiryParams) (PasswordReset, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) (RecoveryCode, error)
+	// Matches that were already recorded for the name, including cleared ones, are not raised again.
+	CreateScreeningCase(ctx context.Context, arg CreateScreeningCaseParams) (int64, error)
+	CreateServiceClient(ctx context.Context, arg CreateServiceClientParams) (ServiceClient, error)
+	CreateServiceClientKey(ctx context.Context, arg CreateServiceClientKeyParams) (ServiceClientKey, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserStatusChange(ctx context.Context, arg CreateUserStatusChangeParams) (UserStatusChange, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteCategoryRule(ctx context.Context, id int64) error
+	// Returns the keys of the deleted documents, their files have to be removed from the blob store.
+	DeleteKycDocuments(ctx context.Context, username string) ([]string, error)
+	DeleteLoginAttempts(ctx context.Context, username string) error
+	DeleteRecoveryCodes(ctx context.Context, username string) error
+	DeleteTotpCredential(ctx context.Context, username string) error
+	DisableServiceClient(ctx context.Context, arg DisableServiceClientParams) (ServiceClient, error)
+	// Replaces the user with the pseudonym in the events about them. What the user sent and where from is
+	// removed, events of employees acting on the user keep their actor but not the request.
+	EraseAuditEvents(ctx context.Context, arg EraseAuditEventsParams) error
+	// The matched watchlist entry and the review stay as the record of the screening, only the name that was screened
+	// is removed. The case id keeps the placeholder unique for the entry.
+	EraseScreeningCases(ctx context.Context, username string) error
+	EraseSessions(ctx context.Context, username string) error
+	// Renaming the user to the pseudonym cascades to every table referring to them.
+	EraseUser(ctx context.Context, arg EraseUserParams) (User, error)
+	EraseVerifyEmails(ctx context.Context, arg EraseVerifyEmailsParams) error
+	// Keeps the current keys of the client valid until the end of the overlap, keys expiring sooner keep their expiry.
+	ExpireServiceClientKeys(ctx context.Context, arg ExpireServiceClientKeysParams) (int64, error)
+	FreezeAccounts(ctx context.Context, owner string) ([]Account, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountByNumber(ctx context.Context, number string) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAmlReport(ctx context.Context, id int64) (AmlReport, error)
+	GetAmlScanWatermark(ctx context.Context) (time.Time, error)
+	GetBillSplit(ctx context.Context, id int64) (BillSplit, error)
+	GetCategoryRule(ctx context.Context, id int64) (CategoryRule, error)
+	GetDataRequest(ctx context.Context, id int64) (DataRequest, error)
+	GetDirectDebit(ctx context.Context, id int64) (DirectDebit, error)
+	GetDirectDebitForUpdate(ctx context.Context, id int64) (DirectDebit, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetFraudCase(ctx context.Context, id int64) (FraudCase, error)
+	GetFraudCaseForUpdate(ctx context.Context, id int64) (FraudCase, error)
+	GetImpersonation(ctx context.Context, id uuid.UUID) (Impersonation, error)
+	GetKycDocument(ctx context.Context, id int64) (KycDocument, error)
+	GetKycDocumentForUpdate(ctx context.Context, id int64) (KycDocument, error)
+	// Counts the failures of a username since the start of the window,
+	// ignoring those before its last successful login or unlock.
+	GetLoginFailureStats(ctx context.Context, arg GetLoginFailureStatsParams) (GetLoginFailureStatsRow, error)
+	GetMandate(ctx context.Context, id int64) (Mandate, error)
+	GetMandateForUpdate(ctx context.Context, id int64) (Mandate, error)
+	GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error)
+	GetScreeningCase(ctx context.Context, id int64) (ScreeningCase, error)
+	GetScreeningCaseForUpdate(ctx context.Context, id int64) (ScreeningCase, error)
+	GetServiceClient(ctx context.Context, id string) (ServiceClient, error)
+	// Returns the key unless it has expired after a rotation.
+	GetServiceClientKey(ctx context.Context, arg GetServiceClientKeyParams) (ServiceClientKey, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSpendingByCategory(ctx context.Context, arg GetSpendingByCategoryParams) ([]GetSpendingByCategoryRow, error)
+	GetSpendingByCounterparty(ctx context.Context, arg GetSpendingByCounterpartyParams) ([]GetSpendingByCounterpartyRow, error)
+	GetSpendingByMonth(ctx context.Context, arg GetSpendingByMonthParams) ([]GetSpendingByMonthRow, error)
+	GetTotpCredential(ctx context.Context, username string) (TotpCredential, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	// Summarizes what the owner sent from any of their accounts in the last 90 days for the fraud rules.
+	GetTransferHistory(ctx context.Context, arg GetTransferHistoryParams) (GetTransferHistoryRow, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserForUpdate(ctx context.Context, username string) (User, error)
+	GetUserStatus(ctx context.Context, username string) (string, error)
+	InvalidatePasswordResets(ctx context.Context, username string) error
+	// Reports whether the user had never logged in with the user agent of the session before it.
+	IsNewDeviceSession(ctx context.Context, id uuid.UUID) (pgtype.Bool, error)
+	LiftUserRestriction(ctx context.Context, username string) (User, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsByOwner(ctx context.Context, owner string) ([]Account, error)
+	ListAccountsByOwnerForUpdate(ctx context.Context, owner string) ([]Account, error)
+	ListActiveSessions(ctx context.Context, username string) ([]Session, error)
+	// Lists the newest reports first.
+	ListAmlReports(ctx context.Context, arg ListAmlReportsParams) ([]AmlReport, error)
+	// Lists the newest events first, the actor matches both the user and the impersonator of a call.
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListBillSplitPaymentRequests(ctx context.Context, billSplitIds []int64) ([]PaymentRequest, error)
+	ListBillSplits(ctx context.Context, arg ListBillSplitsParams) ([]BillSplit, error)
+	ListCategoryRules(ctx context.Context, owner string) ([]CategoryRule, error)
+	ListDirectDebits(ctx context.Context, arg ListDirectDebitsParams) ([]DirectDebit, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	// Lists the cases with the status, oldest first so that the longest held transfers are reviewed first.
+	ListFraudCases(ctx context.Context, arg ListFraudCasesParams) ([]FraudCase, error)
+	// Includes how many calls were made under each impersonation, so users can tell looking from acting.
+	ListImpersonations(ctx context.Context, arg ListImpersonationsParams) ([]ListImpersonationsRow, error)
+	ListKycDocumentsByUsername(ctx context.Context, username string) ([]KycDocument, error)
+	ListLoginAttempts(ctx context.Context, arg ListLoginAttemptsParams) ([]LoginAttempt, error)
+	ListMandates(ctx context.Context, arg ListMandatesParams) ([]Mandate, error)
+	ListPaymentRequests(ctx context.Context, arg ListPaymentRequestsParams) ([]PaymentRequest, error)
+	// Lists the documents waiting for review, oldest first.
+	ListPendingKycDocuments(ctx context.Context, arg ListPendingKycDocumentsParams) ([]KycDocument, error)
+	ListRolePermissions(ctx context.Context, role string) ([]string, error)
+	ListRoles(ctx context.Context) ([]ListRolesRow, error)
+	ListScreeningCases(ctx context.Context, arg ListScreeningCasesParams) ([]ScreeningCase, error)
+	ListServiceClientKeys(ctx context.Context, clientID string) ([]ServiceClientKey, error)
+	ListServiceClients(ctx context.Context, owner string) ([]ServiceClient, error)
+	ListSessionsByUsername(ctx context.Context, username string) ([]Session, error)
+	// Groups the transfers to other people with an amount in [min_amount, max_amount) that no structuring report covers
+	// yet by sender and currency, returning the senders who made at least min_transfers of them.
+	ListStructuringCandidates(ctx context.Context, arg ListStructuringCandidatesParams) ([]ListStructuringCandidatesRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListTransfersByAccounts(ctx context.Context, accountIds []int64) ([]Transfer, error)
+	ListTransfersByIDs(ctx context.Context, ids []int64) ([]Transfer, error)
+	// Lists transfers to other people of at least the amount that no large transaction report covers yet.
+	ListUnreportedLargeTransfers(ctx context.Context, arg ListUnreportedLargeTransfersParams) ([]ListUnreportedLargeTransfersRow, error)
+	ListUserStatusChanges(ctx context.Context, username string) ([]UserStatusChange, error)
+	LockUser(ctx context.Context, arg LockUserParams) (User, error)
+	MarkBillSplitReminded(ctx context.Context, arg MarkBillSplitRemindedParams) (BillSplit, error)
+	MarkMandateCollected(ctx context.Context, id int64) (Mandate, error)
+	MarkVerificationEmailSent(ctx context.Context, arg MarkVerificationEmailSentParams) (User, error)
+	RehashUserPassword(ctx context.Context, arg RehashUserPasswordParams) (User, error)
+	ResolveFraudCase(ctx context.Context, arg ResolveFraudCaseParams) (FraudCase, error)
+	ResolvePaymentRequest(ctx context.Context, arg ResolvePaymentRequestParams) (PaymentRequest, error)
+	ResolveScreeningCase(ctx context.Context, arg ResolveScreeningCaseParams) (ScreeningCase, error)
+	RestrictUser(ctx context.Context, username string) (User, error)
+	ReviewKycDocument(ctx context.Context, arg ReviewKycDocumentParams) (KycDocument, error)
+	RevokeMandate(ctx context.Context, id int64) (Mandate, error)
+	RotateSessionRefreshToken(ctx context.Context, arg RotateSessionRefreshTokenParams) (Session, error)
+	// Lists the users matching all given filters ordered by username, the email has to match exactly.
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	// Moves the watermark forward only, so a slow run finishing after a later one does not move it back.
+	SetAmlScanWatermark(ctx context.Context, scannedUntil time.Time) error
+	// Adds up the amounts the owner sent from any of their accounts, regardless of currency.
+	SumTransfersFromOwnerSince(ctx context.Context, arg SumTransfersFromOwnerSinceParams) (int64, error)
+	TouchServiceClientKey(ctx context.Context, id int64) error
+	UnfreezeAccounts(ctx context.Context, owner string) ([]Account, error)
+	UnlockUser(ctx context.Context, username string) (User, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateTransferCategory(ctx context.Context, arg UpdateTransferCategoryParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserKyc(ctx context.Context, arg UpdateUserKycParams) (User, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpdateUserStatus(ctx context.Context, arg UpdateUserStatusParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertTotpCredential(ctx context.Context, arg UpsertTotpCredentialParams) (TotpCredential, error)
+	UsePasswordReset(ctx context.Context, hashedToken string) (PasswordReset, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (RecoveryCode, error)
+	UseTotpStep(ctx context.Context, arg UseTotpStepParams) (TotpCredential, error)
+	VerifyUserEmail(ctx context.Context, arg VerifyUserEmailParams) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
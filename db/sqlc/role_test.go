@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestListRolePermissions(t *testing.T) {
+	permissions, err := testStore.ListRolePermissions(context.Background(), util.AuditorRole)
+	require.NoError(t, err)
+	require.Contains(t, permissions, util.AccountsReadAnyPermission)
+	require.NotContains(t, permissions, "accounts:create")
+
+	permissions, err = testStore.ListRolePermissions(context.Background(), "unknown")
+	require.NoError(t, err)
+	require.Empty(t, permissions)
+}
+
+func TestListRoles(t *testing.T) {
+	roles, err := testStore.ListRoles(context.Background())
+	require.NoError(t, err)
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name
+		require.NotEmpty(t, role.Permissions)
+	}
+
+	require.Subset(t, names, []string{util.DepositorRole, util.BankerRole, util.AuditorRole, util.SupportRole, util.AdminRole})
+}
+
+func TestAssignUserRoleTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	result, err := testStore.AssignUserRoleTx(context.Background(), AssignUserRoleTxParams{
+		Username: user.Username,
+		Role:     util.AuditorRole,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AuditorRole, result.User.Role)
+
+	session, err = testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, session.IsBlocked)
+
+	_, err = testStore.AssignUserRoleTx(context.Background(), AssignUserRoleTxParams{
+		Username: user.Username,
+		Role:     "unknown",
+	})
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+}
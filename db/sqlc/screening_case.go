@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScreeningHit is a watchlist entry a screened name matched.
+type ScreeningHit struct {
+	EntryID   string
+	EntryName string
+	Programs  []string
+	Score     float64
+}
+
+func (screeningCase ScreeningCase) ToResponse() *pb.ScreeningCase {
+	response := &pb.ScreeningCase{
+		Id:           screeningCase.ID,
+		Username:     screeningCase.Username,
+		Trigger:      screeningCase.Trigger,
+		ScreenedName: screeningCase.ScreenedName,
+		EntryId:      screeningCase.EntryID,
+		EntryName:    screeningCase.EntryName,
+		Programs:     screeningCase.Programs,
+		Score:        screeningCase.Score,
+		Status:       screeningCase.Status,
+		CreatedAt:    timestamppb.New(screeningCase.CreatedAt),
+	}
+
+	if screeningCase.ResolvedBy.Valid {
+		response.ResolvedBy = &screeningCase.ResolvedBy.String
+	}
+
+	if screeningCase.ResolutionNote.Valid {
+		response.ResolutionNote = &screeningCase.ResolutionNote.String
+	}
+
+	if screeningCase.ResolvedAt.Valid {
+		response.ResolvedAt = timestamppb.New(screeningCase.ResolvedAt.Time)
+	}
+
+	return response
+}
+
+// flagScreeningHits opens a case for every hit on the name of a user whose row is locked by the transaction and
+// restricts the user if any of the hits is new. Hits recorded before, including cleared ones, are left alone.
+func flagScreeningHits(ctx context.Context, q *Queries, user User, trigger string, screenedName string, hits []ScreeningHit) (User, error) {
+	var created int64
+
+	for _, hit := range hits {
+		programs := hit.Programs
+		if programs == nil {
+			programs = []string{}
+		}
+
+		rows, err := q.CreateScreeningCase(ctx, CreateScreeningCaseParams{
+			Username:     user.Username,
+			Trigger:      trigger,
+			ScreenedName: screenedName,
+			EntryID:      hit.EntryID,
+			EntryName:    hit.EntryName,
+			Programs:     programs,
+			Score:        hit.Score,
+		})
+		if err != nil {
+			return user, err
+		}
+
+		created += rows
+	}
+
+	if created == 0 {
+		return user, nil
+	}
+
+	return q.RestrictUser(ctx, user.Username)
+}
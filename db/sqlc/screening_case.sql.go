@@ -0,0 +1,222 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: screening_case.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countRestrictingScreeningCases = `-- name: CountRestrictingScreeningCases :one
+SELECT count(*)
+FROM screening_cases
+WHERE username = $1
+  AND status IN ('open', 'confirmed')
+`
+
+// Counts the open and confirmed cases of a user, the user stays restricted while there are any.
+func (q *Queries) CountRestrictingScreeningCases(ctx context.Context, username string) (int64, error) {
+	row := q.db.QueryRow(ctx, countRestrictingScreeningCases, username)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createScreeningCase = `-- name: CreateScreeningCase :execrows
+INSERT INTO screening_cases (username, trigger, screened_name, entry_id, entry_name, programs, score)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (username, entry_id, screened_name) DO NOTHING
+`
+
+type CreateScreeningCaseParams struct {
+	Username     string   `json:"username"`
+	Trigger      string   `json:"trigger"`
+	ScreenedName string   `json:"screened_name"`
+	EntryID      string   `json:"entry_id"`
+	EntryName    string   `json:"entry_name"`
+	Programs     []string `json:"programs"`
+	Score        float64  `json:"score"`
+}
+
+// Matches that were already recorded for the name, including cleared ones, are not raised again.
+func (q *Queries) CreateScreeningCase(ctx context.Context, arg CreateScreeningCaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createScreeningCase,
+		arg.Username,
+		arg.Trigger,
+		arg.ScreenedName,
+		arg.EntryID,
+		arg.EntryName,
+		arg.Programs,
+		arg.Score,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const eraseScreeningCases = `-- name: EraseScreeningCases :exec
+UPDATE screening_cases
+SET screened_name = 'erased-' || id
+WHERE username = $1
+`
+
+// The matched watchlist entry and the review stay as the record of the screening, only the name that was screened
+// is removed. The case id keeps the placeholder unique for the entry.
+func (q *Queries) EraseScreeningCases(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, eraseScreeningCases, username)
+	return err
+}
+
+const getScreeningCase = `-- name: GetScreeningCase :one
+SELECT id, username, trigger, screened_name, entry_id, entry_name, programs, score, status, resolved_by, resolution_note, resolved_at, created_at
+FROM screening_cases
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetScreeningCase(ctx context.Context, id int64) (ScreeningCase, error) {
+	row := q.db.QueryRow(ctx, getScreeningCase, id)
+	var i ScreeningCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Trigger,
+		&i.ScreenedName,
+		&i.EntryID,
+		&i.EntryName,
+		&i.Programs,
+		&i.Score,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getScreeningCaseForUpdate = `-- name: GetScreeningCaseForUpdate :one
+SELECT id, username, trigger, screened_name, entry_id, entry_name, programs, score, status, resolved_by, resolution_note, resolved_at, created_at
+FROM screening_cases
+WHERE id = $1
+LIMIT 1
+    FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetScreeningCaseForUpdate(ctx context.Context, id int64) (ScreeningCase, error) {
+	row := q.db.QueryRow(ctx, getScreeningCaseForUpdate, id)
+	var i ScreeningCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Trigger,
+		&i.ScreenedName,
+		&i.EntryID,
+		&i.EntryName,
+		&i.Programs,
+		&i.Score,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listScreeningCases = `-- name: ListScreeningCases :many
+SELECT id, username, trigger, screened_name, entry_id, entry_name, programs, score, status, resolved_by, resolution_note, resolved_at, created_at
+FROM screening_cases
+WHERE status = $1
+  AND ($2::bigint IS NULL OR id > $2)
+ORDER BY id
+LIMIT $3
+`
+
+type ListScreeningCasesParams struct {
+	Status   string      `json:"status"`
+	AfterID  pgtype.Int8 `json:"after_id"`
+	PageSize int32       `json:"page_size"`
+}
+
+func (q *Queries) ListScreeningCases(ctx context.Context, arg ListScreeningCasesParams) ([]ScreeningCase, error) {
+	rows, err := q.db.Query(ctx, listScreeningCases, arg.Status, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScreeningCase{}
+	for rows.Next() {
+		var i ScreeningCase
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Trigger,
+			&i.ScreenedName,
+			&i.EntryID,
+			&i.EntryName,
+			&i.Programs,
+			&i.Score,
+			&i.Status,
+			&i.ResolvedBy,
+			&i.ResolutionNote,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveScreeningCase = `-- name: ResolveScreeningCase :one
+UPDATE screening_cases
+SET status          = $1,
+    resolved_by     = $2,
+    resolution_note = $3,
+    resolved_at     = now()
+WHERE id = $4
+RETURNING id, username, trigger, screened_name, entry_id, entry_name, programs, score, status, resolved_by, resolution_note, resolved_at, created_at
+`
+
+type ResolveScreeningCaseParams struct {
+	Status         string      `json:"status"`
+	ResolvedBy     pgtype.Text `json:"resolved_by"`
+	ResolutionNote pgtype.Text `json:"resolution_note"`
+	ID             int64       `json:"id"`
+}
+
+func (q *Queries) ResolveScreeningCase(ctx context.Context, arg ResolveScreeningCaseParams) (ScreeningCase, error) {
+	row := q.db.QueryRow(ctx, resolveScreeningCase,
+		arg.Status,
+		arg.ResolvedBy,
+		arg.ResolutionNote,
+		arg.ID,
+	)
+	var i ScreeningCase
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Trigger,
+		&i.ScreenedName,
+		&i.EntryID,
+		&i.EntryName,
+		&i.Programs,
+		&i.Score,
+		&i.Status,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScreeningFlow(t *testing.T) {
+	banker := createRandomUser(t)
+	user := createRandomUser(t)
+	require.False(t, user.RestrictedAt.Valid)
+
+	hits := []ScreeningHit{
+		{EntryID: util.RandomString(6), EntryName: "BOUT, Viktor", Programs: []string{"SDGT"}, Score: 0.95},
+		{EntryID: util.RandomString(6), EntryName: "BUTT, Victor", Score: 0.93},
+	}
+
+	flag := func() User {
+		result, err := testStore.FlagScreeningHitsTx(context.Background(), FlagScreeningHitsTxParams{
+			Username:     user.Username,
+			Trigger:      util.ScreeningTriggerPayee,
+			ScreenedName: user.FullName,
+			Hits:         hits,
+		})
+		require.NoError(t, err)
+
+		return result.User
+	}
+
+	restricted := flag()
+	require.True(t, restricted.RestrictedAt.Valid)
+
+	cases, err := testStore.ListScreeningCases(context.Background(), ListScreeningCasesParams{
+		Status:   util.ScreeningCaseOpen,
+		PageSize: 1000,
+	})
+	require.NoError(t, err)
+
+	var userCases []ScreeningCase
+	for _, screeningCase := range cases {
+		if screeningCase.Username == user.Username {
+			userCases = append(userCases, screeningCase)
+		}
+	}
+	require.Len(t, userCases, 2)
+
+	resolve := func(id int64, confirm bool) ResolveScreeningCaseTxResult {
+		result, err := testStore.ResolveScreeningCaseTx(context.Background(), ResolveScreeningCaseTxParams{
+			ID:         id,
+			Confirm:    confirm,
+			ResolvedBy: banker.Username,
+			Note:       pgtype.Text{String: "checked the date of birth", Valid: true},
+		})
+		require.NoError(t, err)
+
+		return result
+	}
+
+	// the user stays restricted while one of the cases is open
+	result := resolve(userCases[0].ID, false)
+	require.Equal(t, util.ScreeningCaseCleared, result.ScreeningCase.Status)
+	require.True(t, result.User.RestrictedAt.Valid)
+
+	result = resolve(userCases[1].ID, false)
+	require.False(t, result.User.RestrictedAt.Valid)
+
+	_, err = testStore.ResolveScreeningCaseTx(context.Background(), ResolveScreeningCaseTxParams{
+		ID:         userCases[1].ID,
+		ResolvedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrScreeningCaseNotOpen)
+
+	// cleared hits on the same name are not raised again
+	require.False(t, flag().RestrictedAt.Valid)
+}
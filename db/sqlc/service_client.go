@@ -0,0 +1,42 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c ServiceClient) ToResponse(keys []ServiceClientKey) *pb.ServiceClient {
+	response := &pb.ServiceClient{
+		Id:         c.ID,
+		Name:       c.Name,
+		Scopes:     c.Scopes,
+		AccountIds: c.AccountIds,
+		Disabled:   c.DisabledAt.Valid,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		Keys:       make([]*pb.ServiceClientKey, len(keys)),
+	}
+
+	for i, key := range keys {
+		response.Keys[i] = key.ToResponse()
+	}
+
+	return response
+}
+
+func (k ServiceClientKey) ToResponse() *pb.ServiceClientKey {
+	response := &pb.ServiceClientKey{
+		Id:        k.ID,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+
+	if k.ExpiresAt.Valid {
+		response.ExpiresAt = timestamppb.New(k.ExpiresAt.Time)
+	}
+
+	if k.LastUsedAt.Valid {
+		response.LastUsedAt = timestamppb.New(k.LastUsedAt.Time)
+	}
+
+	return response
+}
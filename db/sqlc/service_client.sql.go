@@ -0,0 +1,253 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: service_client.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createServiceClient = `-- name: CreateServiceClient :one
+INSERT INTO service_clients (id, owner, name, scopes, account_ids)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, owner, name, scopes, account_ids, disabled_at, created_at
+`
+
+type CreateServiceClientParams struct {
+	ID         string   `json:"id"`
+	Owner      string   `json:"owner"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	AccountIds []int64  `json:"account_ids"`
+}
+
+func (q *Queries) CreateServiceClient(ctx context.Context, arg CreateServiceClientParams) (ServiceClient, error) {
+	row := q.db.QueryRow(ctx, createServiceClient,
+		arg.ID,
+		arg.Owner,
+		arg.Name,
+		arg.Scopes,
+		arg.AccountIds,
+	)
+	var i ServiceClient
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Scopes,
+		&i.AccountIds,
+		&i.DisabledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createServiceClientKey = `-- name: CreateServiceClientKey :one
+INSERT INTO service_client_keys (client_id, hashed_secret)
+VALUES ($1, $2)
+RETURNING id, client_id, hashed_secret, expires_at, last_used_at, created_at
+`
+
+type CreateServiceClientKeyParams struct {
+	ClientID     string `json:"client_id"`
+	HashedSecret string `json:"hashed_secret"`
+}
+
+func (q *Queries) CreateServiceClientKey(ctx context.Context, arg CreateServiceClientKeyParams) (ServiceClientKey, error) {
+	row := q.db.QueryRow(ctx, createServiceClientKey, arg.ClientID, arg.HashedSecret)
+	var i ServiceClientKey
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.HashedSecret,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const disableServiceClient = `-- name: DisableServiceClient :one
+UPDATE service_clients
+SET disabled_at = coalesce(disabled_at, now())
+WHERE id = $1
+  AND owner = $2
+RETURNING id, owner, name, scopes, account_ids, disabled_at, created_at
+`
+
+type DisableServiceClientParams struct {
+	ID    string `json:"id"`
+	Owner string `json:"owner"`
+}
+
+func (q *Queries) DisableServiceClient(ctx context.Context, arg DisableServiceClientParams) (ServiceClient, error) {
+	row := q.db.QueryRow(ctx, disableServiceClient, arg.ID, arg.Owner)
+	var i ServiceClient
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Scopes,
+		&i.AccountIds,
+		&i.DisabledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const expireServiceClientKeys = `-- name: ExpireServiceClientKeys :execrows
+UPDATE service_client_keys
+SET expires_at = least(coalesce(expires_at, $1), $1)
+WHERE client_id = $2
+  AND (expires_at IS NULL OR expires_at > now())
+`
+
+type ExpireServiceClientKeysParams struct {
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	ClientID  string             `json:"client_id"`
+}
+
+// Keeps the current keys of the client valid until the end of the overlap, keys expiring sooner keep their expiry.
+func (q *Queries) ExpireServiceClientKeys(ctx context.Context, arg ExpireServiceClientKeysParams) (int64, error) {
+	result, err := q.db.Exec(ctx, expireServiceClientKeys, arg.ExpiresAt, arg.ClientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getServiceClient = `-- name: GetServiceClient :one
+SELECT id, owner, name, scopes, account_ids, disabled_at, created_at
+FROM service_clients
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetServiceClient(ctx context.Context, id string) (ServiceClient, error) {
+	row := q.db.QueryRow(ctx, getServiceClient, id)
+	var i ServiceClient
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Name,
+		&i.Scopes,
+		&i.AccountIds,
+		&i.DisabledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getServiceClientKey = `-- name: GetServiceClientKey :one
+SELECT id, client_id, hashed_secret, expires_at, last_used_at, created_at
+FROM service_client_keys
+WHERE client_id = $1
+  AND hashed_secret = $2
+  AND (expires_at IS NULL OR expires_at > now())
+LIMIT 1
+`
+
+type GetServiceClientKeyParams struct {
+	ClientID     string `json:"client_id"`
+	HashedSecret string `json:"hashed_secret"`
+}
+
+// Returns the key unless it has expired after a rotation.
+func (q *Queries) GetServiceClientKey(ctx context.Context, arg GetServiceClientKeyParams) (ServiceClientKey, error) {
+	row := q.db.QueryRow(ctx, getServiceClientKey, arg.ClientID, arg.HashedSecret)
+	var i ServiceClientKey
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.HashedSecret,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listServiceClientKeys = `-- name: ListServiceClientKeys :many
+SELECT id, client_id, hashed_secret, expires_at, last_used_at, created_at
+FROM service_client_keys
+WHERE client_id = $1
+  AND (expires_at IS NULL OR expires_at > now())
+ORDER BY id DESC
+`
+
+func (q *Queries) ListServiceClientKeys(ctx context.Context, clientID string) ([]ServiceClientKey, error) {
+	rows, err := q.db.Query(ctx, listServiceClientKeys, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ServiceClientKey{}
+	for rows.Next() {
+		var i ServiceClientKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.HashedSecret,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServiceClients = `-- name: ListServiceClients :many
+SELECT id, owner, name, scopes, account_ids, disabled_at, created_at
+FROM service_clients
+WHERE owner = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListServiceClients(ctx context.Context, owner string) ([]ServiceClient, error) {
+	rows, err := q.db.Query(ctx, listServiceClients, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ServiceClient{}
+	for rows.Next() {
+		var i ServiceClient
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Name,
+			&i.Scopes,
+			&i.AccountIds,
+			&i.DisabledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchServiceClientKey = `-- name: TouchServiceClientKey :exec
+UPDATE service_client_keys
+SET last_used_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) TouchServiceClientKey(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, touchServiceClientKey, id)
+	return err
+}
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomServiceClient(t *testing.T, owner string) (CreateServiceClientTxResult, string) {
+	secret := util.RandomString(32)
+
+	result, err := testStore.CreateServiceClientTx(context.Background(), CreateServiceClientTxParams{
+		CreateServiceClientParams: CreateServiceClientParams{
+			ID:         uuid.NewString(),
+			Owner:      owner,
+			Name:       util.RandomString(8),
+			Scopes:     []string{"accounts:read"},
+			AccountIds: []int64{},
+		},
+		HashedSecret: util.HashToken(secret),
+	})
+	require.NoError(t, err)
+	require.Equal(t, owner, result.ServiceClient.Owner)
+	require.Equal(t, result.ServiceClient.ID, result.ServiceClientKey.ClientID)
+	require.False(t, result.ServiceClient.DisabledAt.Valid)
+	require.False(t, result.ServiceClientKey.ExpiresAt.Valid)
+
+	return result, secret
+}
+
+func TestGetServiceClientKey(t *testing.T) {
+	user := createRandomUser(t)
+	result, secret := createRandomServiceClient(t, user.Username)
+
+	key, err := testStore.GetServiceClientKey(context.Background(), GetServiceClientKeyParams{
+		ClientID:     result.ServiceClient.ID,
+		HashedSecret: util.HashToken(secret),
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.ServiceClientKey.ID, key.ID)
+
+	_, err = testStore.GetServiceClientKey(context.Background(), GetServiceClientKeyParams{
+		ClientID:     result.ServiceClient.ID,
+		HashedSecret: util.HashToken(util.RandomString(32)),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestRotateServiceClientKeyTx(t *testing.T) {
+	user := createRandomUser(t)
+	result, secret := createRandomServiceClient(t, user.Username)
+	newSecret := util.RandomString(32)
+
+	rotated, err := testStore.RotateServiceClientKeyTx(context.Background(), RotateServiceClientKeyTxParams{
+		ClientID:             result.ServiceClient.ID,
+		HashedSecret:         util.HashToken(newSecret),
+		PreviousKeysExpireAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.False(t, rotated.ServiceClientKey.ExpiresAt.Valid)
+
+	// The previous key keeps working during the overlap
+	previous, err := testStore.GetServiceClientKey(context.Background(), GetServiceClientKeyParams{
+		ClientID:     result.ServiceClient.ID,
+		HashedSecret: util.HashToken(secret),
+	})
+	require.NoError(t, err)
+	require.True(t, previous.ExpiresAt.Valid)
+	require.WithinDuration(t, time.Now().Add(time.Hour), previous.ExpiresAt.Time, time.Second)
+
+	// Rotating again without an overlap expires both previous keys right away
+	_, err = testStore.RotateServiceClientKeyTx(context.Background(), RotateServiceClientKeyTxParams{
+		ClientID:             result.ServiceClient.ID,
+		HashedSecret:         util.HashToken(util.RandomString(32)),
+		PreviousKeysExpireAt: time.Now().Add(-time.Second),
+	})
+	require.NoError(t, err)
+
+	for _, s := range []string{secret, newSecret} {
+		_, err = testStore.GetServiceClientKey(context.Background(), GetServiceClientKeyParams{
+			ClientID:     result.ServiceClient.ID,
+			HashedSecret: util.HashToken(s),
+		})
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	}
+
+	keys, err := testStore.ListServiceClientKeys(context.Background(), result.ServiceClient.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+}
+
+func TestDisableServiceClient(t *testing.T) {
+	user := createRandomUser(t)
+	other := createRandomUser(t)
+	result, _ := createRandomServiceClient(t, user.Username)
+
+	_, err := testStore.DisableServiceClient(context.Background(), DisableServiceClientParams{
+		ID:    result.ServiceClient.ID,
+		Owner: other.Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	client, err := testStore.DisableServiceClient(context.Background(), DisableServiceClientParams{
+		ID:    result.ServiceClient.ID,
+		Owner: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, client.DisabledAt.Valid)
+
+	clients, err := testStore.ListServiceClients(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	require.Equal(t, client.DisabledAt, clients[0].DisabledAt)
+}
@@ -0,0 +1,19 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Session) ToResponse(currentSessionID uuid.UUID) *pb.Session {
+	return &pb.Session{
+		Id:        s.ID.String(),
+		UserAgent: s.UserAgent,
+		ClientIp:  s.ClientIp,
+		CreatedAt: timestamppb.New(s.CreatedAt.Time),
+		ExpiresAt: timestamppb.New(s.ExpiresAt.Time),
+		Current:   s.ID == currentSessionID,
+	}
+}
@@ -12,27 +12,92 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const blockOtherSessions = `-- name: BlockOtherSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE username = $1
+  AND id <> $2
+  AND is_blocked = FALSE
+`
+
+type BlockOtherSessionsParams struct {
+	Username         string    `json:"username"`
+	CurrentSessionID uuid.UUID `json:"current_session_id"`
+}
+
+func (q *Queries) BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, blockOtherSessions, arg.Username, arg.CurrentSessionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const blockSession = `-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE id = $1
+  AND username = $2
+RETURNING id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+`
+
+type BlockSessionParams struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+func (q *Queries) BlockSession(ctx context.Context, arg BlockSessionParams) (Session, error) {
+	row := q.db.QueryRow(ctx, blockSession, arg.ID, arg.Username)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedRefreshToken,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.IsBlocked,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const blockSessions = `-- name: BlockSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE username = $1
+  AND is_blocked = FALSE
+`
+
+func (q *Queries) BlockSessions(ctx context.Context, username string) (int64, error) {
+	result, err := q.db.Exec(ctx, blockSessions, username)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createSession = `-- name: CreateSession :one
-INSERT INTO sessions (id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at)
+INSERT INTO sessions (id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+RETURNING id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
 `
 
 type CreateSessionParams struct {
-	ID           uuid.UUID        `json:"id"`
-	Username     string           `json:"username"`
-	RefreshToken string           `json:"refresh_token"`
-	UserAgent    string           `json:"user_agent"`
-	ClientIp     string           `json:"client_ip"`
-	IsBlocked    bool             `json:"is_blocked"`
-	ExpiresAt    pgtype.Timestamp `json:"expires_at"`
+	ID                 uuid.UUID        `json:"id"`
+	Username           string           `json:"username"`
+	HashedRefreshToken string           `json:"hashed_refresh_token"`
+	UserAgent          string           `json:"user_agent"`
+	ClientIp           string           `json:"client_ip"`
+	IsBlocked          bool             `json:"is_blocked"`
+	ExpiresAt          pgtype.Timestamp `json:"expires_at"`
 }
 
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
 	row := q.db.QueryRow(ctx, createSession,
 		arg.ID,
 		arg.Username,
-		arg.RefreshToken,
+		arg.HashedRefreshToken,
 		arg.UserAgent,
 		arg.ClientIp,
 		arg.IsBlocked,
@@ -42,7 +107,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
-		&i.RefreshToken,
+		&i.HashedRefreshToken,
 		&i.UserAgent,
 		&i.ClientIp,
 		&i.IsBlocked,
@@ -52,8 +117,21 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 	return i, err
 }
 
+const eraseSessions = `-- name: EraseSessions :exec
+UPDATE sessions
+SET user_agent = '',
+    client_ip  = '',
+    is_blocked = TRUE
+WHERE username = $1
+`
+
+func (q *Queries) EraseSessions(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, eraseSessions, username)
+	return err
+}
+
 const getSession = `-- name: GetSession :one
-SELECT id, username, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+SELECT id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
 FROM sessions
 WHERE id = $1
 LIMIT 1
@@ -65,7 +143,130 @@ func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
-		&i.RefreshToken,
+		&i.HashedRefreshToken,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.IsBlocked,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const isNewDeviceSession = `-- name: IsNewDeviceSession :one
+SELECT NOT EXISTS (SELECT 1
+                   FROM sessions earlier
+                   WHERE earlier.username = s.username
+                     AND earlier.user_agent = s.user_agent
+                     AND earlier.created_at < s.created_at)::bool AS new_device
+FROM sessions s
+WHERE s.id = $1
+`
+
+// Reports whether the user had never logged in with the user agent of the session before it.
+func (q *Queries) IsNewDeviceSession(ctx context.Context, id uuid.UUID) (pgtype.Bool, error) {
+	row := q.db.QueryRow(ctx, isNewDeviceSession, id)
+	var new_device pgtype.Bool
+	err := row.Scan(&new_device)
+	return new_device, err
+}
+
+const listActiveSessions = `-- name: ListActiveSessions :many
+SELECT id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+FROM sessions
+WHERE username = $1
+  AND is_blocked = FALSE
+  AND expires_at > now()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListActiveSessions(ctx context.Context, username string) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listActiveSessions, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedRefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionsByUsername = `-- name: ListSessionsByUsername :many
+SELECT id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+FROM sessions
+WHERE username = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListSessionsByUsername(ctx context.Context, username string) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listSessionsByUsername, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.HashedRefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateSessionRefreshToken = `-- name: RotateSessionRefreshToken :one
+UPDATE sessions
+SET hashed_refresh_token = $1
+WHERE id = $2
+  AND hashed_refresh_token = $3
+  AND is_blocked = FALSE
+RETURNING id, username, hashed_refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at
+`
+
+type RotateSessionRefreshTokenParams struct {
+	NewHashedRefreshToken string    `json:"new_hashed_refresh_token"`
+	ID                    uuid.UUID `json:"id"`
+	HashedRefreshToken    string    `json:"hashed_refresh_token"`
+}
+
+func (q *Queries) RotateSessionRefreshToken(ctx context.Context, arg RotateSessionRefreshTokenParams) (Session, error) {
+	row := q.db.QueryRow(ctx, rotateSessionRefreshToken, arg.NewHashedRefreshToken, arg.ID, arg.HashedRefreshToken)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashedRefreshToken,
 		&i.UserAgent,
 		&i.ClientIp,
 		&i.IsBlocked,
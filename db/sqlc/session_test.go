@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func createRandomSession(t *testing.T, username string) Session {
+	arg := CreateSessionParams{
+		ID:                 uuid.New(),
+		Username:           username,
+		HashedRefreshToken: util.HashToken(util.RandomString(32)),
+		UserAgent:          util.RandomString(10),
+		ClientIp:           "127.0.0.1",
+		ExpiresAt:          pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+
+	session, err := testStore.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.False(t, session.IsBlocked)
+
+	return session
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	other := createRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	// a session cannot be blocked by another user
+	_, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: other.Username,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	blocked, err := testStore.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestBlockOtherSessions(t *testing.T) {
+	user := createRandomUser(t)
+
+	current := createRandomSession(t, user.Username)
+	for i := 0; i < 2; i++ {
+		createRandomSession(t, user.Username)
+	}
+
+	count, err := testStore.BlockOtherSessions(context.Background(), BlockOtherSessionsParams{
+		Username:         user.Username,
+		CurrentSessionID: current.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	sessions, err := testStore.ListActiveSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, current.ID, sessions[0].ID)
+
+	count, err = testStore.BlockSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestRotateSessionRefreshToken(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	newHashedRefreshToken := util.HashToken(util.RandomString(32))
+	arg := RotateSessionRefreshTokenParams{
+		ID:                    session.ID,
+		HashedRefreshToken:    session.HashedRefreshToken,
+		NewHashedRefreshToken: newHashedRefreshToken,
+	}
+
+	rotated, err := testStore.RotateSessionRefreshToken(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, newHashedRefreshToken, rotated.HashedRefreshToken)
+	require.Equal(t, session.ExpiresAt, rotated.ExpiresAt)
+
+	// the previous refresh token cannot be rotated a second time
+	_, err = testStore.RotateSessionRefreshToken(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
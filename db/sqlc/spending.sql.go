@@ -0,0 +1,200 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: spending.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const getSpendingByCategory = `-- name: GetSpendingByCategory :many
+SELECT coalesce(t.category, 'other')::varchar AS category,
+       a.currency,
+       sum(t.amount)::bigint                  AS amount,
+       count(*)::bigint                       AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = $1
+  AND ($2::bigint[] IS NULL OR a.id = ANY ($2::bigint[]))
+  AND c.owner <> $1
+  AND t.created_at >= $3
+  AND t.created_at < $4
+GROUP BY 1, a.currency
+ORDER BY amount DESC
+`
+
+type GetSpendingByCategoryParams struct {
+	Owner      string    `json:"owner"`
+	AccountIds []int64   `json:"account_ids"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+}
+
+type GetSpendingByCategoryRow struct {
+	Category string `json:"category"`
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+	Count    int64  `json:"count"`
+}
+
+func (q *Queries) GetSpendingByCategory(ctx context.Context, arg GetSpendingByCategoryParams) ([]GetSpendingByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, getSpendingByCategory,
+		arg.Owner,
+		arg.AccountIds,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpendingByCategoryRow{}
+	for rows.Next() {
+		var i GetSpendingByCategoryRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.Currency,
+			&i.Amount,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingByCounterparty = `-- name: GetSpendingByCounterparty :many
+SELECT c.number                AS counterparty_account_number,
+       c.owner                 AS counterparty,
+       a.currency,
+       sum(t.amount)::bigint   AS amount,
+       count(*)::bigint        AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = $1
+  AND ($2::bigint[] IS NULL OR a.id = ANY ($2::bigint[]))
+  AND c.owner <> $1
+  AND t.created_at >= $3
+  AND t.created_at < $4
+GROUP BY c.id, c.number, c.owner, a.currency
+ORDER BY amount DESC
+LIMIT $5
+`
+
+type GetSpendingByCounterpartyParams struct {
+	Owner      string    `json:"owner"`
+	AccountIds []int64   `json:"account_ids"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Limit      int64     `json:"limit"`
+}
+
+type GetSpendingByCounterpartyRow struct {
+	CounterpartyAccountNumber string `json:"counterparty_account_number"`
+	Counterparty              string `json:"counterparty"`
+	Currency                  string `json:"currency"`
+	Amount                    int64  `json:"amount"`
+	Count                     int64  `json:"count"`
+}
+
+func (q *Queries) GetSpendingByCounterparty(ctx context.Context, arg GetSpendingByCounterpartyParams) ([]GetSpendingByCounterpartyRow, error) {
+	rows, err := q.db.Query(ctx, getSpendingByCounterparty,
+		arg.Owner,
+		arg.AccountIds,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpendingByCounterpartyRow{}
+	for rows.Next() {
+		var i GetSpendingByCounterpartyRow
+		if err := rows.Scan(
+			&i.CounterpartyAccountNumber,
+			&i.Counterparty,
+			&i.Currency,
+			&i.Amount,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingByMonth = `-- name: GetSpendingByMonth :many
+SELECT date_trunc('month', t.created_at)::timestamptz AS month,
+       a.currency,
+       sum(t.amount)::bigint                          AS amount,
+       count(*)::bigint                               AS count
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+         JOIN accounts c ON c.id = t.to_account_id
+WHERE a.owner = $1
+  AND ($2::bigint[] IS NULL OR a.id = ANY ($2::bigint[]))
+  AND c.owner <> $1
+  AND t.created_at >= $3
+  AND t.created_at < $4
+GROUP BY 1, a.currency
+ORDER BY month
+`
+
+type GetSpendingByMonthParams struct {
+	Owner      string    `json:"owner"`
+	AccountIds []int64   `json:"account_ids"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+}
+
+type GetSpendingByMonthRow struct {
+	Month    time.Time `json:"month"`
+	Currency string    `json:"currency"`
+	Amount   int64     `json:"amount"`
+	Count    int64     `json:"count"`
+}
+
+func (q *Queries) GetSpendingByMonth(ctx context.Context, arg GetSpendingByMonthParams) ([]GetSpendingByMonthRow, error) {
+	rows, err := q.db.Query(ctx, getSpendingByMonth,
+		arg.Owner,
+		arg.AccountIds,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpendingByMonthRow{}
+	for rows.Next() {
+		var i GetSpendingByMonthRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.Currency,
+			&i.Amount,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,7 +9,27 @@ type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error)
+	EraseUserTx(ctx context.Context, arg EraseUserTxParams) (EraseUserTxResult, error)
+	CreateDataExportRequestTx(ctx context.Context, arg CreateDataExportRequestTxParams) (CreateDataExportRequestTxResult, error)
+	UploadKycDocumentTx(ctx context.Context, arg UploadKycDocumentTxParams) (UploadKycDocumentTxResult, error)
+	ReviewKycDocumentTx(ctx context.Context, arg ReviewKycDocumentTxParams) (ReviewKycDocumentTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	CreateBillSplitTx(ctx context.Context, arg CreateBillSplitTxParams) (CreateBillSplitTxResult, error)
+	CollectDirectDebitTx(ctx context.Context, arg CollectDirectDebitTxParams) (CollectDirectDebitTxResult, error)
+	ChargebackDirectDebitTx(ctx context.Context, arg ChargebackDirectDebitTxParams) (ChargebackDirectDebitTxResult, error)
+	ConfirmTotpTx(ctx context.Context, arg ConfirmTotpTxParams) (ConfirmTotpTxResult, error)
+	DisableTotpTx(ctx context.Context, arg DisableTotpTxParams) error
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	ChangeUserStatusTx(ctx context.Context, arg ChangeUserStatusTxParams) (ChangeUserStatusTxResult, error)
+	AssignUserRoleTx(ctx context.Context, arg AssignUserRoleTxParams) (AssignUserRoleTxResult, error)
+	CreateServiceClientTx(ctx context.Context, arg CreateServiceClientTxParams) (CreateServiceClientTxResult, error)
+	RotateServiceClientKeyTx(ctx context.Context, arg RotateServiceClientKeyTxParams) (RotateServiceClientKeyTxResult, error)
+	ResolveFraudCaseTx(ctx context.Context, arg ResolveFraudCaseTxParams) (ResolveFraudCaseTxResult, error)
+	FlagScreeningHitsTx(ctx context.Context, arg FlagScreeningHitsTxParams) (FlagScreeningHitsTxResult, error)
+	ResolveScreeningCaseTx(ctx context.Context, arg ResolveScreeningCaseTxParams) (ResolveScreeningCaseTxResult, error)
 }
 
 type SQLStore struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"simplebank/util"
 	"testing"
 )
 
@@ -156,3 +157,85 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedAccount1.Balance)
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
+
+func TestTransferTxFrozenOrClosedAccount(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	_, err := testStore.FreezeAccounts(context.Background(), account2.Owner)
+	require.NoError(t, err)
+
+	// money can move neither into nor out of a frozen account
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrAccountFrozen)
+
+	_, err = testStore.UpdateAccount(context.Background(), UpdateAccountParams{ID: account1.ID, Balance: 0})
+	require.NoError(t, err)
+
+	_, err = testStore.CloseAccounts(context.Background(), account1.Owner)
+	require.NoError(t, err)
+
+	account3 := createRandomAccount(t)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account3.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrAccountClosed)
+
+	updatedAccount3, err := testStore.GetAccount(context.Background(), account3.ID)
+	require.NoError(t, err)
+	require.Equal(t, account3.Balance, updatedAccount3.Balance)
+}
+
+func TestTransferTxKycDailyLimit(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	// concurrent transfers must not all fit under the daily limit by reading the total before the others commit
+	n := 4
+	amount := util.GetKycTier(1).DailyTransferLimit / 2
+
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for i := 0; i < n; i++ {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+
+		require.ErrorIs(t, err, ErrKycLimitExceeded)
+	}
+
+	require.Equal(t, 2, succeeded)
+
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-2*amount, updatedAccount1.Balance)
+}
@@ -20,5 +20,6 @@ func (t Transfer) ToResponse() *pb.Transfer {
 			}
 			return nil
 		}(),
+		Category: SpendingCategoryToResponse(t.Category.String),
 	}
 }
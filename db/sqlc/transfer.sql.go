@@ -7,14 +7,15 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
-INSERT INTO transfers (from_account_id, to_account_id, amount, description)
-VALUES ($1, $2, $3, $4)
-RETURNING id, from_account_id, to_account_id, amount, created_at, description
+INSERT INTO transfers (from_account_id, to_account_id, amount, description, category)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, from_account_id, to_account_id, amount, created_at, description, category
 `
 
 type CreateTransferParams struct {
@@ -22,6 +23,7 @@ type CreateTransferParams struct {
 	ToAccountID   int64       `json:"to_account_id"`
 	Amount        int64       `json:"amount"`
 	Description   pgtype.Text `json:"description"`
+	Category      pgtype.Text `json:"category"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
@@ -30,6 +32,7 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		arg.ToAccountID,
 		arg.Amount,
 		arg.Description,
+		arg.Category,
 	)
 	var i Transfer
 	err := row.Scan(
@@ -39,12 +42,13 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.Amount,
 		&i.CreatedAt,
 		&i.Description,
+		&i.Category,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at, description
+SELECT id, from_account_id, to_account_id, amount, created_at, description, category
 FROM transfers
 WHERE id = $1
 LIMIT 1
@@ -60,12 +64,49 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.Amount,
 		&i.CreatedAt,
 		&i.Description,
+		&i.Category,
+	)
+	return i, err
+}
+
+const getTransferHistory = `-- name: GetTransferHistory :one
+SELECT count(*) FILTER (WHERE t.created_at >= now() - interval '1 hour')::bigint                   AS transfers_last_hour,
+       coalesce(sum(t.amount) FILTER (WHERE t.created_at >= now() - interval '1 day'), 0)::bigint AS amount_last_day,
+       coalesce(avg(t.amount), 0)::float8                                                        AS average_amount,
+       count(*) FILTER (WHERE t.to_account_id = $1)::bigint                 AS transfers_to_payee
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+WHERE a.owner = $2
+  AND t.created_at >= now() - interval '90 days'
+`
+
+type GetTransferHistoryParams struct {
+	ToAccountID int64  `json:"to_account_id"`
+	Owner       string `json:"owner"`
+}
+
+type GetTransferHistoryRow struct {
+	TransfersLastHour int64   `json:"transfers_last_hour"`
+	AmountLastDay     int64   `json:"amount_last_day"`
+	AverageAmount     float64 `json:"average_amount"`
+	TransfersToPayee  int64   `json:"transfers_to_payee"`
+}
+
+// Summarizes what the owner sent from any of their accounts in the last 90 days for the fraud rules.
+func (q *Queries) GetTransferHistory(ctx context.Context, arg GetTransferHistoryParams) (GetTransferHistoryRow, error) {
+	row := q.db.QueryRow(ctx, getTransferHistory, arg.ToAccountID, arg.Owner)
+	var i GetTransferHistoryRow
+	err := row.Scan(
+		&i.TransfersLastHour,
+		&i.AmountLastDay,
+		&i.AverageAmount,
+		&i.TransfersToPayee,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at, description
+SELECT id, from_account_id, to_account_id, amount, created_at, description, category
 FROM transfers
 WHERE from_account_id = $1
    OR to_account_id = $2
@@ -101,6 +142,78 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.Amount,
 			&i.CreatedAt,
 			&i.Description,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfersByAccounts = `-- name: ListTransfersByAccounts :many
+SELECT id, from_account_id, to_account_id, amount, created_at, description, category
+FROM transfers
+WHERE from_account_id = ANY ($1::bigint[])
+   OR to_account_id = ANY ($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListTransfersByAccounts(ctx context.Context, accountIds []int64) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByAccounts, accountIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Description,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfersByIDs = `-- name: ListTransfersByIDs :many
+SELECT id, from_account_id, to_account_id, amount, created_at, description, category
+FROM transfers
+WHERE id = ANY ($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListTransfersByIDs(ctx context.Context, ids []int64) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, listTransfersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Description,
+			&i.Category,
 		); err != nil {
 			return nil, err
 		}
@@ -111,3 +224,51 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const sumTransfersFromOwnerSince = `-- name: SumTransfersFromOwnerSince :one
+SELECT coalesce(sum(t.amount), 0)::bigint
+FROM transfers t
+         JOIN accounts a ON a.id = t.from_account_id
+WHERE a.owner = $1
+  AND t.created_at >= $2
+`
+
+type SumTransfersFromOwnerSinceParams struct {
+	Owner string    `json:"owner"`
+	Since time.Time `json:"since"`
+}
+
+// Adds up the amounts the owner sent from any of their accounts, regardless of currency.
+func (q *Queries) SumTransfersFromOwnerSince(ctx context.Context, arg SumTransfersFromOwnerSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, sumTransfersFromOwnerSince, arg.Owner, arg.Since)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const updateTransferCategory = `-- name: UpdateTransferCategory :one
+UPDATE transfers
+SET category = $2
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, description, category
+`
+
+type UpdateTransferCategoryParams struct {
+	ID       int64       `json:"id"`
+	Category pgtype.Text `json:"category"`
+}
+
+func (q *Queries) UpdateTransferCategory(ctx context.Context, arg UpdateTransferCategoryParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, updateTransferCategory, arg.ID, arg.Category)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Description,
+		&i.Category,
+	)
+	return i, err
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AcceptPaymentRequestTxParams struct {
+	ID            int64
+	Payer         string
+	FromAccountID int64
+}
+
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest
+	TransferTxResult
+}
+
+// AcceptPaymentRequestTx pays a pending payment request from the given account and marks it as accepted.
+// It returns ErrPaymentRequestNotPending if the request has already been resolved or has expired.
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		paymentRequest, err := q.GetPaymentRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if paymentRequest.Status != util.PaymentRequestPending || time.Now().After(paymentRequest.ExpiresAt) {
+			return ErrPaymentRequestNotPending
+		}
+
+		result.TransferTxResult, err = sendMoney(ctx, q, TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   paymentRequest.ToAccountID,
+			Amount:        paymentRequest.Amount,
+			Description:   paymentRequest.Description.String,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = q.ResolvePaymentRequest(ctx, ResolvePaymentRequestParams{
+			ID:     paymentRequest.ID,
+			Status: util.PaymentRequestAccepted,
+			Payer: pgtype.Text{
+				String: arg.Payer,
+				Valid:  true,
+			},
+			TransferID: pgtype.Int8{
+				Int64: result.Transfer.ID,
+				Valid: true,
+			},
+		})
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,36 @@
+package db
+
+import "context"
+
+type AssignUserRoleTxParams struct {
+	Username string
+	Role     string
+}
+
+type AssignUserRoleTxResult struct {
+	User User
+}
+
+// AssignUserRoleTx changes the role of the user and blocks all their sessions,
+// tokens carry the role they were issued with so the user has to log in again.
+// Tokens of service clients are not tied to a session, they are given the current role of the owner.
+func (store *SQLStore) AssignUserRoleTx(ctx context.Context, arg AssignUserRoleTxParams) (AssignUserRoleTxResult, error) {
+	var result AssignUserRoleTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.UpdateUserRole(ctx, UpdateUserRoleParams{
+			Username: arg.Username,
+			Role:     arg.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.BlockSessions(ctx, arg.Username)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+)
+
+type ChangeUserStatusTxParams struct {
+	Username  string
+	Status    string
+	Reason    string
+	ChangedBy string
+	// FreezeAccounts freezes the open accounts of the user, UnfreezeAccounts unfreezes all of them
+	FreezeAccounts   bool
+	UnfreezeAccounts bool
+}
+
+type ChangeUserStatusTxResult struct {
+	User         User
+	StatusChange UserStatusChange
+	// BlockedSessions is how many sessions were blocked because the user is no longer active
+	BlockedSessions int64
+	// Accounts are the accounts that were frozen or unfrozen
+	Accounts []Account
+}
+
+// ChangeUserStatusTx changes the status of a user and records who did it and why. Users who are no longer active
+// are logged out of all sessions. ErrUserStatusUnchanged is returned when the user already has the status.
+func (store *SQLStore) ChangeUserStatusTx(ctx context.Context, arg ChangeUserStatusTxParams) (ChangeUserStatusTxResult, error) {
+	var result ChangeUserStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		if user.Status == arg.Status {
+			return ErrUserStatusUnchanged
+		}
+
+		result.User, err = q.UpdateUserStatus(ctx, UpdateUserStatusParams{
+			Username: arg.Username,
+			Status:   arg.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Status != util.UserStatusActive {
+			result.BlockedSessions, err = q.BlockSessions(ctx, arg.Username)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case arg.FreezeAccounts:
+			result.Accounts, err = q.FreezeAccounts(ctx, arg.Username)
+		case arg.UnfreezeAccounts:
+			result.Accounts, err = q.UnfreezeAccounts(ctx, arg.Username)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.StatusChange, err = q.CreateUserStatusChange(ctx, CreateUserStatusChangeParams{
+			Username:       arg.Username,
+			PreviousStatus: user.Status,
+			Status:         arg.Status,
+			Reason:         arg.Reason,
+			ChangedBy:      arg.ChangedBy,
+		})
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ChargebackDirectDebitTxParams struct {
+	DirectDebitID int64
+	// Window is how long after the collection the debtor can still claim the money back
+	Window time.Duration
+}
+
+type ChargebackDirectDebitTxResult struct {
+	DirectDebit DirectDebit
+	TransferTxResult
+}
+
+// ChargebackDirectDebitTx returns a collected direct debit to the debtor by transferring the amount back
+// from the creditor account.
+func (store *SQLStore) ChargebackDirectDebitTx(ctx context.Context, arg ChargebackDirectDebitTxParams) (ChargebackDirectDebitTxResult, error) {
+	var result ChargebackDirectDebitTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		directDebit, err := q.GetDirectDebitForUpdate(ctx, arg.DirectDebitID)
+		if err != nil {
+			return err
+		}
+
+		if directDebit.Status != util.DirectDebitCollected {
+			return ErrAlreadyChargedBack
+		}
+
+		if time.Now().After(directDebit.CollectedAt.Add(arg.Window)) {
+			return ErrChargebackWindowClosed
+		}
+
+		mandate, err := q.GetMandate(ctx, directDebit.MandateID)
+		if err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: mandate.CreditorAccountID,
+			ToAccountID:   mandate.DebtorAccountID,
+			Amount:        directDebit.Amount,
+			Description:   "Chargeback",
+		})
+		if err != nil {
+			return err
+		}
+
+		result.DirectDebit, err = q.ChargebackDirectDebit(ctx, ChargebackDirectDebitParams{
+			ID: directDebit.ID,
+			ChargebackTransferID: pgtype.Int8{
+				Int64: result.Transfer.ID,
+				Valid: true,
+			},
+		})
+
+		return err
+	})
+
+	return result, err
+}
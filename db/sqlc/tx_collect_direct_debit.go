@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"time"
+)
+
+type CollectDirectDebitTxParams struct {
+	MandateID   int64
+	Amount      int64
+	Description string
+}
+
+type CollectDirectDebitTxResult struct {
+	Mandate     Mandate
+	DirectDebit DirectDebit
+	TransferTxResult
+}
+
+// CollectDirectDebitTx pulls money from the debtor account into the creditor account of an active mandate.
+// The mandate is locked, so concurrent collections cannot exceed its frequency.
+func (store *SQLStore) CollectDirectDebitTx(ctx context.Context, arg CollectDirectDebitTxParams) (CollectDirectDebitTxResult, error) {
+	var result CollectDirectDebitTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		mandate, err := q.GetMandateForUpdate(ctx, arg.MandateID)
+		if err != nil {
+			return err
+		}
+
+		if mandate.Status != util.MandateActive {
+			return ErrMandateNotActive
+		}
+
+		if arg.Amount > mandate.MaxAmount {
+			return ErrMandateAmountExceeded
+		}
+
+		if mandate.LastCollectedAt.Valid && time.Now().Before(util.NextCollectionAt(mandate.Frequency, mandate.LastCollectedAt.Time)) {
+			return ErrCollectionTooSoon
+		}
+
+		result.TransferTxResult, err = sendMoney(ctx, q, TransferTxParams{
+			FromAccountID: mandate.DebtorAccountID,
+			ToAccountID:   mandate.CreditorAccountID,
+			Amount:        arg.Amount,
+			Description:   arg.Description,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.DirectDebit, err = q.CreateDirectDebit(ctx, CreateDirectDebitParams{
+			MandateID:  mandate.ID,
+			TransferID: result.Transfer.ID,
+			Amount:     arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Mandate, err = q.MarkMandateCollected(ctx, mandate.ID)
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+)
+
+type ConfirmTotpTxParams struct {
+	Username string
+	// HashedRecoveryCodes replace any recovery codes left from a previous enrollment
+	HashedRecoveryCodes []string
+}
+
+type ConfirmTotpTxResult struct {
+	TotpCredential TotpCredential
+	RecoveryCodes  []RecoveryCode
+}
+
+func (store *SQLStore) ConfirmTotpTx(ctx context.Context, arg ConfirmTotpTxParams) (ConfirmTotpTxResult, error) {
+	var result ConfirmTotpTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.TotpCredential, err = q.ConfirmTotpCredential(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteRecoveryCodes(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.RecoveryCodes = make([]RecoveryCode, len(arg.HashedRecoveryCodes))
+		for i, hashedCode := range arg.HashedRecoveryCodes {
+			result.RecoveryCodes[i], err = q.CreateRecoveryCode(ctx, CreateRecoveryCodeParams{
+				Username:   arg.Username,
+				HashedCode: hashedCode,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
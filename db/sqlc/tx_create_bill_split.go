@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateBillSplitTxParams struct {
+	CreateBillSplitParams
+	// PaymentRequests are created for each participant other than the creator and linked to the split
+	PaymentRequests []CreatePaymentRequestParams
+	AfterCreate     func(billSplit BillSplit, paymentRequests []PaymentRequest) error
+}
+
+type CreateBillSplitTxResult struct {
+	BillSplit       BillSplit
+	PaymentRequests []PaymentRequest
+}
+
+func (store *SQLStore) CreateBillSplitTx(ctx context.Context, arg CreateBillSplitTxParams) (CreateBillSplitTxResult, error) {
+	var result CreateBillSplitTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.BillSplit, err = q.CreateBillSplit(ctx, arg.CreateBillSplitParams)
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequests = make([]PaymentRequest, len(arg.PaymentRequests))
+		for i, paymentRequestArg := range arg.PaymentRequests {
+			paymentRequestArg.BillSplitID = pgtype.Int8{
+				Int64: result.BillSplit.ID,
+				Valid: true,
+			}
+
+			result.PaymentRequests[i], err = q.CreatePaymentRequest(ctx, paymentRequestArg)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		return arg.AfterCreate(result.BillSplit, result.PaymentRequests)
+	})
+
+	return result, err
+}
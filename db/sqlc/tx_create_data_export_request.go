@@ -0,0 +1,35 @@
+package db
+
+import "context"
+
+type CreateDataExportRequestTxParams struct {
+	CreateDataExportRequestParams
+	AfterCreate func(dataRequest DataRequest) error
+}
+
+type CreateDataExportRequestTxResult struct {
+	DataRequest DataRequest
+}
+
+// CreateDataExportRequestTx records an export request unless one was made after CreatedAfter, in which case
+// ErrRecordNotFound is returned. The request is rolled back when AfterCreate fails, so it can be retried right away.
+func (store *SQLStore) CreateDataExportRequestTx(ctx context.Context, arg CreateDataExportRequestTxParams) (CreateDataExportRequestTxResult, error) {
+	var result CreateDataExportRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.DataRequest, err = q.CreateDataExportRequest(ctx, arg.CreateDataExportRequestParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		return arg.AfterCreate(result.DataRequest)
+	})
+
+	return result, err
+}
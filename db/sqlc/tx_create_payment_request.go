@@ -0,0 +1,33 @@
+package db
+
+import "context"
+
+type CreatePaymentRequestTxParams struct {
+	CreatePaymentRequestParams
+	AfterCreate func(paymentRequest PaymentRequest) error
+}
+
+type CreatePaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest
+}
+
+func (store *SQLStore) CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error) {
+	var result CreatePaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PaymentRequest, err = q.CreatePaymentRequest(ctx, arg.CreatePaymentRequestParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.AfterCreate == nil {
+			return nil
+		}
+
+		return arg.AfterCreate(result.PaymentRequest)
+	})
+
+	return result, err
+}
@@ -0,0 +1,35 @@
+package db
+
+import "context"
+
+type CreateServiceClientTxParams struct {
+	CreateServiceClientParams
+	HashedSecret string
+}
+
+type CreateServiceClientTxResult struct {
+	ServiceClient    ServiceClient
+	ServiceClientKey ServiceClientKey
+}
+
+// CreateServiceClientTx creates the service client together with its first key.
+func (store *SQLStore) CreateServiceClientTx(ctx context.Context, arg CreateServiceClientTxParams) (CreateServiceClientTxResult, error) {
+	var result CreateServiceClientTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.ServiceClient, err = q.CreateServiceClient(ctx, arg.CreateServiceClientParams)
+		if err != nil {
+			return err
+		}
+
+		result.ServiceClientKey, err = q.CreateServiceClientKey(ctx, CreateServiceClientKeyParams{
+			ClientID:     result.ServiceClient.ID,
+			HashedSecret: arg.HashedSecret,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -1,10 +1,15 @@
 package db
 
-import "context"
+import (
+	"context"
+	"simplebank/util"
+)
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	// ScreeningHits are the watchlist entries the full name matched, the user is restricted until they are reviewed
+	ScreeningHits []ScreeningHit
+	AfterCreate   func(user User) error
 }
 
 type CreateUserTxResult struct {
@@ -22,6 +27,13 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 			return err
 		}
 
+		if len(arg.ScreeningHits) > 0 {
+			result.User, err = flagScreeningHits(ctx, q, result.User, util.ScreeningTriggerSignup, result.User.FullName, arg.ScreeningHits)
+			if err != nil {
+				return err
+			}
+		}
+
 		return arg.AfterCreate(result.User)
 	})
 
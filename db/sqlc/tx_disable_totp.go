@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+)
+
+type DisableTotpTxParams struct {
+	Username string
+}
+
+func (store *SQLStore) DisableTotpTx(ctx context.Context, arg DisableTotpTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteTotpCredential(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteRecoveryCodes(ctx, arg.Username)
+	})
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+type EraseUserTxParams struct {
+	Username string
+}
+
+type EraseUserTxResult struct {
+	User           User
+	DataRequest    DataRequest
+	ClosedAccounts []Account
+	// KycBlobKeys are the files of the deleted identity documents, which the caller removes from the blob store
+	KycBlobKeys []string
+}
+
+// EraseUserTx closes the accounts of a user and pseudonymizes their personal data. Accounts, entries and transfers
+// are kept for financial record-keeping, so every account has to be emptied first. The user is renamed to a
+// pseudonym that every record referring to them follows, their identity documents and login attempts are deleted.
+// Sanctions screening cases keep the matched watchlist entry but lose the name that was screened.
+func (store *SQLStore) EraseUserTx(ctx context.Context, arg EraseUserTxParams) (EraseUserTxResult, error) {
+	var result EraseUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		accounts, err := q.ListAccountsByOwnerForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			if account.Balance != 0 {
+				return ErrAccountNotEmpty
+			}
+		}
+
+		result.ClosedAccounts, err = q.CloseAccounts(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.DataRequest, err = q.CreateDataErasureRequest(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		// Usernames and emails are unique, the request id keeps the placeholders unique without deriving them
+		// from personal data. The hyphen is rejected by val.ValidateUsername, so nobody can register the pseudonym
+		// first and make the erasure fail
+		pseudonym := fmt.Sprintf("erased-%d", result.DataRequest.ID)
+		email := fmt.Sprintf("erased-%d@simplebank.invalid", result.DataRequest.ID)
+
+		err = q.EraseSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.EraseVerifyEmails(ctx, EraseVerifyEmailsParams{
+			Username: arg.Username,
+			Email:    email,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.KycBlobKeys, err = q.DeleteKycDocuments(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.EraseScreeningCases(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		// Attempts are recorded by the username as entered, so they do not follow the rename
+		err = q.DeleteLoginAttempts(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.AllowAuditEventErasure(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = q.EraseAuditEvents(ctx, EraseAuditEventsParams{
+			Username:  arg.Username,
+			Pseudonym: pseudonym,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.EraseUser(ctx, EraseUserParams{
+			Username:  arg.Username,
+			Pseudonym: pseudonym,
+			Email:     email,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.DataRequest.Username = pseudonym
+		for i := range result.ClosedAccounts {
+			result.ClosedAccounts[i].Owner = pseudonym
+		}
+
+		return nil
+	})
+
+	return result, err
+}
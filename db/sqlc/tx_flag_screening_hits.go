@@ -0,0 +1,33 @@
+package db
+
+import "context"
+
+type FlagScreeningHitsTxParams struct {
+	Username     string
+	Trigger      string
+	ScreenedName string
+	Hits         []ScreeningHit
+}
+
+type FlagScreeningHitsTxResult struct {
+	User User
+}
+
+// FlagScreeningHitsTx records the watchlist hits of an existing user for review, restricting the user if any of
+// them is new.
+func (store *SQLStore) FlagScreeningHitsTx(ctx context.Context, arg FlagScreeningHitsTxParams) (FlagScreeningHitsTxResult, error) {
+	var result FlagScreeningHitsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = flagScreeningHits(ctx, q, user, arg.Trigger, arg.ScreenedName, arg.Hits)
+
+		return err
+	})
+
+	return result, err
+}
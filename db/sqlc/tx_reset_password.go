@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResetPasswordTxParams struct {
+	HashedToken    string
+	HashedPassword string
+}
+
+type ResetPasswordTxResult struct {
+	User          User
+	PasswordReset PasswordReset
+}
+
+// ResetPasswordTx uses up the reset token and changes the password. Other outstanding reset tokens are
+// invalidated and all sessions of the user are blocked, so whoever knew the old password is logged out.
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PasswordReset, err = q.UsePasswordReset(ctx, arg.HashedToken)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+			Username: result.PasswordReset.Username,
+			HashedPassword: pgtype.Text{
+				String: arg.HashedPassword,
+				Valid:  true,
+			},
+			PasswordChangedAt: pgtype.Timestamptz{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.InvalidatePasswordResets(ctx, result.User.Username)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.BlockSessions(ctx, result.User.Username)
+		return err
+	})
+
+	return result, err
+}
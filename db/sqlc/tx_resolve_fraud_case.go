@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResolveFraudCaseTxParams struct {
+	ID         int64
+	Approve    bool
+	ResolvedBy string
+	Note       pgtype.Text
+}
+
+type ResolveFraudCaseTxResult struct {
+	FraudCase FraudCase
+	// TransferTxResult is only set when the case was approved
+	TransferTxResult
+}
+
+// ResolveFraudCaseTx approves or rejects an open fraud case, approving it makes the transfer that was held back.
+// It returns ErrFraudCaseNotOpen if the case has already been resolved.
+func (store *SQLStore) ResolveFraudCaseTx(ctx context.Context, arg ResolveFraudCaseTxParams) (ResolveFraudCaseTxResult, error) {
+	var result ResolveFraudCaseTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		fraudCase, err := q.GetFraudCaseForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if fraudCase.Status != util.FraudCaseOpen {
+			return ErrFraudCaseNotOpen
+		}
+
+		resolveArg := ResolveFraudCaseParams{
+			ID:             fraudCase.ID,
+			Status:         util.FraudCaseRejected,
+			ResolvedBy:     pgtype.Text{String: arg.ResolvedBy, Valid: true},
+			ResolutionNote: arg.Note,
+		}
+
+		if arg.Approve {
+			result.TransferTxResult, err = sendMoney(ctx, q, TransferTxParams{
+				FromAccountID: fraudCase.FromAccountID,
+				ToAccountID:   fraudCase.ToAccountID,
+				Amount:        fraudCase.Amount,
+				Description:   fraudCase.Description,
+			})
+			if err != nil {
+				return err
+			}
+
+			resolveArg.Status = util.FraudCaseApproved
+			resolveArg.TransferID = pgtype.Int8{Int64: result.Transfer.ID, Valid: true}
+		}
+
+		result.FraudCase, err = q.ResolveFraudCase(ctx, resolveArg)
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ResolveScreeningCaseTxParams struct {
+	ID         int64
+	Confirm    bool
+	ResolvedBy string
+	Note       pgtype.Text
+}
+
+type ResolveScreeningCaseTxResult struct {
+	ScreeningCase ScreeningCase
+	User          User
+}
+
+// ResolveScreeningCaseTx clears an open screening case as a false positive or confirms it as a true match.
+// The restriction of the user is lifted once none of their cases is open or confirmed anymore.
+// It returns ErrScreeningCaseNotOpen if the case has already been resolved.
+func (store *SQLStore) ResolveScreeningCaseTx(ctx context.Context, arg ResolveScreeningCaseTxParams) (ResolveScreeningCaseTxResult, error) {
+	var result ResolveScreeningCaseTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		screeningCase, err := q.GetScreeningCase(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		// The user is locked before the case, in the same order as new hits are flagged
+		result.User, err = q.GetUserForUpdate(ctx, screeningCase.Username)
+		if err != nil {
+			return err
+		}
+
+		screeningCase, err = q.GetScreeningCaseForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if screeningCase.Status != util.ScreeningCaseOpen {
+			return ErrScreeningCaseNotOpen
+		}
+
+		status := util.ScreeningCaseCleared
+		if arg.Confirm {
+			status = util.ScreeningCaseConfirmed
+		}
+
+		result.ScreeningCase, err = q.ResolveScreeningCase(ctx, ResolveScreeningCaseParams{
+			ID:             screeningCase.ID,
+			Status:         status,
+			ResolvedBy:     pgtype.Text{String: arg.ResolvedBy, Valid: true},
+			ResolutionNote: arg.Note,
+		})
+		if err != nil {
+			return err
+		}
+
+		remaining, err := q.CountRestrictingScreeningCases(ctx, screeningCase.Username)
+		if err != nil || remaining > 0 {
+			return err
+		}
+
+		result.User, err = q.LiftUserRestriction(ctx, screeningCase.Username)
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+)
+
+type ReviewKycDocumentTxParams struct {
+	ReviewKycDocumentParams
+	// AfterReview is called with the reviewed document, the review is rolled back if it fails
+	AfterReview func(document KycDocument) error
+}
+
+type ReviewKycDocumentTxResult struct {
+	Document KycDocument
+	User     User
+}
+
+// ReviewKycDocumentTx approves or rejects a pending document and updates the KYC tier and status of its owner.
+func (store *SQLStore) ReviewKycDocumentTx(ctx context.Context, arg ReviewKycDocumentTxParams) (ReviewKycDocumentTxResult, error) {
+	var result ReviewKycDocumentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		document, err := q.GetKycDocument(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		// The user is locked before the document, in the same order as uploads
+		_, err = q.GetUserForUpdate(ctx, document.Username)
+		if err != nil {
+			return err
+		}
+
+		document, err = q.GetKycDocumentForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if document.Status != util.KycDocumentPending {
+			return ErrKycDocumentNotPending
+		}
+
+		result.Document, err = q.ReviewKycDocument(ctx, arg.ReviewKycDocumentParams)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = refreshUserKyc(ctx, q, document.Username)
+		if err != nil {
+			return err
+		}
+
+		if arg.AfterReview == nil {
+			return nil
+		}
+
+		return arg.AfterReview(result.Document)
+	})
+
+	return result, err
+}
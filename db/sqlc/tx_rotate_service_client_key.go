@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type RotateServiceClientKeyTxParams struct {
+	ClientID     string
+	HashedSecret string
+	// PreviousKeysExpireAt is when the keys the new one replaces stop working
+	PreviousKeysExpireAt time.Time
+}
+
+type RotateServiceClientKeyTxResult struct {
+	ServiceClientKey ServiceClientKey
+}
+
+// RotateServiceClientKeyTx adds a new key to the service client and lets its previous keys expire,
+// so integrations keep working while they are switched to the new key.
+func (store *SQLStore) RotateServiceClientKeyTx(ctx context.Context, arg RotateServiceClientKeyTxParams) (RotateServiceClientKeyTxResult, error) {
+	var result RotateServiceClientKeyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.ExpireServiceClientKeys(ctx, ExpireServiceClientKeysParams{
+			ClientID: arg.ClientID,
+			ExpiresAt: pgtype.Timestamptz{
+				Time:  arg.PreviousKeysExpireAt,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ServiceClientKey, err = q.CreateServiceClientKey(ctx, CreateServiceClientKeyParams{
+			ClientID:     arg.ClientID,
+			HashedSecret: arg.HashedSecret,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -2,6 +2,9 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"simplebank/util"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -11,6 +14,8 @@ type TransferTxParams struct {
 	ToAccountID   int64  `json:"to_account_id"`
 	Amount        int64  `json:"amount"`
 	Description   string `json:"description"`
+	// Category is assigned from the sender's category rules when empty
+	Category string `json:"category"`
 }
 
 type TransferTxResult struct {
@@ -21,51 +26,155 @@ type TransferTxResult struct {
 	ToEntry     Entry    `json:"to_entry"`
 }
 
+// TransferTx moves money on behalf of the owner of the sender account, within the limits of their KYC tier.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-			Description: pgtype.Text{
-				String: arg.Description,
-				Valid:  true,
-			},
-		})
-		if err != nil {
-			return err
-		}
+		result, err = sendMoney(ctx, q, arg)
+
+		return err
+	})
+
+	return result, err
+}
+
+// CheckKycTransferLimit returns ErrKycLimitExceeded if the KYC tier of the user does not allow sending the amount
+// on top of what they sent in the last 24 hours.
+func CheckKycTransferLimit(user User, amount int64, sent int64) error {
+	tier := util.GetKycTier(user.KycTier)
+	if tier.MaxTransferAmount == 0 {
+		return fmt.Errorf("%w: identity must be verified before making transfers", ErrKycLimitExceeded)
+	}
+
+	if amount > tier.MaxTransferAmount {
+		return fmt.Errorf("%w: tier %d allows transfers of at most %d", ErrKycLimitExceeded, user.KycTier, tier.MaxTransferAmount)
+	}
+
+	if sent+amount > tier.DailyTransferLimit {
+		return fmt.Errorf("%w: tier %d allows sending at most %d a day, %d has been sent already", ErrKycLimitExceeded, user.KycTier, tier.DailyTransferLimit, sent)
+	}
+
+	return nil
+}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
+// sendMoney is transfer for money the owner of the sender account pays out, which counts towards their KYC limits.
+// The owner is locked, so concurrent payments of the same user cannot each see the daily total without the other.
+func sendMoney(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	user, err := q.GetUserForUpdate(ctx, fromAccount.Owner)
+	if err != nil {
+		return result, err
+	}
+
+	sent, err := q.SumTransfersFromOwnerSince(ctx, SumTransfersFromOwnerSinceParams{
+		Owner: user.Username,
+		Since: time.Now().Add(-24 * time.Hour),
+	})
+	if err != nil {
+		return result, err
+	}
+
+	err = CheckKycTransferLimit(user, arg.Amount, sent)
+	if err != nil {
+		return result, err
+	}
+
+	return transfer(ctx, q, arg)
+}
+
+// transfer moves money between two accounts using the given queries, so it can be part of a larger transaction.
+// It fails with ErrAccountClosed or ErrAccountFrozen if money cannot move through one of the accounts.
+func transfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	err := lockTransferAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	category := arg.Category
+	if category == "" {
+		category, err = categorize(ctx, q, arg)
 		if err != nil {
-			return err
+			return result, err
 		}
+	}
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Description: pgtype.Text{
+			String: arg.Description,
+			Valid:  true,
+		},
+		Category: pgtype.Text{
+			String: category,
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.FromAccountID,
+		Amount:    -arg.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.ToAccountID,
+		Amount:    arg.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	}
+
+	return result, err
+}
+
+// lockTransferAccounts locks both accounts in the order of their ids, like addMoney updates them, and checks that
+// they are open and not frozen. Holding the locks keeps them that way until the transfer commits.
+func lockTransferAccounts(ctx context.Context, q *Queries, fromAccountID int64, toAccountID int64) error {
+	accountIDs := []int64{fromAccountID, toAccountID}
+	if toAccountID < fromAccountID {
+		accountIDs = []int64{toAccountID, fromAccountID}
+	}
+
+	for _, accountID := range accountIDs {
+		account, err := q.GetAccountForUpdate(ctx, accountID)
 		if err != nil {
 			return err
 		}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+		if account.ClosedAt.Valid {
+			return fmt.Errorf("%w: %s", ErrAccountClosed, account.Number)
 		}
 
-		return nil
-	})
+		if account.FrozenAt.Valid {
+			return fmt.Errorf("%w: %s", ErrAccountFrozen, account.Number)
+		}
+	}
 
-	return result, err
+	return nil
 }
 
 func addMoney(ctx context.Context, q *Queries, accountID1 int64, amount1 int64, accountID2 int64, amount2 int64) (account1 Account, account2 Account, err error) {
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UpdateUserTxParams struct {
+	UpdateUserParams
+	// ScreeningHits are the watchlist entries the new full name matched, they are ignored if the name is unchanged
+	ScreeningHits []ScreeningHit
+	AfterUpdate   func(before User, after User) error
+}
+
+type UpdateUserTxResult struct {
+	User User
+}
+
+// UpdateUserTx updates a user, resetting email verification when the address changes, and runs AfterUpdate
+// with the user as it was before and after the change so that follow-up work is rolled back with the update.
+func (store *SQLStore) UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error) {
+	var result UpdateUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		before, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		// A new address has to be verified again before money can be moved
+		if arg.Email.Valid && arg.Email.String != before.Email {
+			arg.IsEmailVerified = pgtype.Bool{Bool: false, Valid: true}
+		}
+
+		result.User, err = q.UpdateUser(ctx, arg.UpdateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if len(arg.ScreeningHits) > 0 && result.User.FullName != before.FullName {
+			result.User, err = flagScreeningHits(ctx, q, result.User, util.ScreeningTriggerNameChange, result.User.FullName, arg.ScreeningHits)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.AfterUpdate == nil {
+			return nil
+		}
+
+		return arg.AfterUpdate(before, result.User)
+	})
+
+	return result, err
+}
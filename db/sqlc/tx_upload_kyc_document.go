@@ -0,0 +1,36 @@
+package db
+
+import "context"
+
+type UploadKycDocumentTxParams struct {
+	CreateKycDocumentParams
+}
+
+type UploadKycDocumentTxResult struct {
+	Document KycDocument
+	User     User
+}
+
+// UploadKycDocumentTx records an uploaded document for review and puts the user in review. ErrRecordNotFound
+// is returned when a document of the same kind is already waiting for review.
+func (store *SQLStore) UploadKycDocumentTx(ctx context.Context, arg UploadKycDocumentTxParams) (UploadKycDocumentTxResult, error) {
+	var result UploadKycDocumentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.Document, err = q.CreateKycDocument(ctx, arg.CreateKycDocumentParams)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = refreshUserKyc(ctx, q, arg.Username)
+
+		return err
+	})
+
+	return result, err
+}
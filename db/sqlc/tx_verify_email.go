@@ -1,9 +1,6 @@
 package db
 
-import (
-	"context"
-	"github.com/jackc/pgx/v5/pgtype"
-)
+import "context"
 
 type VerifyEmailTxParams struct {
 	EmailId    int64
@@ -29,12 +26,10 @@ func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParam
 			return err
 		}
 
-		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+		// A link sent to an address the user has since changed away from must not verify the new one
+		result.User, err = q.VerifyUserEmail(ctx, VerifyUserEmailParams{
 			Username: result.VerifyEmail.Username,
-			IsEmailVerified: pgtype.Bool{
-				Bool:  true,
-				Valid: true,
-			},
+			Email:    result.VerifyEmail.Email,
 		})
 		if err != nil {
 			return err
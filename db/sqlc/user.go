@@ -7,11 +7,23 @@ import (
 )
 
 func (user *User) ToResponse() *pb.User {
-	return &pb.User{
+	response := &pb.User{
 		Username:          user.Username,
 		FullName:          user.FullName,
 		Email:             user.Email,
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
+		Role:              user.Role,
+		IsEmailVerified:   user.IsEmailVerified,
+		KycTier:           user.KycTier,
+		KycStatus:         user.KycStatus,
+		Status:            user.Status,
+		InvitedBy:         user.InvitedBy.String,
 	}
+
+	if user.RestrictedAt.Valid {
+		response.RestrictedAt = timestamppb.New(user.RestrictedAt.Time)
+	}
+
+	return response
 }
@@ -7,21 +7,23 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, hashed_password, full_name, email)
-VALUES ($1, $2, $3, $4)
-RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role
+INSERT INTO users (username, hashed_password, full_name, email, invited_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
 `
 
 type CreateUserParams struct {
-	Username       string `json:"username"`
-	HashedPassword string `json:"hashed_password"`
-	FullName       string `json:"full_name"`
-	Email          string `json:"email"`
+	Username       string      `json:"username"`
+	HashedPassword string      `json:"hashed_password"`
+	FullName       string      `json:"full_name"`
+	Email          string      `json:"email"`
+	InvitedBy      pgtype.Text `json:"invited_by"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -30,6 +32,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.HashedPassword,
 		arg.FullName,
 		arg.Email,
+		arg.InvitedBy,
 	)
 	var i User
 	err := row.Scan(
@@ -41,12 +44,66 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const eraseUser = `-- name: EraseUser :one
+UPDATE users
+SET username          = $1,
+    full_name         = '',
+    email             = $2,
+    hashed_password   = '',
+    is_email_verified = FALSE,
+    status            = 'deactivated',
+    erased_at         = now()
+WHERE username = $3
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type EraseUserParams struct {
+	Pseudonym string `json:"pseudonym"`
+	Email     string `json:"email"`
+	Username  string `json:"username"`
+}
+
+// Renaming the user to the pseudonym cascades to every table referring to them.
+func (q *Queries) EraseUser(ctx context.Context, arg EraseUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, eraseUser, arg.Pseudonym, arg.Email, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role
+SELECT username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
 FROM users
 WHERE username = $1
 LIMIT 1
@@ -64,6 +121,369 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.PasswordChangedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+FROM users
+WHERE email = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+FROM users
+WHERE username = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetUserForUpdate(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserForUpdate, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const getUserStatus = `-- name: GetUserStatus :one
+SELECT status
+FROM users
+WHERE username = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserStatus(ctx context.Context, username string) (string, error) {
+	row := q.db.QueryRow(ctx, getUserStatus, username)
+	var status string
+	err := row.Scan(&status)
+	return status, err
+}
+
+const liftUserRestriction = `-- name: LiftUserRestriction :one
+UPDATE users
+SET restricted_at = NULL
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+func (q *Queries) LiftUserRestriction(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, liftUserRestriction, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const lockUser = `-- name: LockUser :one
+UPDATE users
+SET locked_until = $1
+WHERE username = $2
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type LockUserParams struct {
+	LockedUntil pgtype.Timestamptz `json:"locked_until"`
+	Username    string             `json:"username"`
+}
+
+func (q *Queries) LockUser(ctx context.Context, arg LockUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, lockUser, arg.LockedUntil, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const markVerificationEmailSent = `-- name: MarkVerificationEmailSent :one
+UPDATE users
+SET verification_email_sent_at = now()
+WHERE username = $1
+  AND (verification_email_sent_at IS NULL OR verification_email_sent_at <= $2::timestamptz)
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type MarkVerificationEmailSentParams struct {
+	Username   string    `json:"username"`
+	SentBefore time.Time `json:"sent_before"`
+}
+
+func (q *Queries) MarkVerificationEmailSent(ctx context.Context, arg MarkVerificationEmailSentParams) (User, error) {
+	row := q.db.QueryRow(ctx, markVerificationEmailSent, arg.Username, arg.SentBefore)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const rehashUserPassword = `-- name: RehashUserPassword :one
+UPDATE users
+SET hashed_password = $1
+WHERE username = $2
+  AND hashed_password = $3
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type RehashUserPasswordParams struct {
+	NewHashedPassword string `json:"new_hashed_password"`
+	Username          string `json:"username"`
+	OldHashedPassword string `json:"old_hashed_password"`
+}
+
+func (q *Queries) RehashUserPassword(ctx context.Context, arg RehashUserPasswordParams) (User, error) {
+	row := q.db.QueryRow(ctx, rehashUserPassword, arg.NewHashedPassword, arg.Username, arg.OldHashedPassword)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const restrictUser = `-- name: RestrictUser :one
+UPDATE users
+SET restricted_at = coalesce(restricted_at, now())
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+func (q *Queries) RestrictUser(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, restrictUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+FROM users
+WHERE ($1::varchar IS NULL OR starts_with(username, $1))
+  AND ($2::varchar IS NULL OR lower(email) = lower($2))
+  AND ($3::varchar IS NULL OR full_name ILIKE '%' || $3 || '%')
+  AND ($4::varchar IS NULL OR username > $4)
+ORDER BY username
+LIMIT $5
+`
+
+type SearchUsersParams struct {
+	UsernamePrefix pgtype.Text `json:"username_prefix"`
+	Email          pgtype.Text `json:"email"`
+	FullName       pgtype.Text `json:"full_name"`
+	AfterUsername  pgtype.Text `json:"after_username"`
+	PageSize       int32       `json:"page_size"`
+}
+
+// Lists the users matching all given filters ordered by username, the email has to match exactly.
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsers,
+		arg.UsernamePrefix,
+		arg.Email,
+		arg.FullName,
+		arg.AfterUsername,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.CreatedAt,
+			&i.PasswordChangedAt,
+			&i.IsEmailVerified,
+			&i.Role,
+			&i.LockedUntil,
+			&i.UnlockedAt,
+			&i.VerificationEmailSentAt,
+			&i.ErasedAt,
+			&i.KycTier,
+			&i.KycStatus,
+			&i.Status,
+			&i.InvitedBy,
+			&i.RestrictedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unlockUser = `-- name: UnlockUser :one
+UPDATE users
+SET locked_until = NULL,
+    unlocked_at  = now()
+WHERE username = $1
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+func (q *Queries) UnlockUser(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, unlockUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
 	)
 	return i, err
 }
@@ -76,7 +496,7 @@ SET hashed_password     = coalesce($1, hashed_password),
     email               = coalesce($4, email),
     is_email_verified   = coalesce($5, is_email_verified)
 WHERE username = $6
-RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
 `
 
 type UpdateUserParams struct {
@@ -107,6 +527,166 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.PasswordChangedAt,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const updateUserKyc = `-- name: UpdateUserKyc :one
+UPDATE users
+SET kyc_tier   = $1,
+    kyc_status = $2
+WHERE username = $3
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type UpdateUserKycParams struct {
+	KycTier   int32  `json:"kyc_tier"`
+	KycStatus string `json:"kyc_status"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) UpdateUserKyc(ctx context.Context, arg UpdateUserKycParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserKyc, arg.KycTier, arg.KycStatus, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $1
+WHERE username = $2
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type UpdateUserRoleParams struct {
+	Role     string `json:"role"`
+	Username string `json:"username"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRole, arg.Role, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const updateUserStatus = `-- name: UpdateUserStatus :one
+UPDATE users
+SET status = $1
+WHERE username = $2
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type UpdateUserStatusParams struct {
+	Status   string `json:"status"`
+	Username string `json:"username"`
+}
+
+func (q *Queries) UpdateUserStatus(ctx context.Context, arg UpdateUserStatusParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserStatus, arg.Status, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
+	)
+	return i, err
+}
+
+const verifyUserEmail = `-- name: VerifyUserEmail :one
+UPDATE users
+SET is_email_verified = TRUE
+WHERE username = $1
+  AND email = $2
+RETURNING username, hashed_password, full_name, email, created_at, password_changed_at, is_email_verified, role, locked_until, unlocked_at, verification_email_sent_at, erased_at, kyc_tier, kyc_status, status, invited_by, restricted_at
+`
+
+type VerifyUserEmailParams struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+func (q *Queries) VerifyUserEmail(ctx context.Context, arg VerifyUserEmailParams) (User, error) {
+	row := q.db.QueryRow(ctx, verifyUserEmail, arg.Username, arg.Email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordChangedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.LockedUntil,
+		&i.UnlockedAt,
+		&i.VerificationEmailSentAt,
+		&i.ErasedAt,
+		&i.KycTier,
+		&i.KycStatus,
+		&i.Status,
+		&i.InvitedBy,
+		&i.RestrictedAt,
 	)
 	return i, err
 }
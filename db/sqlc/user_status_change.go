@@ -0,0 +1,19 @@
+package db
+
+import (
+	"simplebank/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (change UserStatusChange) ToResponse() *pb.UserStatusChange {
+	return &pb.UserStatusChange{
+		Id:             change.ID,
+		Username:       change.Username,
+		PreviousStatus: change.PreviousStatus,
+		Status:         change.Status,
+		Reason:         change.Reason,
+		ChangedBy:      change.ChangedBy,
+		CreatedAt:      timestamppb.New(change.CreatedAt),
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: user_status_change.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createUserStatusChange = `-- name: CreateUserStatusChange :one
+INSERT INTO user_status_changes (username, previous_status, status, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, username, previous_status, status, reason, changed_by, created_at
+`
+
+type CreateUserStatusChangeParams struct {
+	Username       string `json:"username"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+	Reason         string `json:"reason"`
+	ChangedBy      string `json:"changed_by"`
+}
+
+func (q *Queries) CreateUserStatusChange(ctx context.Context, arg CreateUserStatusChangeParams) (UserStatusChange, error) {
+	row := q.db.QueryRow(ctx, createUserStatusChange,
+		arg.Username,
+		arg.PreviousStatus,
+		arg.Status,
+		arg.Reason,
+		arg.ChangedBy,
+	)
+	var i UserStatusChange
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PreviousStatus,
+		&i.Status,
+		&i.Reason,
+		&i.ChangedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUserStatusChanges = `-- name: ListUserStatusChanges :many
+SELECT id, username, previous_status, status, reason, changed_by, created_at
+FROM user_status_changes
+WHERE username = $1
+ORDER BY id DESC
+`
+
+func (q *Queries) ListUserStatusChanges(ctx context.Context, username string) ([]UserStatusChange, error) {
+	rows, err := q.db.Query(ctx, listUserStatusChanges, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserStatusChange{}
+	for rows.Next() {
+		var i UserStatusChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.PreviousStatus,
+			&i.Status,
+			&i.Reason,
+			&i.ChangedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
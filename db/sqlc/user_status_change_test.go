@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"simplebank/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeUserStatusTx(t *testing.T) {
+	account := createRandomAccount(t)
+	banker := createRandomUser(t)
+	session := createRandomSession(t, account.Owner)
+
+	suspended, err := testStore.ChangeUserStatusTx(context.Background(), ChangeUserStatusTxParams{
+		Username:       account.Owner,
+		Status:         util.UserStatusSuspended,
+		Reason:         "suspected account takeover",
+		ChangedBy:      banker.Username,
+		FreezeAccounts: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.UserStatusSuspended, suspended.User.Status)
+	require.Equal(t, util.UserStatusActive, suspended.StatusChange.PreviousStatus)
+	require.Equal(t, banker.Username, suspended.StatusChange.ChangedBy)
+	require.Equal(t, int64(1), suspended.BlockedSessions)
+	require.Len(t, suspended.Accounts, 1)
+	require.True(t, suspended.Accounts[0].FrozenAt.Valid)
+
+	blockedSession, err := testStore.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blockedSession.IsBlocked)
+
+	// money no longer moves in or out of the frozen account
+	_, err = testStore.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: account.ID, Amount: 10})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.ChangeUserStatusTx(context.Background(), ChangeUserStatusTxParams{
+		Username:  account.Owner,
+		Status:    util.UserStatusSuspended,
+		Reason:    "suspected account takeover",
+		ChangedBy: banker.Username,
+	})
+	require.ErrorIs(t, err, ErrUserStatusUnchanged)
+
+	reactivated, err := testStore.ChangeUserStatusTx(context.Background(), ChangeUserStatusTxParams{
+		Username:         account.Owner,
+		Status:           util.UserStatusActive,
+		Reason:           "identity confirmed by phone",
+		ChangedBy:        banker.Username,
+		UnfreezeAccounts: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.UserStatusActive, reactivated.User.Status)
+	require.Zero(t, reactivated.BlockedSessions)
+	require.Len(t, reactivated.Accounts, 1)
+	require.False(t, reactivated.Accounts[0].FrozenAt.Valid)
+
+	statusChanges, err := testStore.ListUserStatusChanges(context.Background(), account.Owner)
+	require.NoError(t, err)
+	require.Len(t, statusChanges, 2)
+	require.Equal(t, reactivated.StatusChange.ID, statusChanges[0].ID)
+	require.Equal(t, util.UserStatusSuspended, statusChanges[0].PreviousStatus)
+}
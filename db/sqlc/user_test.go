@@ -5,6 +5,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"simplebank/util"
+	"strings"
 	"testing"
 	"time"
 )
@@ -147,3 +148,108 @@ func TestUpdateUser(t *testing.T) {
 	require.NotEqual(t, oldUser.HashedPassword, dbRecord.HashedPassword)
 	require.Equal(t, newPassword, dbRecord.HashedPassword)
 }
+
+func TestUpdateUserTxResetsEmailVerification(t *testing.T) {
+	user := createRandomUser(t)
+
+	verifyEmail, err := testStore.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   user.Username,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	// the link for the old address must not verify the new one
+	newEmail := util.RandomEmail()
+	var afterUpdateCalled bool
+
+	result, err := testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			Username: user.Username,
+			Email:    pgtype.Text{String: newEmail, Valid: true},
+		},
+		AfterUpdate: func(before User, after User) error {
+			afterUpdateCalled = true
+			require.Equal(t, user.Email, before.Email)
+			require.Equal(t, newEmail, after.Email)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, afterUpdateCalled)
+	require.False(t, result.User.IsEmailVerified)
+
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	dbUser, err := testStore.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.False(t, dbUser.IsEmailVerified)
+}
+
+func TestMarkVerificationEmailSent(t *testing.T) {
+	user := createRandomUser(t)
+
+	// sign-up already sent one
+	_, err := testStore.MarkVerificationEmailSent(context.Background(), MarkVerificationEmailSentParams{
+		Username:   user.Username,
+		SentBefore: time.Now().Add(-time.Minute),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	dbUser, err := testStore.MarkVerificationEmailSent(context.Background(), MarkVerificationEmailSentParams{
+		Username:   user.Username,
+		SentBefore: time.Now().Add(time.Second),
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), dbUser.VerificationEmailSentAt.Time, time.Second)
+}
+
+func TestRehashUserPassword(t *testing.T) {
+	user := createRandomUser(t)
+
+	newHashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	// a hash that was replaced in the meantime is left alone
+	_, err = testStore.RehashUserPassword(context.Background(), RehashUserPasswordParams{
+		Username:          user.Username,
+		OldHashedPassword: "outdated",
+		NewHashedPassword: newHashedPassword,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	dbUser, err := testStore.RehashUserPassword(context.Background(), RehashUserPasswordParams{
+		Username:          user.Username,
+		OldHashedPassword: user.HashedPassword,
+		NewHashedPassword: newHashedPassword,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newHashedPassword, dbUser.HashedPassword)
+	require.Equal(t, user.PasswordChangedAt, dbUser.PasswordChangedAt)
+}
+
+func TestSearchUsers(t *testing.T) {
+	user := createRandomUser(t)
+
+	users, err := testStore.SearchUsers(context.Background(), SearchUsersParams{
+		UsernamePrefix: pgtype.Text{String: user.Username[:4], Valid: true},
+		Email:          pgtype.Text{String: strings.ToUpper(user.Email), Valid: true},
+		FullName:       pgtype.Text{String: user.FullName[1:], Valid: true},
+		PageSize:       5,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.Username, users[0].Username)
+
+	users, err = testStore.SearchUsers(context.Background(), SearchUsersParams{
+		Email:         pgtype.Text{String: user.Email, Valid: true},
+		AfterUsername: pgtype.Text{String: user.Username, Valid: true},
+		PageSize:      5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, users)
+}
@@ -36,6 +36,24 @@ func (q *Queries) CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailPa
 	return i, err
 }
 
+const eraseVerifyEmails = `-- name: EraseVerifyEmails :exec
+UPDATE "verify_emails"
+SET email       = $1,
+    secret_code = '',
+    is_used     = TRUE
+WHERE username = $2
+`
+
+type EraseVerifyEmailsParams struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+func (q *Queries) EraseVerifyEmails(ctx context.Context, arg EraseVerifyEmailsParams) error {
+	_, err := q.db.Exec(ctx, eraseVerifyEmails, arg.Email, arg.Username)
+	return err
+}
+
 const updateVerifyEmail = `-- name: UpdateVerifyEmail :one
 UPDATE "verify_emails"
 SET is_used = TRUE
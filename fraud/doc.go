@@ -0,0 +1,3 @@
+// Package fraud decides whether a transfer may be posted, has to be reviewed by a banker or is blocked,
+// using rules written as expressions over features of the transfer and the history of the sender.
+package fraud
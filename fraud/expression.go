@@ -0,0 +1,393 @@
+package fraud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// kind is the type of an expression, rule conditions have to be boolean.
+type kind int
+
+const (
+	kindNumber kind = iota
+	kindBool
+)
+
+func (k kind) String() string {
+	if k == kindBool {
+		return "bool"
+	}
+
+	return "number"
+}
+
+// value holds the result of evaluating an expression of either kind.
+type value struct {
+	number  float64
+	boolean bool
+}
+
+// expression is a type checked node of a parsed condition.
+type expression interface {
+	kind() kind
+	eval(variables map[string]value) value
+}
+
+type literal struct {
+	k kind
+	v value
+}
+
+func (e literal) kind() kind                    { return e.k }
+func (e literal) eval(_ map[string]value) value { return e.v }
+
+type variable struct {
+	name string
+	k    kind
+}
+
+func (e variable) kind() kind                            { return e.k }
+func (e variable) eval(variables map[string]value) value { return variables[e.name] }
+
+type unary struct {
+	op      string
+	operand expression
+}
+
+func (e unary) kind() kind { return e.operand.kind() }
+
+func (e unary) eval(variables map[string]value) value {
+	operand := e.operand.eval(variables)
+	if e.op == "!" {
+		return value{boolean: !operand.boolean}
+	}
+
+	return value{number: -operand.number}
+}
+
+type binary struct {
+	op          string
+	left, right expression
+}
+
+func (e binary) kind() kind {
+	switch e.op {
+	case "+", "-", "*", "/":
+		return kindNumber
+	default:
+		return kindBool
+	}
+}
+
+func (e binary) eval(variables map[string]value) value {
+	left := e.left.eval(variables)
+
+	// Short circuit, so conditions like "avg_amount > 0 && amount / avg_amount > 5" do what they read like
+	switch e.op {
+	case "&&":
+		if !left.boolean {
+			return value{}
+		}
+		return value{boolean: e.right.eval(variables).boolean}
+	case "||":
+		if left.boolean {
+			return value{boolean: true}
+		}
+		return value{boolean: e.right.eval(variables).boolean}
+	}
+
+	right := e.right.eval(variables)
+
+	switch e.op {
+	case "+":
+		return value{number: left.number + right.number}
+	case "-":
+		return value{number: left.number - right.number}
+	case "*":
+		return value{number: left.number * right.number}
+	case "/":
+		if right.number == 0 {
+			return value{}
+		}
+		return value{number: left.number / right.number}
+	case "<":
+		return value{boolean: left.number < right.number}
+	case "<=":
+		return value{boolean: left.number <= right.number}
+	case ">":
+		return value{boolean: left.number > right.number}
+	case ">=":
+		return value{boolean: left.number >= right.number}
+	case "==":
+		if e.left.kind() == kindBool {
+			return value{boolean: left.boolean == right.boolean}
+		}
+		return value{boolean: left.number == right.number}
+	case "!=":
+		if e.left.kind() == kindBool {
+			return value{boolean: left.boolean != right.boolean}
+		}
+		return value{boolean: left.number != right.number}
+	}
+
+	panic(fmt.Sprintf("unknown operator %s", e.op))
+}
+
+// parseCondition parses a boolean expression over the given variables. Expressions support numbers, true and false,
+// arithmetic with + - * /, comparisons, the logical operators && || ! and parentheses, division by zero yields 0.
+func parseCondition(source string, variables map[string]kind) (expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, variables: variables}
+	condition, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() != "" {
+		return nil, fmt.Errorf("unexpected %q", p.peek())
+	}
+
+	if condition.kind() != kindBool {
+		return nil, fmt.Errorf("condition must be a bool, not a %s", condition.kind())
+	}
+
+	return condition, nil
+}
+
+var operators = []string{"&&", "||", "<=", ">=", "==", "!=", "<", ">", "+", "-", "*", "/", "!", "(", ")"}
+
+func tokenize(source string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(source); {
+		c := rune(source[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(source) && (unicode.IsDigit(rune(source[i])) || source[i] == '.' || source[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, source[start:i])
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(source) && (unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i])) || source[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, source[start:i])
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, op)
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+type parser struct {
+	tokens    []string
+	position  int
+	variables map[string]kind
+}
+
+func (p *parser) peek() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() string {
+	token := p.peek()
+	p.position++
+
+	return token
+}
+
+func (p *parser) parseOr() (expression, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (expression, error) {
+	return p.parseLogical("&&", p.parseNot)
+}
+
+func (p *parser) parseLogical(op string, operand func() (expression, error)) (expression, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == op {
+		p.next()
+
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		if left.kind() != kindBool || right.kind() != kindBool {
+			return nil, fmt.Errorf("operands of %s must be bools", op)
+		}
+
+		left = binary{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (expression, error) {
+	if p.peek() != "!" {
+		return p.parseComparison()
+	}
+
+	p.next()
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	if operand.kind() != kindBool {
+		return nil, fmt.Errorf("operand of ! must be a bool")
+	}
+
+	return unary{op: "!", operand: operand}, nil
+}
+
+func (p *parser) parseComparison() (expression, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	switch op {
+	case "<", "<=", ">", ">=", "==", "!=":
+	default:
+		return left, nil
+	}
+
+	p.next()
+	right, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	if left.kind() != right.kind() {
+		return nil, fmt.Errorf("cannot compare a %s with a %s", left.kind(), right.kind())
+	}
+
+	if left.kind() == kindBool && op != "==" && op != "!=" {
+		return nil, fmt.Errorf("operands of %s must be numbers", op)
+	}
+
+	return binary{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseSum() (expression, error) {
+	return p.parseArithmetic([]string{"+", "-"}, p.parseProduct)
+}
+
+func (p *parser) parseProduct() (expression, error) {
+	return p.parseArithmetic([]string{"*", "/"}, p.parseNegation)
+}
+
+func (p *parser) parseArithmetic(ops []string, operand func() (expression, error)) (expression, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for op := p.peek(); op == ops[0] || op == ops[1]; op = p.peek() {
+		p.next()
+
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		if left.kind() != kindNumber || right.kind() != kindNumber {
+			return nil, fmt.Errorf("operands of %s must be numbers", op)
+		}
+
+		left = binary{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNegation() (expression, error) {
+	if p.peek() != "-" {
+		return p.parsePrimary()
+	}
+
+	p.next()
+	operand, err := p.parseNegation()
+	if err != nil {
+		return nil, err
+	}
+
+	if operand.kind() != kindNumber {
+		return nil, fmt.Errorf("operand of - must be a number")
+	}
+
+	return unary{op: "-", operand: operand}, nil
+}
+
+func (p *parser) parsePrimary() (expression, error) {
+	token := p.next()
+
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case token == "(":
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+
+		return inner, nil
+	case token == "true" || token == "false":
+		return literal{k: kindBool, v: value{boolean: token == "true"}}, nil
+	case unicode.IsDigit(rune(token[0])) || token[0] == '.':
+		number, err := strconv.ParseFloat(strings.ReplaceAll(token, "_", ""), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", token)
+		}
+
+		return literal{k: kindNumber, v: value{number: number}}, nil
+	case unicode.IsLetter(rune(token[0])) || token[0] == '_':
+		k, ok := p.variables[token]
+		if !ok {
+			return nil, fmt.Errorf("unknown feature %q", token)
+		}
+
+		return variable{name: token, k: k}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected %q", token)
+}
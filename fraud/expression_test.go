@@ -0,0 +1,66 @@
+package fraud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCondition(t *testing.T) {
+	features := Features{
+		Amount:            60_000,
+		AverageAmount:     10_000,
+		TransfersLastHour: 3,
+		NewPayee:          true,
+	}
+
+	testCases := []struct {
+		name      string
+		condition string
+		matches   bool
+	}{
+		{"Comparison", "amount >= 60_000", true},
+		{"Arithmetic", "amount > 5 * avg_amount + 1000", true},
+		{"Precedence", "transfers_last_hour - 1 * 2 == 1", true},
+		{"Negation", "-amount < 0", true},
+		{"Parentheses", "(transfers_last_hour - 1) * 2 == 1", false},
+		{"And", "new_payee && own_account", false},
+		{"Or", "own_account || new_payee", true},
+		{"Not", "!own_account", true},
+		{"BoolEquality", "new_payee == true", true},
+		{"DivisionByZero", "amount / amount_last_day == 0", true},
+		{"ShortCircuit", "amount_last_day > 0 && amount / amount_last_day > 1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			condition, err := parseCondition(tc.condition, featureKinds)
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, condition.eval(features.variables()).boolean)
+		})
+	}
+}
+
+func TestParseConditionErrors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		condition string
+	}{
+		{"NotBool", "amount * 2"},
+		{"UnknownFeature", "balance > 0"},
+		{"CompareBoolWithNumber", "new_payee > 1"},
+		{"ArithmeticOnBool", "new_payee + 1 > 0"},
+		{"NotOnNumber", "!amount"},
+		{"MissingParenthesis", "(amount > 1"},
+		{"TrailingToken", "amount > 1 1"},
+		{"UnexpectedCharacter", "amount > $1"},
+		{"Empty", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseCondition(tc.condition, featureKinds)
+			require.Error(t, err)
+		})
+	}
+}
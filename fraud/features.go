@@ -0,0 +1,47 @@
+package fraud
+
+// Features describe a transfer and the recent history of its sender, amounts are in minor units regardless of
+// currency. The JSON names are the names rules refer to them by.
+type Features struct {
+	Amount int64 `json:"amount"`
+	// AverageAmount of the transfers the sender made in the last 90 days, 0 if there were none
+	AverageAmount float64 `json:"avg_amount"`
+	// TransfersLastHour counts the transfers the sender made in the last hour, not including this one
+	TransfersLastHour int64 `json:"transfers_last_hour"`
+	// AmountLastDay adds up what the sender transferred in the last 24 hours, not including this transfer
+	AmountLastDay int64 `json:"amount_last_day"`
+	// NewPayee is set when the sender did not transfer to the receiving account in the last 90 days
+	NewPayee bool `json:"new_payee"`
+	// OwnAccount is set when the sender owns the receiving account as well
+	OwnAccount     bool    `json:"own_account"`
+	AccountAgeDays float64 `json:"account_age_days"`
+	UserAgeDays    float64 `json:"user_age_days"`
+	// NewDevice is set when the sender never logged in with the user agent of the session before
+	NewDevice bool `json:"new_device"`
+}
+
+var featureKinds = map[string]kind{
+	"amount":              kindNumber,
+	"avg_amount":          kindNumber,
+	"transfers_last_hour": kindNumber,
+	"amount_last_day":     kindNumber,
+	"new_payee":           kindBool,
+	"own_account":         kindBool,
+	"account_age_days":    kindNumber,
+	"user_age_days":       kindNumber,
+	"new_device":          kindBool,
+}
+
+func (f Features) variables() map[string]value {
+	return map[string]value{
+		"amount":              {number: float64(f.Amount)},
+		"avg_amount":          {number: f.AverageAmount},
+		"transfers_last_hour": {number: float64(f.TransfersLastHour)},
+		"amount_last_day":     {number: float64(f.AmountLastDay)},
+		"new_payee":           {boolean: f.NewPayee},
+		"own_account":         {boolean: f.OwnAccount},
+		"account_age_days":    {number: f.AccountAgeDays},
+		"user_age_days":       {number: f.UserAgeDays},
+		"new_device":          {boolean: f.NewDevice},
+	}
+}
@@ -0,0 +1,139 @@
+package fraud
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Action is what happens to a transfer a rule matches.
+type Action string
+
+const (
+	ActionAllow  Action = "allow"
+	ActionReview Action = "review"
+	ActionBlock  Action = "block"
+)
+
+// DefaultRules are used when no rules file is configured. Every line of a rules file is a rule written as
+// "<name>: <allow|review|block> when <condition>", empty lines and lines starting with # are ignored.
+const DefaultRules = `
+# Moving money between your own accounts is not what fraudsters are after
+own_account: allow when own_account
+
+# Emptying a taken over account takes many transfers in a short time
+velocity: block when transfers_last_hour >= 10
+
+# Far more than the sender usually transfers, to someone they never paid before
+unusual_amount_new_payee: review when new_payee && avg_amount > 0 && amount > 5 * avg_amount
+
+# Stolen credentials are mostly used from a device the customer never logged in with
+new_device_large_amount: review when new_device && amount >= 50000
+
+# Accounts opened to launder money are used soon after they are opened
+new_account_large_amount: review when account_age_days < 7 && amount >= 100000
+`
+
+var rulePattern = regexp.MustCompile(`^([a-z0-9_]+)\s*:\s*(allow|review|block)\s+when\s+(.+)$`)
+
+// rule decides about the transfers its condition holds for.
+type rule struct {
+	name      string
+	action    Action
+	condition expression
+}
+
+// Decision is the outcome of evaluating the rules for a transfer.
+type Decision struct {
+	Action Action
+	// Rule is the name of the rule that decided, empty if no rule matched
+	Rule string
+}
+
+// Engine evaluates rules in order, the first rule whose condition holds decides and transfers no rule matches
+// are allowed. Allow rules therefore make exceptions to the rules that follow them.
+type Engine struct {
+	rules []rule
+}
+
+// NewEngine parses the rules, the rules from DefaultRules are used when the reader is nil.
+func NewEngine(reader io.Reader) (*Engine, error) {
+	if reader == nil {
+		reader = strings.NewReader(DefaultRules)
+	}
+
+	rules, err := parseRules(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{rules: rules}, nil
+}
+
+// LoadEngine reads the rules from the file at path, or uses DefaultRules if path is empty.
+func LoadEngine(path string) (*Engine, error) {
+	if path == "" {
+		return NewEngine(nil)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open fraud rules: %w", err)
+	}
+	defer file.Close()
+
+	return NewEngine(file)
+}
+
+func parseRules(reader io.Reader) ([]rule, error) {
+	var rules []rule
+	names := make(map[string]bool)
+
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		match := rulePattern.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("line %d: expected <name>: <allow|review|block> when <condition>", lineNumber)
+		}
+
+		name := match[1]
+		if names[name] {
+			return nil, fmt.Errorf("line %d: duplicate rule %s", lineNumber, name)
+		}
+		names[name] = true
+
+		condition, err := parseCondition(match[3], featureKinds)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: rule %s: %w", lineNumber, name, err)
+		}
+
+		rules = append(rules, rule{name: name, action: Action(match[2]), condition: condition})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read fraud rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// Evaluate decides about a transfer with the given features.
+func (engine *Engine) Evaluate(features Features) Decision {
+	variables := features.variables()
+
+	for _, r := range engine.rules {
+		if r.condition.eval(variables).boolean {
+			return Decision{Action: r.action, Rule: r.name}
+		}
+	}
+
+	return Decision{Action: ActionAllow}
+}
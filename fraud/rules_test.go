@@ -0,0 +1,86 @@
+package fraud
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRules(t *testing.T) {
+	engine, err := LoadEngine("")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		features Features
+		decision Decision
+	}{
+		{
+			"NoRuleMatches",
+			Features{Amount: 1000, AverageAmount: 800, AccountAgeDays: 100},
+			Decision{Action: ActionAllow},
+		},
+		{
+			"OwnAccountIsAnException",
+			Features{Amount: 1000, TransfersLastHour: 20, OwnAccount: true},
+			Decision{Action: ActionAllow, Rule: "own_account"},
+		},
+		{
+			"Velocity",
+			Features{Amount: 1000, TransfersLastHour: 10, AccountAgeDays: 100},
+			Decision{Action: ActionBlock, Rule: "velocity"},
+		},
+		{
+			"UnusualAmountToNewPayee",
+			Features{Amount: 6000, AverageAmount: 1000, NewPayee: true, AccountAgeDays: 100},
+			Decision{Action: ActionReview, Rule: "unusual_amount_new_payee"},
+		},
+		{
+			"NewDevice",
+			Features{Amount: 50000, NewDevice: true, AccountAgeDays: 100},
+			Decision{Action: ActionReview, Rule: "new_device_large_amount"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.decision, engine.Evaluate(tc.features))
+		})
+	}
+}
+
+func TestLoadEngine(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "rules.txt")
+	rules := "# only one rule\n\nlarge: block when amount > 100\n"
+	require.NoError(t, os.WriteFile(rulesPath, []byte(rules), 0o600))
+
+	engine, err := LoadEngine(rulesPath)
+	require.NoError(t, err)
+	require.Equal(t, Decision{Action: ActionBlock, Rule: "large"}, engine.Evaluate(Features{Amount: 101}))
+	require.Equal(t, Decision{Action: ActionAllow}, engine.Evaluate(Features{Amount: 100}))
+
+	_, err = LoadEngine(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestNewEngineErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rules string
+	}{
+		{"MissingWhen", "large: block amount > 100"},
+		{"UnknownAction", "large: hold when amount > 100"},
+		{"DuplicateName", "large: block when amount > 100\nlarge: review when amount > 10"},
+		{"InvalidCondition", "large: block when amount >"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewEngine(strings.NewReader(tc.rules))
+			require.Error(t, err)
+		})
+	}
+}
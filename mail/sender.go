@@ -27,6 +27,11 @@ type ProtonSender struct {
 type EmailData struct {
 	FullName  string
 	VerifyURL string
+	// Template selects the email body, the email verification template is used when empty
+	Template Template
+	// Message and ActionURL are rendered by the notification template
+	Message   string
+	ActionURL string
 }
 
 func NewProtonSender(username, fromEmailAddress, fromEmailPassword, certPath, keyPath string) EmailSender {
@@ -60,21 +65,17 @@ func (sender ProtonSender) SendEmail(subject string, content EmailData, to, cc,
 
 	message.Subject(subject)
 
-	textBodyTemplate := `Hello {{.FullName}}!
-
-Thank you for registering with us!
-Please verify your email address by clicking this link: {{.VerifyURL}}`
-
-	htmlBodyTemplate := `Hello <b>{{.FullName}}!</b><br/>
-Thank you for registering with us!<br/>
-Please <a href="{{.VerifyURL}}" target="_blank">click here<a/> to verify your email address`
+	body, ok := templates[content.Template]
+	if !ok {
+		return fmt.Errorf("unknown email template: %s", content.Template)
+	}
 
-	textTmpl, err := tt.New("verify_email_text").Parse(textBodyTemplate)
+	textTmpl, err := tt.New(string(body.name) + "_text").Parse(body.text)
 	if err != nil {
 		return fmt.Errorf("failed to parse text template: %w", err)
 	}
 
-	htmlTmpl, err := ht.New("verify_email_html").Parse(htmlBodyTemplate)
+	htmlTmpl, err := ht.New(string(body.name) + "_html").Parse(body.html)
 	if err != nil {
 		return fmt.Errorf("failed to parse html template: %w", err)
 	}
@@ -0,0 +1,43 @@
+package mail
+
+// Template identifies the body of an email.
+type Template string
+
+const (
+	VerifyEmailTemplate  Template = "verify_email"
+	NotificationTemplate Template = "notification"
+)
+
+type bodyTemplate struct {
+	name Template
+	text string
+	html string
+}
+
+var verifyEmailBody = bodyTemplate{
+	name: VerifyEmailTemplate,
+	text: `Hello {{.FullName}}!
+
+Thank you for registering with us!
+Please verify your email address by clicking this link: {{.VerifyURL}}`,
+	html: `Hello <b>{{.FullName}}!</b><br/>
+Thank you for registering with us!<br/>
+Please <a href="{{.VerifyURL}}" target="_blank">click here<a/> to verify your email address`,
+}
+
+var notificationBody = bodyTemplate{
+	name: NotificationTemplate,
+	text: `Hello {{.FullName}}!
+
+{{.Message}}{{if .ActionURL}}
+{{.ActionURL}}{{end}}`,
+	html: `Hello <b>{{.FullName}}!</b><br/>
+{{.Message}}{{if .ActionURL}}<br/>
+<a href="{{.ActionURL}}" target="_blank">Open in Simple Bank<a/>{{end}}`,
+}
+
+var templates = map[Template]bodyTemplate{
+	"":                   verifyEmailBody,
+	VerifyEmailTemplate:  verifyEmailBody,
+	NotificationTemplate: notificationBody,
+}
@@ -8,13 +8,17 @@ import (
 	"os"
 	"os/signal"
 	"simplebank/api"
+	"simplebank/api/clients"
 	"simplebank/api/core"
 	db "simplebank/db/sqlc"
 	_ "simplebank/docs/statik"
 	"simplebank/mail"
 	"simplebank/pb"
+	"simplebank/token"
 	"simplebank/util"
+	"simplebank/val"
 	"simplebank/worker"
+	"strings"
 	"syscall"
 
 	"github.com/golang-migrate/migrate/v4"
@@ -30,6 +34,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -48,6 +53,17 @@ func main() {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
 
+	// Emailed links carry reset and invitation tokens, which must not travel in plain text
+	if config.Environment != "development" && !strings.HasPrefix(config.PublicBaseURL, "https://") {
+		log.Fatal().Str("public_base_url", config.PublicBaseURL).Msg("public base url must use https")
+	}
+
+	passwordPolicy, err := val.NewPasswordPolicy(config.PasswordMinLength, config.PasswordMinCharClasses, config.BreachedPasswordsPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load password policy")
+	}
+	val.SetPasswordPolicy(passwordPolicy)
+
 	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
 	defer stop()
 
@@ -66,7 +82,8 @@ func main() {
 
 	waitGroup, ctx := errgroup.WithContext(ctx)
 	runTaskProcessor(ctx, waitGroup, config, redisOpt, store)
-	runGatewayServer(ctx, waitGroup, config, store, taskDistributor)
+	runTaskScheduler(ctx, waitGroup, config, redisOpt)
+	runGatewayServer(ctx, waitGroup, config)
 	runGrpcServer(ctx, waitGroup, err, config, store, taskDistributor)
 
 	err = waitGroup.Wait()
@@ -77,7 +94,7 @@ func main() {
 
 func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config util.Config, opt asynq.RedisClientOpt, store db.Store) {
 	mailer := mail.NewProtonSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword, config.CertificatePath, config.KeyPath)
-	taskProcessor := worker.NewRedisTaskProcessor(opt, store, mailer)
+	taskProcessor := worker.NewRedisTaskProcessor(opt, store, mailer, config.PublicBaseURL)
 
 	log.Info().Msg("starting task processor")
 	err := taskProcessor.Start()
@@ -97,14 +114,45 @@ func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config uti
 	})
 }
 
+func runTaskScheduler(ctx context.Context, waitGroup *errgroup.Group, config util.Config, opt asynq.RedisClientOpt) {
+	scheduler, err := worker.NewTaskScheduler(opt, config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create task scheduler")
+	}
+
+	log.Info().Msg("starting task scheduler")
+	err = scheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot start task scheduler")
+	}
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("stopping task scheduler")
+
+		scheduler.Shutdown()
+
+		log.Info().Msg("stopped task scheduler")
+
+		return nil
+	})
+}
+
 func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, err error, config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
 	server, err := api.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	grpcLogger := grpc.UnaryInterceptor(core.GrpcLogger)
-	grpcServer := grpc.NewServer(grpcLogger)
+	authInterceptor, err := core.NewAuthInterceptor(server.TokenMaker, server.Store, pb.File_service_simplebank_proto.Services())
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load auth policies")
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(core.GrpcLogger, authInterceptor.Unary()),
+		grpc.StreamInterceptor(authInterceptor.Stream()),
+	)
 
 	pb.RegisterSimplebankServer(grpcServer, server)
 
@@ -145,12 +193,7 @@ func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, err error, co
 	})
 }
 
-func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := api.NewServer(config, store, taskDistributor)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
-	}
-
+func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config util.Config) {
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			UseProtoNames: true,
@@ -162,13 +205,34 @@ func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config uti
 
 	grpcMux := runtime.NewServeMux(jsonOption)
 
-	err = pb.RegisterSimplebankHandlerServer(ctx, grpcMux, server)
+	// The gateway forwards to the gRPC server rather than calling the handlers directly,
+	// so that HTTP requests go through the same interceptors
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	err := pb.RegisterSimplebankHandlerFromEndpoint(ctx, grpcMux, config.GRPCServerAddress, dialOptions)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot register gateway server")
 	}
 
+	conn, err := grpc.NewClient(config.GRPCServerAddress, dialOptions...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot connect to gRPC server")
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
+	mux.Handle(clients.OAuthTokenPath, clients.OAuthTokenHandler(pb.NewSimplebankClient(conn)))
+
+	if config.TokenSigningKey != "" {
+		keyring, err := token.ParseKeyring(config.TokenSigningKey, config.TokenVerificationKeys)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot load token keyring")
+		}
+
+		mux.Handle(core.JWKSPath, core.JWKSHandler(keyring))
+	}
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -220,6 +284,7 @@ func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config uti
 			return err
 		}
 
+		conn.Close()
 		log.Info().Msg("stopped HTTP gateway server")
 
 		return nil
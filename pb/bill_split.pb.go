@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: payments/bill_split.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SplitMethod int32
+
+const (
+	SplitMethod_SPLIT_METHOD_UNSPECIFIED SplitMethod = 0
+	SplitMethod_SPLIT_METHOD_EVEN        SplitMethod = 1
+	SplitMethod_SPLIT_METHOD_SHARES      SplitMethod = 2
+	SplitMethod_SPLIT_METHOD_EXACT       SplitMethod = 3
+)
+
+// Enum value maps for SplitMethod.
+var (
+	SplitMethod_name = map[int32]string{
+		0: "SPLIT_METHOD_UNSPECIFIED",
+		1: "SPLIT_METHOD_EVEN",
+		2: "SPLIT_METHOD_SHARES",
+		3: "SPLIT_METHOD_EXACT",
+	}
+	SplitMethod_value = map[string]int32{
+		"SPLIT_METHOD_UNSPECIFIED": 0,
+		"SPLIT_METHOD_EVEN":        1,
+		"SPLIT_METHOD_SHARES":      2,
+		"SPLIT_METHOD_EXACT":       3,
+	}
+)
+
+func (x SplitMethod) Enum() *SplitMethod {
+	p := new(SplitMethod)
+	*p = x
+	return p
+}
+
+func (x SplitMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_payments_bill_split_proto_enumTypes[0].Descriptor()
+}
+
+func (SplitMethod) Type() protoreflect.EnumType {
+	return &file_payments_bill_split_proto_enumTypes[0]
+}
+
+func (x SplitMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitMethod.Descriptor instead.
+func (SplitMethod) EnumDescriptor() ([]byte, []int) {
+	return file_payments_bill_split_proto_rawDescGZIP(), []int{0}
+}
+
+type BillSplitParticipant struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Username         string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Amount           int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status           PaymentRequestStatus   `protobuf:"varint,3,opt,name=status,proto3,enum=pb.PaymentRequestStatus" json:"status,omitempty"`
+	PaymentRequestId *int64                 `protobuf:"varint,4,opt,name=payment_request_id,json=paymentRequestId,proto3,oneof" json:"payment_request_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BillSplitParticipant) Reset() {
+	*x = BillSplitParticipant{}
+	mi := &file_payments_bill_split_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillSplitParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillSplitParticipant) ProtoMessage() {}
+
+func (x *BillSplitParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_bill_split_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillSplitParticipant.ProtoReflect.Descriptor instead.
+func (*BillSplitParticipant) Descriptor() ([]byte, []int) {
+	return file_payments_bill_split_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BillSplitParticipant) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BillSplitParticipant) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BillSplitParticipant) GetStatus() PaymentRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentRequestStatus_PAYMENT_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *BillSplitParticipant) GetPaymentRequestId() int64 {
+	if x != nil && x.PaymentRequestId != nil {
+		return *x.PaymentRequestId
+	}
+	return 0
+}
+
+type BillSplit struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator       string                  `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount        int64                   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      Currency                `protobuf:"varint,4,opt,name=currency,proto3,enum=pb.Currency" json:"currency,omitempty"`
+	Description   *string                 `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SplitMethod   SplitMethod             `protobuf:"varint,6,opt,name=split_method,json=splitMethod,proto3,enum=pb.SplitMethod" json:"split_method,omitempty"`
+	Participants  []*BillSplitParticipant `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants,omitempty"`
+	Settled       bool                    `protobuf:"varint,8,opt,name=settled,proto3" json:"settled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RemindedAt    *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=reminded_at,json=remindedAt,proto3,oneof" json:"reminded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BillSplit) Reset() {
+	*x = BillSplit{}
+	mi := &file_payments_bill_split_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillSplit) ProtoMessage() {}
+
+func (x *BillSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_bill_split_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillSplit.ProtoReflect.Descriptor instead.
+func (*BillSplit) Descriptor() ([]byte, []int) {
+	return file_payments_bill_split_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BillSplit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BillSplit) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *BillSplit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BillSplit) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_USD
+}
+
+func (x *BillSplit) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *BillSplit) GetSplitMethod() SplitMethod {
+	if x != nil {
+		return x.SplitMethod
+	}
+	return SplitMethod_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *BillSplit) GetParticipants() []*BillSplitParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *BillSplit) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *BillSplit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BillSplit) GetRemindedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindedAt
+	}
+	return nil
+}
+
+var File_payments_bill_split_proto protoreflect.FileDescriptor
+
+var file_payments_bill_split_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xd8,
+	0x03, 0x0a, 0x09, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0f, 0x92, 0xb5, 0x18, 0x0b, 0x62, 0x69,
+	0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x0c, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0b, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x69, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2a, 0x73, 0x0a, 0x0b, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x50, 0x4c, 0x49,
+	0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x48,
+	0x41, 0x52, 0x45, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x03, 0x42, 0x0f,
+	0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payments_bill_split_proto_rawDescOnce sync.Once
+	file_payments_bill_split_proto_rawDescData = file_payments_bill_split_proto_rawDesc
+)
+
+func file_payments_bill_split_proto_rawDescGZIP() []byte {
+	file_payments_bill_split_proto_rawDescOnce.Do(func() {
+		file_payments_bill_split_proto_rawDescData = protoimpl.X.CompressGZIP(file_payments_bill_split_proto_rawDescData)
+	})
+	return file_payments_bill_split_proto_rawDescData
+}
+
+var file_payments_bill_split_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_payments_bill_split_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payments_bill_split_proto_goTypes = []any{
+	(SplitMethod)(0),              // 0: pb.SplitMethod
+	(*BillSplitParticipant)(nil),  // 1: pb.BillSplitParticipant
+	(*BillSplit)(nil),             // 2: pb.BillSplit
+	(PaymentRequestStatus)(0),     // 3: pb.PaymentRequestStatus
+	(Currency)(0),                 // 4: pb.Currency
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_payments_bill_split_proto_depIdxs = []int32{
+	3, // 0: pb.BillSplitParticipant.status:type_name -> pb.PaymentRequestStatus
+	4, // 1: pb.BillSplit.currency:type_name -> pb.Currency
+	0, // 2: pb.BillSplit.split_method:type_name -> pb.SplitMethod
+	1, // 3: pb.BillSplit.participants:type_name -> pb.BillSplitParticipant
+	5, // 4: pb.BillSplit.created_at:type_name -> google.protobuf.Timestamp
+	5, // 5: pb.BillSplit.reminded_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_payments_bill_split_proto_init() }
+func file_payments_bill_split_proto_init() {
+	if File_payments_bill_split_proto != nil {
+		return
+	}
+	file_accounts_account_proto_init()
+	file_payments_payment_request_proto_init()
+	file_audit_proto_init()
+	file_payments_bill_split_proto_msgTypes[0].OneofWrappers = []any{}
+	file_payments_bill_split_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_payments_bill_split_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_payments_bill_split_proto_goTypes,
+		DependencyIndexes: file_payments_bill_split_proto_depIdxs,
+		EnumInfos:         file_payments_bill_split_proto_enumTypes,
+		MessageInfos:      file_payments_bill_split_proto_msgTypes,
+	}.Build()
+	File_payments_bill_split_proto = out.File
+	file_payments_bill_split_proto_rawDesc = nil
+	file_payments_bill_split_proto_goTypes = nil
+	file_payments_bill_split_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: transfers/category.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpendingCategory int32
+
+const (
+	SpendingCategory_SPENDING_CATEGORY_UNSPECIFIED   SpendingCategory = 0
+	SpendingCategory_SPENDING_CATEGORY_GROCERIES     SpendingCategory = 1
+	SpendingCategory_SPENDING_CATEGORY_DINING        SpendingCategory = 2
+	SpendingCategory_SPENDING_CATEGORY_TRANSPORT     SpendingCategory = 3
+	SpendingCategory_SPENDING_CATEGORY_UTILITIES     SpendingCategory = 4
+	SpendingCategory_SPENDING_CATEGORY_HOUSING       SpendingCategory = 5
+	SpendingCategory_SPENDING_CATEGORY_ENTERTAINMENT SpendingCategory = 6
+	SpendingCategory_SPENDING_CATEGORY_SHOPPING      SpendingCategory = 7
+	SpendingCategory_SPENDING_CATEGORY_HEALTH        SpendingCategory = 8
+	SpendingCategory_SPENDING_CATEGORY_OTHER         SpendingCategory = 9
+)
+
+// Enum value maps for SpendingCategory.
+var (
+	SpendingCategory_name = map[int32]string{
+		0: "SPENDING_CATEGORY_UNSPECIFIED",
+		1: "SPENDING_CATEGORY_GROCERIES",
+		2: "SPENDING_CATEGORY_DINING",
+		3: "SPENDING_CATEGORY_TRANSPORT",
+		4: "SPENDING_CATEGORY_UTILITIES",
+		5: "SPENDING_CATEGORY_HOUSING",
+		6: "SPENDING_CATEGORY_ENTERTAINMENT",
+		7: "SPENDING_CATEGORY_SHOPPING",
+		8: "SPENDING_CATEGORY_HEALTH",
+		9: "SPENDING_CATEGORY_OTHER",
+	}
+	SpendingCategory_value = map[string]int32{
+		"SPENDING_CATEGORY_UNSPECIFIED":   0,
+		"SPENDING_CATEGORY_GROCERIES":     1,
+		"SPENDING_CATEGORY_DINING":        2,
+		"SPENDING_CATEGORY_TRANSPORT":     3,
+		"SPENDING_CATEGORY_UTILITIES":     4,
+		"SPENDING_CATEGORY_HOUSING":       5,
+		"SPENDING_CATEGORY_ENTERTAINMENT": 6,
+		"SPENDING_CATEGORY_SHOPPING":      7,
+		"SPENDING_CATEGORY_HEALTH":        8,
+		"SPENDING_CATEGORY_OTHER":         9,
+	}
+)
+
+func (x SpendingCategory) Enum() *SpendingCategory {
+	p := new(SpendingCategory)
+	*p = x
+	return p
+}
+
+func (x SpendingCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpendingCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_transfers_category_proto_enumTypes[0].Descriptor()
+}
+
+func (SpendingCategory) Type() protoreflect.EnumType {
+	return &file_transfers_category_proto_enumTypes[0]
+}
+
+func (x SpendingCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpendingCategory.Descriptor instead.
+func (SpendingCategory) EnumDescriptor() ([]byte, []int) {
+	return file_transfers_category_proto_rawDescGZIP(), []int{0}
+}
+
+type CategoryRule struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keyword               *string                `protobuf:"bytes,2,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	CounterpartyAccountId *int64                 `protobuf:"varint,3,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3,oneof" json:"counterparty_account_id,omitempty"`
+	Category              SpendingCategory       `protobuf:"varint,4,opt,name=category,proto3,enum=pb.SpendingCategory" json:"category,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CategoryRule) Reset() {
+	*x = CategoryRule{}
+	mi := &file_transfers_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRule) ProtoMessage() {}
+
+func (x *CategoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRule.ProtoReflect.Descriptor instead.
+func (*CategoryRule) Descriptor() ([]byte, []int) {
+	return file_transfers_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CategoryRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *CategoryRule) GetCounterpartyAccountId() int64 {
+	if x != nil && x.CounterpartyAccountId != nil {
+		return *x.CounterpartyAccountId
+	}
+	return 0
+}
+
+func (x *CategoryRule) GetCategory() SpendingCategory {
+	if x != nil {
+		return x.Category
+	}
+	return SpendingCategory_SPENDING_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_transfers_category_proto protoreflect.FileDescriptor
+
+var file_transfers_category_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x8f, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x17, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x01, 0x52, 0x15, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x2a, 0xd5, 0x02, 0x0a, 0x10, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x47,
+	0x52, 0x4f, 0x43, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x44, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55,
+	0x54, 0x49, 0x4c, 0x49, 0x54, 0x49, 0x45, 0x53, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x48, 0x4f, 0x55, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x4e, 0x54, 0x45, 0x52, 0x54, 0x41, 0x49, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x1e,
+	0x0a, 0x1a, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x53, 0x48, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x09, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_transfers_category_proto_rawDescOnce sync.Once
+	file_transfers_category_proto_rawDescData = file_transfers_category_proto_rawDesc
+)
+
+func file_transfers_category_proto_rawDescGZIP() []byte {
+	file_transfers_category_proto_rawDescOnce.Do(func() {
+		file_transfers_category_proto_rawDescData = protoimpl.X.CompressGZIP(file_transfers_category_proto_rawDescData)
+	})
+	return file_transfers_category_proto_rawDescData
+}
+
+var file_transfers_category_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_transfers_category_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfers_category_proto_goTypes = []any{
+	(SpendingCategory)(0),         // 0: pb.SpendingCategory
+	(*CategoryRule)(nil),          // 1: pb.CategoryRule
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_transfers_category_proto_depIdxs = []int32{
+	0, // 0: pb.CategoryRule.category:type_name -> pb.SpendingCategory
+	2, // 1: pb.CategoryRule.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_transfers_category_proto_init() }
+func file_transfers_category_proto_init() {
+	if File_transfers_category_proto != nil {
+		return
+	}
+	file_transfers_category_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_transfers_category_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_transfers_category_proto_goTypes,
+		DependencyIndexes: file_transfers_category_proto_depIdxs,
+		EnumInfos:         file_transfers_category_proto_enumTypes,
+		MessageInfos:      file_transfers_category_proto_msgTypes,
+	}.Build()
+	File_transfers_category_proto = out.File
+	file_transfers_category_proto_rawDesc = nil
+	file_transfers_category_proto_goTypes = nil
+	file_transfers_category_proto_depIdxs = nil
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: debits/direct_debit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DirectDebitStatus int32
+
+const (
+	DirectDebitStatus_DIRECT_DEBIT_STATUS_UNSPECIFIED  DirectDebitStatus = 0
+	DirectDebitStatus_DIRECT_DEBIT_STATUS_COLLECTED    DirectDebitStatus = 1
+	DirectDebitStatus_DIRECT_DEBIT_STATUS_CHARGED_BACK DirectDebitStatus = 2
+)
+
+// Enum value maps for DirectDebitStatus.
+var (
+	DirectDebitStatus_name = map[int32]string{
+		0: "DIRECT_DEBIT_STATUS_UNSPECIFIED",
+		1: "DIRECT_DEBIT_STATUS_COLLECTED",
+		2: "DIRECT_DEBIT_STATUS_CHARGED_BACK",
+	}
+	DirectDebitStatus_value = map[string]int32{
+		"DIRECT_DEBIT_STATUS_UNSPECIFIED":  0,
+		"DIRECT_DEBIT_STATUS_COLLECTED":    1,
+		"DIRECT_DEBIT_STATUS_CHARGED_BACK": 2,
+	}
+)
+
+func (x DirectDebitStatus) Enum() *DirectDebitStatus {
+	p := new(DirectDebitStatus)
+	*p = x
+	return p
+}
+
+func (x DirectDebitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DirectDebitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_debits_direct_debit_proto_enumTypes[0].Descriptor()
+}
+
+func (DirectDebitStatus) Type() protoreflect.EnumType {
+	return &file_debits_direct_debit_proto_enumTypes[0]
+}
+
+func (x DirectDebitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DirectDebitStatus.Descriptor instead.
+func (DirectDebitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_debits_direct_debit_proto_rawDescGZIP(), []int{0}
+}
+
+type DirectDebit struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MandateId            int64                  `protobuf:"varint,2,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
+	TransferId           int64                  `protobuf:"varint,3,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount               int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               DirectDebitStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=pb.DirectDebitStatus" json:"status,omitempty"`
+	ChargebackTransferId *int64                 `protobuf:"varint,6,opt,name=chargeback_transfer_id,json=chargebackTransferId,proto3,oneof" json:"chargeback_transfer_id,omitempty"`
+	CollectedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	ChargedBackAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=charged_back_at,json=chargedBackAt,proto3,oneof" json:"charged_back_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DirectDebit) Reset() {
+	*x = DirectDebit{}
+	mi := &file_debits_direct_debit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectDebit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectDebit) ProtoMessage() {}
+
+func (x *DirectDebit) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_direct_debit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectDebit.ProtoReflect.Descriptor instead.
+func (*DirectDebit) Descriptor() ([]byte, []int) {
+	return file_debits_direct_debit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DirectDebit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DirectDebit) GetMandateId() int64 {
+	if x != nil {
+		return x.MandateId
+	}
+	return 0
+}
+
+func (x *DirectDebit) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *DirectDebit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DirectDebit) GetStatus() DirectDebitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DirectDebitStatus_DIRECT_DEBIT_STATUS_UNSPECIFIED
+}
+
+func (x *DirectDebit) GetChargebackTransferId() int64 {
+	if x != nil && x.ChargebackTransferId != nil {
+		return *x.ChargebackTransferId
+	}
+	return 0
+}
+
+func (x *DirectDebit) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *DirectDebit) GetChargedBackAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChargedBackAt
+	}
+	return nil
+}
+
+var File_debits_direct_debit_proto protoreflect.FileDescriptor
+
+var file_debits_direct_debit_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x03,
+	0x0a, 0x0b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x21, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x0a, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x92, 0xb5, 0x18, 0x08, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0d, 0x92, 0xb5, 0x18, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0x92, 0xb5, 0x18, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x14, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x62, 0x61, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x42, 0x61, 0x63,
+	0x6b, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x61, 0x74, 0x2a, 0x81, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x44,
+	0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45,
+	0x42, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47,
+	0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_debits_direct_debit_proto_rawDescOnce sync.Once
+	file_debits_direct_debit_proto_rawDescData = file_debits_direct_debit_proto_rawDesc
+)
+
+func file_debits_direct_debit_proto_rawDescGZIP() []byte {
+	file_debits_direct_debit_proto_rawDescOnce.Do(func() {
+		file_debits_direct_debit_proto_rawDescData = protoimpl.X.CompressGZIP(file_debits_direct_debit_proto_rawDescData)
+	})
+	return file_debits_direct_debit_proto_rawDescData
+}
+
+var file_debits_direct_debit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_debits_direct_debit_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_debits_direct_debit_proto_goTypes = []any{
+	(DirectDebitStatus)(0),        // 0: pb.DirectDebitStatus
+	(*DirectDebit)(nil),           // 1: pb.DirectDebit
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_debits_direct_debit_proto_depIdxs = []int32{
+	0, // 0: pb.DirectDebit.status:type_name -> pb.DirectDebitStatus
+	2, // 1: pb.DirectDebit.collected_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.DirectDebit.charged_back_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_debits_direct_debit_proto_init() }
+func file_debits_direct_debit_proto_init() {
+	if File_debits_direct_debit_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_debits_direct_debit_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debits_direct_debit_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debits_direct_debit_proto_goTypes,
+		DependencyIndexes: file_debits_direct_debit_proto_depIdxs,
+		EnumInfos:         file_debits_direct_debit_proto_enumTypes,
+		MessageInfos:      file_debits_direct_debit_proto_msgTypes,
+	}.Build()
+	File_debits_direct_debit_proto = out.File
+	file_debits_direct_debit_proto_rawDesc = nil
+	file_debits_direct_debit_proto_goTypes = nil
+	file_debits_direct_debit_proto_depIdxs = nil
+}
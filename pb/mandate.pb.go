@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: debits/mandate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MandateFrequency int32
+
+const (
+	MandateFrequency_MANDATE_FREQUENCY_UNSPECIFIED MandateFrequency = 0
+	MandateFrequency_MANDATE_FREQUENCY_DAILY       MandateFrequency = 1
+	MandateFrequency_MANDATE_FREQUENCY_WEEKLY      MandateFrequency = 2
+	MandateFrequency_MANDATE_FREQUENCY_MONTHLY     MandateFrequency = 3
+	MandateFrequency_MANDATE_FREQUENCY_YEARLY      MandateFrequency = 4
+)
+
+// Enum value maps for MandateFrequency.
+var (
+	MandateFrequency_name = map[int32]string{
+		0: "MANDATE_FREQUENCY_UNSPECIFIED",
+		1: "MANDATE_FREQUENCY_DAILY",
+		2: "MANDATE_FREQUENCY_WEEKLY",
+		3: "MANDATE_FREQUENCY_MONTHLY",
+		4: "MANDATE_FREQUENCY_YEARLY",
+	}
+	MandateFrequency_value = map[string]int32{
+		"MANDATE_FREQUENCY_UNSPECIFIED": 0,
+		"MANDATE_FREQUENCY_DAILY":       1,
+		"MANDATE_FREQUENCY_WEEKLY":      2,
+		"MANDATE_FREQUENCY_MONTHLY":     3,
+		"MANDATE_FREQUENCY_YEARLY":      4,
+	}
+)
+
+func (x MandateFrequency) Enum() *MandateFrequency {
+	p := new(MandateFrequency)
+	*p = x
+	return p
+}
+
+func (x MandateFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MandateFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_debits_mandate_proto_enumTypes[0].Descriptor()
+}
+
+func (MandateFrequency) Type() protoreflect.EnumType {
+	return &file_debits_mandate_proto_enumTypes[0]
+}
+
+func (x MandateFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MandateFrequency.Descriptor instead.
+func (MandateFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_debits_mandate_proto_rawDescGZIP(), []int{0}
+}
+
+type MandateStatus int32
+
+const (
+	MandateStatus_MANDATE_STATUS_UNSPECIFIED MandateStatus = 0
+	MandateStatus_MANDATE_STATUS_ACTIVE      MandateStatus = 1
+	MandateStatus_MANDATE_STATUS_REVOKED     MandateStatus = 2
+)
+
+// Enum value maps for MandateStatus.
+var (
+	MandateStatus_name = map[int32]string{
+		0: "MANDATE_STATUS_UNSPECIFIED",
+		1: "MANDATE_STATUS_ACTIVE",
+		2: "MANDATE_STATUS_REVOKED",
+	}
+	MandateStatus_value = map[string]int32{
+		"MANDATE_STATUS_UNSPECIFIED": 0,
+		"MANDATE_STATUS_ACTIVE":      1,
+		"MANDATE_STATUS_REVOKED":     2,
+	}
+)
+
+func (x MandateStatus) Enum() *MandateStatus {
+	p := new(MandateStatus)
+	*p = x
+	return p
+}
+
+func (x MandateStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MandateStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_debits_mandate_proto_enumTypes[1].Descriptor()
+}
+
+func (MandateStatus) Type() protoreflect.EnumType {
+	return &file_debits_mandate_proto_enumTypes[1]
+}
+
+func (x MandateStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MandateStatus.Descriptor instead.
+func (MandateStatus) EnumDescriptor() ([]byte, []int) {
+	return file_debits_mandate_proto_rawDescGZIP(), []int{1}
+}
+
+type Mandate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Debtor            string                 `protobuf:"bytes,2,opt,name=debtor,proto3" json:"debtor,omitempty"`
+	DebtorAccountId   int64                  `protobuf:"varint,3,opt,name=debtor_account_id,json=debtorAccountId,proto3" json:"debtor_account_id,omitempty"`
+	Creditor          string                 `protobuf:"bytes,4,opt,name=creditor,proto3" json:"creditor,omitempty"`
+	CreditorAccountId int64                  `protobuf:"varint,5,opt,name=creditor_account_id,json=creditorAccountId,proto3" json:"creditor_account_id,omitempty"`
+	MaxAmount         int64                  `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	Currency          Currency               `protobuf:"varint,7,opt,name=currency,proto3,enum=pb.Currency" json:"currency,omitempty"`
+	Frequency         MandateFrequency       `protobuf:"varint,8,opt,name=frequency,proto3,enum=pb.MandateFrequency" json:"frequency,omitempty"`
+	Reference         *string                `protobuf:"bytes,9,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	Status            MandateStatus          `protobuf:"varint,10,opt,name=status,proto3,enum=pb.MandateStatus" json:"status,omitempty"`
+	LastCollectedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_collected_at,json=lastCollectedAt,proto3,oneof" json:"last_collected_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Mandate) Reset() {
+	*x = Mandate{}
+	mi := &file_debits_mandate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mandate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mandate) ProtoMessage() {}
+
+func (x *Mandate) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_mandate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mandate.ProtoReflect.Descriptor instead.
+func (*Mandate) Descriptor() ([]byte, []int) {
+	return file_debits_mandate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Mandate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Mandate) GetDebtor() string {
+	if x != nil {
+		return x.Debtor
+	}
+	return ""
+}
+
+func (x *Mandate) GetDebtorAccountId() int64 {
+	if x != nil {
+		return x.DebtorAccountId
+	}
+	return 0
+}
+
+func (x *Mandate) GetCreditor() string {
+	if x != nil {
+		return x.Creditor
+	}
+	return ""
+}
+
+func (x *Mandate) GetCreditorAccountId() int64 {
+	if x != nil {
+		return x.CreditorAccountId
+	}
+	return 0
+}
+
+func (x *Mandate) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *Mandate) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_USD
+}
+
+func (x *Mandate) GetFrequency() MandateFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return MandateFrequency_MANDATE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Mandate) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
+func (x *Mandate) GetStatus() MandateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MandateStatus_MANDATE_STATUS_UNSPECIFIED
+}
+
+func (x *Mandate) GetLastCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCollectedAt
+	}
+	return nil
+}
+
+func (x *Mandate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Mandate) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+var File_debits_mandate_proto protoreflect.FileDescriptor
+
+var file_debits_mandate_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2f, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x99, 0x05, 0x0a, 0x07, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x92, 0xb5, 0x18, 0x08, 0x6d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x62, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x62, 0x74,
+	0x6f, 0x72, 0x12, 0x38, 0x0a, 0x11, 0x64, 0x65, 0x62, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x92,
+	0xb5, 0x18, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x0f, 0x64, 0x65, 0x62,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x92, 0xb5, 0x18, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x32, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x4b, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2a, 0xad, 0x01, 0x0a,
+	0x10, 0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x12,
+	0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x04, 0x2a, 0x66, 0x0a, 0x0d,
+	0x4d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
+	0x1a, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x41, 0x4e, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x4e, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61,
+	0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debits_mandate_proto_rawDescOnce sync.Once
+	file_debits_mandate_proto_rawDescData = file_debits_mandate_proto_rawDesc
+)
+
+func file_debits_mandate_proto_rawDescGZIP() []byte {
+	file_debits_mandate_proto_rawDescOnce.Do(func() {
+		file_debits_mandate_proto_rawDescData = protoimpl.X.CompressGZIP(file_debits_mandate_proto_rawDescData)
+	})
+	return file_debits_mandate_proto_rawDescData
+}
+
+var file_debits_mandate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_debits_mandate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_debits_mandate_proto_goTypes = []any{
+	(MandateFrequency)(0),         // 0: pb.MandateFrequency
+	(MandateStatus)(0),            // 1: pb.MandateStatus
+	(*Mandate)(nil),               // 2: pb.Mandate
+	(Currency)(0),                 // 3: pb.Currency
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_debits_mandate_proto_depIdxs = []int32{
+	3, // 0: pb.Mandate.currency:type_name -> pb.Currency
+	0, // 1: pb.Mandate.frequency:type_name -> pb.MandateFrequency
+	1, // 2: pb.Mandate.status:type_name -> pb.MandateStatus
+	4, // 3: pb.Mandate.last_collected_at:type_name -> google.protobuf.Timestamp
+	4, // 4: pb.Mandate.created_at:type_name -> google.protobuf.Timestamp
+	4, // 5: pb.Mandate.revoked_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_debits_mandate_proto_init() }
+func file_debits_mandate_proto_init() {
+	if File_debits_mandate_proto != nil {
+		return
+	}
+	file_accounts_account_proto_init()
+	file_audit_proto_init()
+	file_debits_mandate_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debits_mandate_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debits_mandate_proto_goTypes,
+		DependencyIndexes: file_debits_mandate_proto_depIdxs,
+		EnumInfos:         file_debits_mandate_proto_enumTypes,
+		MessageInfos:      file_debits_mandate_proto_msgTypes,
+	}.Build()
+	File_debits_mandate_proto = out.File
+	file_debits_mandate_proto_rawDesc = nil
+	file_debits_mandate_proto_goTypes = nil
+	file_debits_mandate_proto_depIdxs = nil
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: debits/rpc_collect_direct_debit.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CollectDirectDebitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MandateId     int64                  `protobuf:"varint,1,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MfaCode       *string                `protobuf:"bytes,3,opt,name=mfa_code,json=mfaCode,proto3,oneof" json:"mfa_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectDirectDebitRequest) Reset() {
+	*x = CollectDirectDebitRequest{}
+	mi := &file_debits_rpc_collect_direct_debit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectDirectDebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectDirectDebitRequest) ProtoMessage() {}
+
+func (x *CollectDirectDebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_rpc_collect_direct_debit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectDirectDebitRequest.ProtoReflect.Descriptor instead.
+func (*CollectDirectDebitRequest) Descriptor() ([]byte, []int) {
+	return file_debits_rpc_collect_direct_debit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CollectDirectDebitRequest) GetMandateId() int64 {
+	if x != nil {
+		return x.MandateId
+	}
+	return 0
+}
+
+func (x *CollectDirectDebitRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CollectDirectDebitRequest) GetMfaCode() string {
+	if x != nil && x.MfaCode != nil {
+		return *x.MfaCode
+	}
+	return ""
+}
+
+type CollectDirectDebitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DirectDebit   *DirectDebit           `protobuf:"bytes,1,opt,name=direct_debit,json=directDebit,proto3" json:"direct_debit,omitempty"`
+	Transfer      *Transfer              `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectDirectDebitResponse) Reset() {
+	*x = CollectDirectDebitResponse{}
+	mi := &file_debits_rpc_collect_direct_debit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectDirectDebitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectDirectDebitResponse) ProtoMessage() {}
+
+func (x *CollectDirectDebitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_rpc_collect_direct_debit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectDirectDebitResponse.ProtoReflect.Descriptor instead.
+func (*CollectDirectDebitResponse) Descriptor() ([]byte, []int) {
+	return file_debits_rpc_collect_direct_debit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectDirectDebitResponse) GetDirectDebit() *DirectDebit {
+	if x != nil {
+		return x.DirectDebit
+	}
+	return nil
+}
+
+func (x *CollectDirectDebitResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_debits_rpc_collect_direct_debit_proto protoreflect.FileDescriptor
+
+var file_debits_rpc_collect_direct_debit_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x73, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5,
+	0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0a,
+	0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0f, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x08, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x72, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x5a, 0x92, 0x41,
+	0x54, 0x32, 0x49, 0x54, 0x68, 0x65, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x2c, 0x20, 0x61, 0x74, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x69, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0xf0, 0x3f, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x86, 0x01, 0x0a, 0x08, 0x6d, 0x66, 0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x66, 0x92, 0x41, 0x5c, 0x32, 0x5a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x20, 0x74, 0x77, 0x6f, 0x2d, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x64,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x65, 0x70, 0x2d, 0x75, 0x70, 0x20, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0xe0, 0x41, 0x01, 0x98, 0xb5, 0x18, 0x01, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x66, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x66,
+	0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x1a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debits_rpc_collect_direct_debit_proto_rawDescOnce sync.Once
+	file_debits_rpc_collect_direct_debit_proto_rawDescData = file_debits_rpc_collect_direct_debit_proto_rawDesc
+)
+
+func file_debits_rpc_collect_direct_debit_proto_rawDescGZIP() []byte {
+	file_debits_rpc_collect_direct_debit_proto_rawDescOnce.Do(func() {
+		file_debits_rpc_collect_direct_debit_proto_rawDescData = protoimpl.X.CompressGZIP(file_debits_rpc_collect_direct_debit_proto_rawDescData)
+	})
+	return file_debits_rpc_collect_direct_debit_proto_rawDescData
+}
+
+var file_debits_rpc_collect_direct_debit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_debits_rpc_collect_direct_debit_proto_goTypes = []any{
+	(*CollectDirectDebitRequest)(nil),  // 0: pb.CollectDirectDebitRequest
+	(*CollectDirectDebitResponse)(nil), // 1: pb.CollectDirectDebitResponse
+	(*DirectDebit)(nil),                // 2: pb.DirectDebit
+	(*Transfer)(nil),                   // 3: pb.Transfer
+}
+var file_debits_rpc_collect_direct_debit_proto_depIdxs = []int32{
+	2, // 0: pb.CollectDirectDebitResponse.direct_debit:type_name -> pb.DirectDebit
+	3, // 1: pb.CollectDirectDebitResponse.transfer:type_name -> pb.Transfer
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_debits_rpc_collect_direct_debit_proto_init() }
+func file_debits_rpc_collect_direct_debit_proto_init() {
+	if File_debits_rpc_collect_direct_debit_proto != nil {
+		return
+	}
+	file_debits_direct_debit_proto_init()
+	file_transfers_transfer_proto_init()
+	file_audit_proto_init()
+	file_debits_rpc_collect_direct_debit_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debits_rpc_collect_direct_debit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debits_rpc_collect_direct_debit_proto_goTypes,
+		DependencyIndexes: file_debits_rpc_collect_direct_debit_proto_depIdxs,
+		MessageInfos:      file_debits_rpc_collect_direct_debit_proto_msgTypes,
+	}.Build()
+	File_debits_rpc_collect_direct_debit_proto = out.File
+	file_debits_rpc_collect_direct_debit_proto_rawDesc = nil
+	file_debits_rpc_collect_direct_debit_proto_goTypes = nil
+	file_debits_rpc_collect_direct_debit_proto_depIdxs = nil
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: aml/rpc_get_aml_report.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAmlReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmlReportRequest) Reset() {
+	*x = GetAmlReportRequest{}
+	mi := &file_aml_rpc_get_aml_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmlReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmlReportRequest) ProtoMessage() {}
+
+func (x *GetAmlReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aml_rpc_get_aml_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmlReportRequest.ProtoReflect.Descriptor instead.
+func (*GetAmlReportRequest) Descriptor() ([]byte, []int) {
+	return file_aml_rpc_get_aml_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAmlReportRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAmlReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *AmlReport             `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Transfers     []*AmlTransfer         `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmlReportResponse) Reset() {
+	*x = GetAmlReportResponse{}
+	mi := &file_aml_rpc_get_aml_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmlReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmlReportResponse) ProtoMessage() {}
+
+func (x *GetAmlReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aml_rpc_get_aml_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmlReportResponse.ProtoReflect.Descriptor instead.
+func (*GetAmlReportResponse) Descriptor() ([]byte, []int) {
+	return file_aml_rpc_get_aml_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAmlReportResponse) GetReport() *AmlReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *GetAmlReportResponse) GetTransfers() []*AmlTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+var File_aml_rpc_get_aml_report_proto protoreflect.FileDescriptor
+
+var file_aml_rpc_get_aml_report_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x6d, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d,
+	0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x61, 0x6d, 0x6c, 0x2f, 0x61, 0x6d, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6c,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xe0, 0x41, 0x02, 0x92, 0xb5,
+	0x18, 0x0b, 0x61, 0x6d, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x6c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x6d, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x2d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6d, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42,
+	0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aml_rpc_get_aml_report_proto_rawDescOnce sync.Once
+	file_aml_rpc_get_aml_report_proto_rawDescData = file_aml_rpc_get_aml_report_proto_rawDesc
+)
+
+func file_aml_rpc_get_aml_report_proto_rawDescGZIP() []byte {
+	file_aml_rpc_get_aml_report_proto_rawDescOnce.Do(func() {
+		file_aml_rpc_get_aml_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_aml_rpc_get_aml_report_proto_rawDescData)
+	})
+	return file_aml_rpc_get_aml_report_proto_rawDescData
+}
+
+var file_aml_rpc_get_aml_report_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_aml_rpc_get_aml_report_proto_goTypes = []any{
+	(*GetAmlReportRequest)(nil),  // 0: pb.GetAmlReportRequest
+	(*GetAmlReportResponse)(nil), // 1: pb.GetAmlReportResponse
+	(*AmlReport)(nil),            // 2: pb.AmlReport
+	(*AmlTransfer)(nil),          // 3: pb.AmlTransfer
+}
+var file_aml_rpc_get_aml_report_proto_depIdxs = []int32{
+	2, // 0: pb.GetAmlReportResponse.report:type_name -> pb.AmlReport
+	3, // 1: pb.GetAmlReportResponse.transfers:type_name -> pb.AmlTransfer
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_aml_rpc_get_aml_report_proto_init() }
+func file_aml_rpc_get_aml_report_proto_init() {
+	if File_aml_rpc_get_aml_report_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_aml_aml_report_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aml_rpc_get_aml_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_aml_rpc_get_aml_report_proto_goTypes,
+		DependencyIndexes: file_aml_rpc_get_aml_report_proto_depIdxs,
+		MessageInfos:      file_aml_rpc_get_aml_report_proto_msgTypes,
+	}.Build()
+	File_aml_rpc_get_aml_report_proto = out.File
+	file_aml_rpc_get_aml_report_proto_rawDesc = nil
+	file_aml_rpc_get_aml_report_proto_goTypes = nil
+	file_aml_rpc_get_aml_report_proto_depIdxs = nil
+}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: debits/rpc_list_direct_debits.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDirectDebitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MandateId     int64                  `protobuf:"varint,1,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectDebitsRequest) Reset() {
+	*x = ListDirectDebitsRequest{}
+	mi := &file_debits_rpc_list_direct_debits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectDebitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectDebitsRequest) ProtoMessage() {}
+
+func (x *ListDirectDebitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_rpc_list_direct_debits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectDebitsRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectDebitsRequest) Descriptor() ([]byte, []int) {
+	return file_debits_rpc_list_direct_debits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDirectDebitsRequest) GetMandateId() int64 {
+	if x != nil {
+		return x.MandateId
+	}
+	return 0
+}
+
+func (x *ListDirectDebitsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListDirectDebitsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDirectDebitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*DirectDebit         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectDebitsResponse) Reset() {
+	*x = ListDirectDebitsResponse{}
+	mi := &file_debits_rpc_list_direct_debits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectDebitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectDebitsResponse) ProtoMessage() {}
+
+func (x *ListDirectDebitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_rpc_list_direct_debits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectDebitsResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectDebitsResponse) Descriptor() ([]byte, []int) {
+	return file_debits_rpc_list_direct_debits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDirectDebitsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListDirectDebitsResponse) GetData() []*DirectDebit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_debits_rpc_list_direct_debits_proto protoreflect.FileDescriptor
+
+var file_debits_rpc_list_direct_debits_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x73, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x0a, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0f, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x08, 0x6d, 0x61, 0x6e, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6f, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x62, 0x69, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0f,
+	0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debits_rpc_list_direct_debits_proto_rawDescOnce sync.Once
+	file_debits_rpc_list_direct_debits_proto_rawDescData = file_debits_rpc_list_direct_debits_proto_rawDesc
+)
+
+func file_debits_rpc_list_direct_debits_proto_rawDescGZIP() []byte {
+	file_debits_rpc_list_direct_debits_proto_rawDescOnce.Do(func() {
+		file_debits_rpc_list_direct_debits_proto_rawDescData = protoimpl.X.CompressGZIP(file_debits_rpc_list_direct_debits_proto_rawDescData)
+	})
+	return file_debits_rpc_list_direct_debits_proto_rawDescData
+}
+
+var file_debits_rpc_list_direct_debits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_debits_rpc_list_direct_debits_proto_goTypes = []any{
+	(*ListDirectDebitsRequest)(nil),  // 0: pb.ListDirectDebitsRequest
+	(*ListDirectDebitsResponse)(nil), // 1: pb.ListDirectDebitsResponse
+	(*Pagination)(nil),               // 2: pb.Pagination
+	(*DirectDebit)(nil),              // 3: pb.DirectDebit
+}
+var file_debits_rpc_list_direct_debits_proto_depIdxs = []int32{
+	2, // 0: pb.ListDirectDebitsResponse.pagination:type_name -> pb.Pagination
+	3, // 1: pb.ListDirectDebitsResponse.data:type_name -> pb.DirectDebit
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_debits_rpc_list_direct_debits_proto_init() }
+func file_debits_rpc_list_direct_debits_proto_init() {
+	if File_debits_rpc_list_direct_debits_proto != nil {
+		return
+	}
+	file_pagination_proto_init()
+	file_debits_direct_debit_proto_init()
+	file_audit_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debits_rpc_list_direct_debits_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debits_rpc_list_direct_debits_proto_goTypes,
+		DependencyIndexes: file_debits_rpc_list_direct_debits_proto_depIdxs,
+		MessageInfos:      file_debits_rpc_list_direct_debits_proto_msgTypes,
+	}.Build()
+	File_debits_rpc_list_direct_debits_proto = out.File
+	file_debits_rpc_list_direct_debits_proto_rawDesc = nil
+	file_debits_rpc_list_direct_debits_proto_goTypes = nil
+	file_debits_rpc_list_direct_debits_proto_depIdxs = nil
+}
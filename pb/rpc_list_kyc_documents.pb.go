@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: kyc/rpc_list_kyc_documents.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListKycDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycDocumentsRequest) Reset() {
+	*x = ListKycDocumentsRequest{}
+	mi := &file_kyc_rpc_list_kyc_documents_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycDocumentsRequest) ProtoMessage() {}
+
+func (x *ListKycDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_list_kyc_documents_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListKycDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListKycDocumentsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListKycDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*KycDocument         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycDocumentsResponse) Reset() {
+	*x = ListKycDocumentsResponse{}
+	mi := &file_kyc_rpc_list_kyc_documents_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycDocumentsResponse) ProtoMessage() {}
+
+func (x *ListKycDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_list_kyc_documents_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListKycDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListKycDocumentsResponse) GetData() []*KycDocument {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_kyc_rpc_list_kyc_documents_proto protoreflect.FileDescriptor
+
+var file_kyc_rpc_list_kyc_documents_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6b, 0x79, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6b,
+	0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x79, 0x63, 0x2f, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0x92, 0xb5,
+	0x18, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x3f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_rpc_list_kyc_documents_proto_rawDescOnce sync.Once
+	file_kyc_rpc_list_kyc_documents_proto_rawDescData = file_kyc_rpc_list_kyc_documents_proto_rawDesc
+)
+
+func file_kyc_rpc_list_kyc_documents_proto_rawDescGZIP() []byte {
+	file_kyc_rpc_list_kyc_documents_proto_rawDescOnce.Do(func() {
+		file_kyc_rpc_list_kyc_documents_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_rpc_list_kyc_documents_proto_rawDescData)
+	})
+	return file_kyc_rpc_list_kyc_documents_proto_rawDescData
+}
+
+var file_kyc_rpc_list_kyc_documents_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kyc_rpc_list_kyc_documents_proto_goTypes = []any{
+	(*ListKycDocumentsRequest)(nil),  // 0: pb.ListKycDocumentsRequest
+	(*ListKycDocumentsResponse)(nil), // 1: pb.ListKycDocumentsResponse
+	(*KycDocument)(nil),              // 2: pb.KycDocument
+}
+var file_kyc_rpc_list_kyc_documents_proto_depIdxs = []int32{
+	2, // 0: pb.ListKycDocumentsResponse.data:type_name -> pb.KycDocument
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_kyc_rpc_list_kyc_documents_proto_init() }
+func file_kyc_rpc_list_kyc_documents_proto_init() {
+	if File_kyc_rpc_list_kyc_documents_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_kyc_kyc_document_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_rpc_list_kyc_documents_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kyc_rpc_list_kyc_documents_proto_goTypes,
+		DependencyIndexes: file_kyc_rpc_list_kyc_documents_proto_depIdxs,
+		MessageInfos:      file_kyc_rpc_list_kyc_documents_proto_msgTypes,
+	}.Build()
+	File_kyc_rpc_list_kyc_documents_proto = out.File
+	file_kyc_rpc_list_kyc_documents_proto_rawDesc = nil
+	file_kyc_rpc_list_kyc_documents_proto_goTypes = nil
+	file_kyc_rpc_list_kyc_documents_proto_depIdxs = nil
+}
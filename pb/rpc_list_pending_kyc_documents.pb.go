@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: kyc/rpc_list_pending_kyc_documents.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPendingKycDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingKycDocumentsRequest) Reset() {
+	*x = ListPendingKycDocumentsRequest{}
+	mi := &file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingKycDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingKycDocumentsRequest) ProtoMessage() {}
+
+func (x *ListPendingKycDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingKycDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingKycDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_list_pending_kyc_documents_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPendingKycDocumentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPendingKycDocumentsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPendingKycDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*KycDocument         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingKycDocumentsResponse) Reset() {
+	*x = ListPendingKycDocumentsResponse{}
+	mi := &file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingKycDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingKycDocumentsResponse) ProtoMessage() {}
+
+func (x *ListPendingKycDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingKycDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingKycDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_list_pending_kyc_documents_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPendingKycDocumentsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListPendingKycDocumentsResponse) GetData() []*KycDocument {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_kyc_rpc_list_pending_kyc_documents_proto protoreflect.FileDescriptor
+
+var file_kyc_rpc_list_pending_kyc_documents_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x6b, 0x79, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x10,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x6b, 0x79, 0x63, 0x2f, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x76, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4b,
+	0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_rpc_list_pending_kyc_documents_proto_rawDescOnce sync.Once
+	file_kyc_rpc_list_pending_kyc_documents_proto_rawDescData = file_kyc_rpc_list_pending_kyc_documents_proto_rawDesc
+)
+
+func file_kyc_rpc_list_pending_kyc_documents_proto_rawDescGZIP() []byte {
+	file_kyc_rpc_list_pending_kyc_documents_proto_rawDescOnce.Do(func() {
+		file_kyc_rpc_list_pending_kyc_documents_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_rpc_list_pending_kyc_documents_proto_rawDescData)
+	})
+	return file_kyc_rpc_list_pending_kyc_documents_proto_rawDescData
+}
+
+var file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kyc_rpc_list_pending_kyc_documents_proto_goTypes = []any{
+	(*ListPendingKycDocumentsRequest)(nil),  // 0: pb.ListPendingKycDocumentsRequest
+	(*ListPendingKycDocumentsResponse)(nil), // 1: pb.ListPendingKycDocumentsResponse
+	(*Pagination)(nil),                      // 2: pb.Pagination
+	(*KycDocument)(nil),                     // 3: pb.KycDocument
+}
+var file_kyc_rpc_list_pending_kyc_documents_proto_depIdxs = []int32{
+	2, // 0: pb.ListPendingKycDocumentsResponse.pagination:type_name -> pb.Pagination
+	3, // 1: pb.ListPendingKycDocumentsResponse.data:type_name -> pb.KycDocument
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_kyc_rpc_list_pending_kyc_documents_proto_init() }
+func file_kyc_rpc_list_pending_kyc_documents_proto_init() {
+	if File_kyc_rpc_list_pending_kyc_documents_proto != nil {
+		return
+	}
+	file_pagination_proto_init()
+	file_kyc_kyc_document_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_rpc_list_pending_kyc_documents_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kyc_rpc_list_pending_kyc_documents_proto_goTypes,
+		DependencyIndexes: file_kyc_rpc_list_pending_kyc_documents_proto_depIdxs,
+		MessageInfos:      file_kyc_rpc_list_pending_kyc_documents_proto_msgTypes,
+	}.Build()
+	File_kyc_rpc_list_pending_kyc_documents_proto = out.File
+	file_kyc_rpc_list_pending_kyc_documents_proto_rawDesc = nil
+	file_kyc_rpc_list_pending_kyc_documents_proto_goTypes = nil
+	file_kyc_rpc_list_pending_kyc_documents_proto_depIdxs = nil
+}
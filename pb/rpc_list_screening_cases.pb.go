@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: screening/rpc_list_screening_cases.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListScreeningCasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// open, cleared or confirmed, defaults to open
+	Status        *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Cursor        string  `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningCasesRequest) Reset() {
+	*x = ListScreeningCasesRequest{}
+	mi := &file_screening_rpc_list_screening_cases_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningCasesRequest) ProtoMessage() {}
+
+func (x *ListScreeningCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_screening_rpc_list_screening_cases_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListScreeningCasesRequest) Descriptor() ([]byte, []int) {
+	return file_screening_rpc_list_screening_cases_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListScreeningCasesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListScreeningCasesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListScreeningCasesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListScreeningCasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*ScreeningCase       `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningCasesResponse) Reset() {
+	*x = ListScreeningCasesResponse{}
+	mi := &file_screening_rpc_list_screening_cases_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningCasesResponse) ProtoMessage() {}
+
+func (x *ListScreeningCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_screening_rpc_list_screening_cases_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListScreeningCasesResponse) Descriptor() ([]byte, []int) {
+	return file_screening_rpc_list_screening_cases_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListScreeningCasesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListScreeningCasesResponse) GetData() []*ScreeningCase {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_screening_rpc_list_screening_cases_proto protoreflect.FileDescriptor
+
+var file_screening_rpc_list_screening_cases_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x70, 0x63, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x10,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x71, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_screening_rpc_list_screening_cases_proto_rawDescOnce sync.Once
+	file_screening_rpc_list_screening_cases_proto_rawDescData = file_screening_rpc_list_screening_cases_proto_rawDesc
+)
+
+func file_screening_rpc_list_screening_cases_proto_rawDescGZIP() []byte {
+	file_screening_rpc_list_screening_cases_proto_rawDescOnce.Do(func() {
+		file_screening_rpc_list_screening_cases_proto_rawDescData = protoimpl.X.CompressGZIP(file_screening_rpc_list_screening_cases_proto_rawDescData)
+	})
+	return file_screening_rpc_list_screening_cases_proto_rawDescData
+}
+
+var file_screening_rpc_list_screening_cases_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_screening_rpc_list_screening_cases_proto_goTypes = []any{
+	(*ListScreeningCasesRequest)(nil),  // 0: pb.ListScreeningCasesRequest
+	(*ListScreeningCasesResponse)(nil), // 1: pb.ListScreeningCasesResponse
+	(*Pagination)(nil),                 // 2: pb.Pagination
+	(*ScreeningCase)(nil),              // 3: pb.ScreeningCase
+}
+var file_screening_rpc_list_screening_cases_proto_depIdxs = []int32{
+	2, // 0: pb.ListScreeningCasesResponse.pagination:type_name -> pb.Pagination
+	3, // 1: pb.ListScreeningCasesResponse.data:type_name -> pb.ScreeningCase
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_screening_rpc_list_screening_cases_proto_init() }
+func file_screening_rpc_list_screening_cases_proto_init() {
+	if File_screening_rpc_list_screening_cases_proto != nil {
+		return
+	}
+	file_pagination_proto_init()
+	file_screening_screening_case_proto_init()
+	file_screening_rpc_list_screening_cases_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_screening_rpc_list_screening_cases_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_screening_rpc_list_screening_cases_proto_goTypes,
+		DependencyIndexes: file_screening_rpc_list_screening_cases_proto_depIdxs,
+		MessageInfos:      file_screening_rpc_list_screening_cases_proto_msgTypes,
+	}.Build()
+	File_screening_rpc_list_screening_cases_proto = out.File
+	file_screening_rpc_list_screening_cases_proto_rawDesc = nil
+	file_screening_rpc_list_screening_cases_proto_goTypes = nil
+	file_screening_rpc_list_screening_cases_proto_depIdxs = nil
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: fraud/rpc_resolve_fraud_case.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveFraudCaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Approving makes the held back transfer, rejecting drops it
+	Approve       bool    `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFraudCaseRequest) Reset() {
+	*x = ResolveFraudCaseRequest{}
+	mi := &file_fraud_rpc_resolve_fraud_case_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFraudCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFraudCaseRequest) ProtoMessage() {}
+
+func (x *ResolveFraudCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fraud_rpc_resolve_fraud_case_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFraudCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFraudCaseRequest) Descriptor() ([]byte, []int) {
+	return file_fraud_rpc_resolve_fraud_case_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveFraudCaseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveFraudCaseRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ResolveFraudCaseRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type ResolveFraudCaseResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FraudCase *FraudCase             `protobuf:"bytes,1,opt,name=fraud_case,json=fraudCase,proto3" json:"fraud_case,omitempty"`
+	// The transfer, only set when the case was approved
+	Transfer      *Transfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFraudCaseResponse) Reset() {
+	*x = ResolveFraudCaseResponse{}
+	mi := &file_fraud_rpc_resolve_fraud_case_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFraudCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFraudCaseResponse) ProtoMessage() {}
+
+func (x *ResolveFraudCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fraud_rpc_resolve_fraud_case_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFraudCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFraudCaseResponse) Descriptor() ([]byte, []int) {
+	return file_fraud_rpc_resolve_fraud_case_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveFraudCaseResponse) GetFraudCase() *FraudCase {
+	if x != nil {
+		return x.FraudCase
+	}
+	return nil
+}
+
+func (x *ResolveFraudCaseResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_fraud_rpc_resolve_fraud_case_proto protoreflect.FileDescriptor
+
+var file_fraud_rpc_resolve_fraud_case_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x66, 0x72, 0x61, 0x75, 0x64, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x66, 0x72, 0x61, 0x75, 0x64, 0x2f, 0x66, 0x72,
+	0x61, 0x75, 0x64, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x12, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x0b, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x72, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x46, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72,
+	0x61, 0x75, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x66, 0x72, 0x61, 0x75, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x0f, 0x5a, 0x0d,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fraud_rpc_resolve_fraud_case_proto_rawDescOnce sync.Once
+	file_fraud_rpc_resolve_fraud_case_proto_rawDescData = file_fraud_rpc_resolve_fraud_case_proto_rawDesc
+)
+
+func file_fraud_rpc_resolve_fraud_case_proto_rawDescGZIP() []byte {
+	file_fraud_rpc_resolve_fraud_case_proto_rawDescOnce.Do(func() {
+		file_fraud_rpc_resolve_fraud_case_proto_rawDescData = protoimpl.X.CompressGZIP(file_fraud_rpc_resolve_fraud_case_proto_rawDescData)
+	})
+	return file_fraud_rpc_resolve_fraud_case_proto_rawDescData
+}
+
+var file_fraud_rpc_resolve_fraud_case_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fraud_rpc_resolve_fraud_case_proto_goTypes = []any{
+	(*ResolveFraudCaseRequest)(nil),  // 0: pb.ResolveFraudCaseRequest
+	(*ResolveFraudCaseResponse)(nil), // 1: pb.ResolveFraudCaseResponse
+	(*FraudCase)(nil),                // 2: pb.FraudCase
+	(*Transfer)(nil),                 // 3: pb.Transfer
+}
+var file_fraud_rpc_resolve_fraud_case_proto_depIdxs = []int32{
+	2, // 0: pb.ResolveFraudCaseResponse.fraud_case:type_name -> pb.FraudCase
+	3, // 1: pb.ResolveFraudCaseResponse.transfer:type_name -> pb.Transfer
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_fraud_rpc_resolve_fraud_case_proto_init() }
+func file_fraud_rpc_resolve_fraud_case_proto_init() {
+	if File_fraud_rpc_resolve_fraud_case_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_fraud_fraud_case_proto_init()
+	file_transfers_transfer_proto_init()
+	file_fraud_rpc_resolve_fraud_case_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fraud_rpc_resolve_fraud_case_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fraud_rpc_resolve_fraud_case_proto_goTypes,
+		DependencyIndexes: file_fraud_rpc_resolve_fraud_case_proto_depIdxs,
+		MessageInfos:      file_fraud_rpc_resolve_fraud_case_proto_msgTypes,
+	}.Build()
+	File_fraud_rpc_resolve_fraud_case_proto = out.File
+	file_fraud_rpc_resolve_fraud_case_proto_rawDesc = nil
+	file_fraud_rpc_resolve_fraud_case_proto_goTypes = nil
+	file_fraud_rpc_resolve_fraud_case_proto_depIdxs = nil
+}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: screening/rpc_resolve_screening_case.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveScreeningCaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Confirming the match keeps the user restricted, otherwise the case is cleared as a false positive
+	Confirm       bool    `protobuf:"varint,2,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	Note          *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveScreeningCaseRequest) Reset() {
+	*x = ResolveScreeningCaseRequest{}
+	mi := &file_screening_rpc_resolve_screening_case_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveScreeningCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveScreeningCaseRequest) ProtoMessage() {}
+
+func (x *ResolveScreeningCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_screening_rpc_resolve_screening_case_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveScreeningCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveScreeningCaseRequest) Descriptor() ([]byte, []int) {
+	return file_screening_rpc_resolve_screening_case_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveScreeningCaseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveScreeningCaseRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+func (x *ResolveScreeningCaseRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type ResolveScreeningCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScreeningCase *ScreeningCase         `protobuf:"bytes,1,opt,name=screening_case,json=screeningCase,proto3" json:"screening_case,omitempty"`
+	// The user as it is after the review, the restriction is lifted once all their cases are cleared
+	User          *User `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveScreeningCaseResponse) Reset() {
+	*x = ResolveScreeningCaseResponse{}
+	mi := &file_screening_rpc_resolve_screening_case_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveScreeningCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveScreeningCaseResponse) ProtoMessage() {}
+
+func (x *ResolveScreeningCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_screening_rpc_resolve_screening_case_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveScreeningCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveScreeningCaseResponse) Descriptor() ([]byte, []int) {
+	return file_screening_rpc_resolve_screening_case_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveScreeningCaseResponse) GetScreeningCase() *ScreeningCase {
+	if x != nil {
+		return x.ScreeningCase
+	}
+	return nil
+}
+
+func (x *ResolveScreeningCaseResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_screening_rpc_resolve_screening_case_proto protoreflect.FileDescriptor
+
+var file_screening_rpc_resolve_screening_case_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x70, 0x63, 0x5f,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x86, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xe0, 0x41,
+	0x02, 0x92, 0xb5, 0x18, 0x0f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x1c, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43,
+	0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_screening_rpc_resolve_screening_case_proto_rawDescOnce sync.Once
+	file_screening_rpc_resolve_screening_case_proto_rawDescData = file_screening_rpc_resolve_screening_case_proto_rawDesc
+)
+
+func file_screening_rpc_resolve_screening_case_proto_rawDescGZIP() []byte {
+	file_screening_rpc_resolve_screening_case_proto_rawDescOnce.Do(func() {
+		file_screening_rpc_resolve_screening_case_proto_rawDescData = protoimpl.X.CompressGZIP(file_screening_rpc_resolve_screening_case_proto_rawDescData)
+	})
+	return file_screening_rpc_resolve_screening_case_proto_rawDescData
+}
+
+var file_screening_rpc_resolve_screening_case_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_screening_rpc_resolve_screening_case_proto_goTypes = []any{
+	(*ResolveScreeningCaseRequest)(nil),  // 0: pb.ResolveScreeningCaseRequest
+	(*ResolveScreeningCaseResponse)(nil), // 1: pb.ResolveScreeningCaseResponse
+	(*ScreeningCase)(nil),                // 2: pb.ScreeningCase
+	(*User)(nil),                         // 3: pb.User
+}
+var file_screening_rpc_resolve_screening_case_proto_depIdxs = []int32{
+	2, // 0: pb.ResolveScreeningCaseResponse.screening_case:type_name -> pb.ScreeningCase
+	3, // 1: pb.ResolveScreeningCaseResponse.user:type_name -> pb.User
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_screening_rpc_resolve_screening_case_proto_init() }
+func file_screening_rpc_resolve_screening_case_proto_init() {
+	if File_screening_rpc_resolve_screening_case_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_screening_screening_case_proto_init()
+	file_users_user_proto_init()
+	file_screening_rpc_resolve_screening_case_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_screening_rpc_resolve_screening_case_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_screening_rpc_resolve_screening_case_proto_goTypes,
+		DependencyIndexes: file_screening_rpc_resolve_screening_case_proto_depIdxs,
+		MessageInfos:      file_screening_rpc_resolve_screening_case_proto_msgTypes,
+	}.Build()
+	File_screening_rpc_resolve_screening_case_proto = out.File
+	file_screening_rpc_resolve_screening_case_proto_rawDesc = nil
+	file_screening_rpc_resolve_screening_case_proto_goTypes = nil
+	file_screening_rpc_resolve_screening_case_proto_depIdxs = nil
+}
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: kyc/rpc_review_kyc_document.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewKycDocumentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	// Required when rejecting, it is sent to the user
+	Reason        *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewKycDocumentRequest) Reset() {
+	*x = ReviewKycDocumentRequest{}
+	mi := &file_kyc_rpc_review_kyc_document_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycDocumentRequest) ProtoMessage() {}
+
+func (x *ReviewKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_review_kyc_document_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*ReviewKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_review_kyc_document_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewKycDocumentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewKycDocumentRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewKycDocumentRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ReviewKycDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *KycDocument           `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewKycDocumentResponse) Reset() {
+	*x = ReviewKycDocumentResponse{}
+	mi := &file_kyc_rpc_review_kyc_document_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycDocumentResponse) ProtoMessage() {}
+
+func (x *ReviewKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_review_kyc_document_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*ReviewKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_review_kyc_document_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewKycDocumentResponse) GetDocument() *KycDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ReviewKycDocumentResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_kyc_rpc_review_kyc_document_proto protoreflect.FileDescriptor
+
+var file_kyc_rpc_review_kyc_document_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6b, 0x79, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x79, 0x63, 0x2f, 0x6b, 0x79, 0x63, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x87, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x14, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18,
+	0x0d, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x19, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x79,
+	0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_rpc_review_kyc_document_proto_rawDescOnce sync.Once
+	file_kyc_rpc_review_kyc_document_proto_rawDescData = file_kyc_rpc_review_kyc_document_proto_rawDesc
+)
+
+func file_kyc_rpc_review_kyc_document_proto_rawDescGZIP() []byte {
+	file_kyc_rpc_review_kyc_document_proto_rawDescOnce.Do(func() {
+		file_kyc_rpc_review_kyc_document_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_rpc_review_kyc_document_proto_rawDescData)
+	})
+	return file_kyc_rpc_review_kyc_document_proto_rawDescData
+}
+
+var file_kyc_rpc_review_kyc_document_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kyc_rpc_review_kyc_document_proto_goTypes = []any{
+	(*ReviewKycDocumentRequest)(nil),  // 0: pb.ReviewKycDocumentRequest
+	(*ReviewKycDocumentResponse)(nil), // 1: pb.ReviewKycDocumentResponse
+	(*KycDocument)(nil),               // 2: pb.KycDocument
+	(*User)(nil),                      // 3: pb.User
+}
+var file_kyc_rpc_review_kyc_document_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewKycDocumentResponse.document:type_name -> pb.KycDocument
+	3, // 1: pb.ReviewKycDocumentResponse.user:type_name -> pb.User
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_kyc_rpc_review_kyc_document_proto_init() }
+func file_kyc_rpc_review_kyc_document_proto_init() {
+	if File_kyc_rpc_review_kyc_document_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_kyc_kyc_document_proto_init()
+	file_users_user_proto_init()
+	file_kyc_rpc_review_kyc_document_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_rpc_review_kyc_document_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kyc_rpc_review_kyc_document_proto_goTypes,
+		DependencyIndexes: file_kyc_rpc_review_kyc_document_proto_depIdxs,
+		MessageInfos:      file_kyc_rpc_review_kyc_document_proto_msgTypes,
+	}.Build()
+	File_kyc_rpc_review_kyc_document_proto = out.File
+	file_kyc_rpc_review_kyc_document_proto_rawDesc = nil
+	file_kyc_rpc_review_kyc_document_proto_goTypes = nil
+	file_kyc_rpc_review_kyc_document_proto_depIdxs = nil
+}
@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: debits/rpc_revoke_mandate.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RevokeMandateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMandateRequest) Reset() {
+	*x = RevokeMandateRequest{}
+	mi := &file_debits_rpc_revoke_mandate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMandateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMandateRequest) ProtoMessage() {}
+
+func (x *RevokeMandateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debits_rpc_revoke_mandate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMandateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMandateRequest) Descriptor() ([]byte, []int) {
+	return file_debits_rpc_revoke_mandate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevokeMandateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_debits_rpc_revoke_mandate_proto protoreflect.FileDescriptor
+
+var file_debits_rpc_revoke_mandate_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x61, 0x6e,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0f, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x08,
+	0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x02, 0x69, 0x64, 0x42, 0x0f, 0x5a, 0x0d,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debits_rpc_revoke_mandate_proto_rawDescOnce sync.Once
+	file_debits_rpc_revoke_mandate_proto_rawDescData = file_debits_rpc_revoke_mandate_proto_rawDesc
+)
+
+func file_debits_rpc_revoke_mandate_proto_rawDescGZIP() []byte {
+	file_debits_rpc_revoke_mandate_proto_rawDescOnce.Do(func() {
+		file_debits_rpc_revoke_mandate_proto_rawDescData = protoimpl.X.CompressGZIP(file_debits_rpc_revoke_mandate_proto_rawDescData)
+	})
+	return file_debits_rpc_revoke_mandate_proto_rawDescData
+}
+
+var file_debits_rpc_revoke_mandate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_debits_rpc_revoke_mandate_proto_goTypes = []any{
+	(*RevokeMandateRequest)(nil), // 0: pb.RevokeMandateRequest
+}
+var file_debits_rpc_revoke_mandate_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_debits_rpc_revoke_mandate_proto_init() }
+func file_debits_rpc_revoke_mandate_proto_init() {
+	if File_debits_rpc_revoke_mandate_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debits_rpc_revoke_mandate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_debits_rpc_revoke_mandate_proto_goTypes,
+		DependencyIndexes: file_debits_rpc_revoke_mandate_proto_depIdxs,
+		MessageInfos:      file_debits_rpc_revoke_mandate_proto_msgTypes,
+	}.Build()
+	File_debits_rpc_revoke_mandate_proto = out.File
+	file_debits_rpc_revoke_mandate_proto_rawDesc = nil
+	file_debits_rpc_revoke_mandate_proto_goTypes = nil
+	file_debits_rpc_revoke_mandate_proto_depIdxs = nil
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: clients/rpc_rotate_service_client_key.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RotateServiceClientKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateServiceClientKeyRequest) Reset() {
+	*x = RotateServiceClientKeyRequest{}
+	mi := &file_clients_rpc_rotate_service_client_key_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceClientKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceClientKeyRequest) ProtoMessage() {}
+
+func (x *RotateServiceClientKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clients_rpc_rotate_service_client_key_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceClientKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateServiceClientKeyRequest) Descriptor() ([]byte, []int) {
+	return file_clients_rpc_rotate_service_client_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RotateServiceClientKeyRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type RotateServiceClientKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   *ServiceClientKey      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Only returned once, it cannot be recovered.
+	ClientSecret  string `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateServiceClientKeyResponse) Reset() {
+	*x = RotateServiceClientKeyResponse{}
+	mi := &file_clients_rpc_rotate_service_client_key_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceClientKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceClientKeyResponse) ProtoMessage() {}
+
+func (x *RotateServiceClientKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clients_rpc_rotate_service_client_key_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceClientKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateServiceClientKeyResponse) Descriptor() ([]byte, []int) {
+	return file_clients_rpc_rotate_service_client_key_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RotateServiceClientKeyResponse) GetKey() *ServiceClientKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *RotateServiceClientKeyResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+var File_clients_rpc_rotate_service_client_key_proto protoreflect.FileDescriptor
+
+var file_clients_rpc_rotate_service_client_key_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a,
+	0x1d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x16, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x1e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_clients_rpc_rotate_service_client_key_proto_rawDescOnce sync.Once
+	file_clients_rpc_rotate_service_client_key_proto_rawDescData = file_clients_rpc_rotate_service_client_key_proto_rawDesc
+)
+
+func file_clients_rpc_rotate_service_client_key_proto_rawDescGZIP() []byte {
+	file_clients_rpc_rotate_service_client_key_proto_rawDescOnce.Do(func() {
+		file_clients_rpc_rotate_service_client_key_proto_rawDescData = protoimpl.X.CompressGZIP(file_clients_rpc_rotate_service_client_key_proto_rawDescData)
+	})
+	return file_clients_rpc_rotate_service_client_key_proto_rawDescData
+}
+
+var file_clients_rpc_rotate_service_client_key_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_clients_rpc_rotate_service_client_key_proto_goTypes = []any{
+	(*RotateServiceClientKeyRequest)(nil),  // 0: pb.RotateServiceClientKeyRequest
+	(*RotateServiceClientKeyResponse)(nil), // 1: pb.RotateServiceClientKeyResponse
+	(*ServiceClientKey)(nil),               // 2: pb.ServiceClientKey
+}
+var file_clients_rpc_rotate_service_client_key_proto_depIdxs = []int32{
+	2, // 0: pb.RotateServiceClientKeyResponse.key:type_name -> pb.ServiceClientKey
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_clients_rpc_rotate_service_client_key_proto_init() }
+func file_clients_rpc_rotate_service_client_key_proto_init() {
+	if File_clients_rpc_rotate_service_client_key_proto != nil {
+		return
+	}
+	file_clients_service_client_proto_init()
+	file_audit_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_clients_rpc_rotate_service_client_key_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_clients_rpc_rotate_service_client_key_proto_goTypes,
+		DependencyIndexes: file_clients_rpc_rotate_service_client_key_proto_depIdxs,
+		MessageInfos:      file_clients_rpc_rotate_service_client_key_proto_msgTypes,
+	}.Build()
+	File_clients_rpc_rotate_service_client_key_proto = out.File
+	file_clients_rpc_rotate_service_client_key_proto_rawDesc = nil
+	file_clients_rpc_rotate_service_client_key_proto_goTypes = nil
+	file_clients_rpc_rotate_service_client_key_proto_depIdxs = nil
+}
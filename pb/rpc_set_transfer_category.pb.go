@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: transfers/rpc_set_transfer_category.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetTransferCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      SpendingCategory       `protobuf:"varint,2,opt,name=category,proto3,enum=pb.SpendingCategory" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferCategoryRequest) Reset() {
+	*x = SetTransferCategoryRequest{}
+	mi := &file_transfers_rpc_set_transfer_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferCategoryRequest) ProtoMessage() {}
+
+func (x *SetTransferCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_rpc_set_transfer_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SetTransferCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_rpc_set_transfer_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetTransferCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetTransferCategoryRequest) GetCategory() SpendingCategory {
+	if x != nil {
+		return x.Category
+	}
+	return SpendingCategory_SPENDING_CATEGORY_UNSPECIFIED
+}
+
+var File_transfers_rpc_set_transfer_category_proto protoreflect.FileDescriptor
+
+var file_transfers_rpc_set_transfer_category_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x5f,
+	0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x10, 0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x02, 0x69, 0x64, 0x12, 0x6f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x42, 0x3d, 0x92, 0x41, 0x37, 0x32, 0x35, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x20, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0xe0, 0x41, 0x02, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_transfers_rpc_set_transfer_category_proto_rawDescOnce sync.Once
+	file_transfers_rpc_set_transfer_category_proto_rawDescData = file_transfers_rpc_set_transfer_category_proto_rawDesc
+)
+
+func file_transfers_rpc_set_transfer_category_proto_rawDescGZIP() []byte {
+	file_transfers_rpc_set_transfer_category_proto_rawDescOnce.Do(func() {
+		file_transfers_rpc_set_transfer_category_proto_rawDescData = protoimpl.X.CompressGZIP(file_transfers_rpc_set_transfer_category_proto_rawDescData)
+	})
+	return file_transfers_rpc_set_transfer_category_proto_rawDescData
+}
+
+var file_transfers_rpc_set_transfer_category_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfers_rpc_set_transfer_category_proto_goTypes = []any{
+	(*SetTransferCategoryRequest)(nil), // 0: pb.SetTransferCategoryRequest
+	(SpendingCategory)(0),              // 1: pb.SpendingCategory
+}
+var file_transfers_rpc_set_transfer_category_proto_depIdxs = []int32{
+	1, // 0: pb.SetTransferCategoryRequest.category:type_name -> pb.SpendingCategory
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_transfers_rpc_set_transfer_category_proto_init() }
+func file_transfers_rpc_set_transfer_category_proto_init() {
+	if File_transfers_rpc_set_transfer_category_proto != nil {
+		return
+	}
+	file_transfers_category_proto_init()
+	file_audit_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_transfers_rpc_set_transfer_category_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_transfers_rpc_set_transfer_category_proto_goTypes,
+		DependencyIndexes: file_transfers_rpc_set_transfer_category_proto_depIdxs,
+		MessageInfos:      file_transfers_rpc_set_transfer_category_proto_msgTypes,
+	}.Build()
+	File_transfers_rpc_set_transfer_category_proto = out.File
+	file_transfers_rpc_set_transfer_category_proto_rawDesc = nil
+	file_transfers_rpc_set_transfer_category_proto_goTypes = nil
+	file_transfers_rpc_set_transfer_category_proto_depIdxs = nil
+}
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v6.33.2
+// source: kyc/rpc_upload_kyc_document.proto
+
+package pb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadKycDocumentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// identity or proof_of_address
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// A JPEG, PNG or PDF file
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKycDocumentRequest) Reset() {
+	*x = UploadKycDocumentRequest{}
+	mi := &file_kyc_rpc_upload_kyc_document_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKycDocumentRequest) ProtoMessage() {}
+
+func (x *UploadKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_upload_kyc_document_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_upload_kyc_document_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadKycDocumentRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadKycDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *KycDocument           `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKycDocumentResponse) Reset() {
+	*x = UploadKycDocumentResponse{}
+	mi := &file_kyc_rpc_upload_kyc_document_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKycDocumentResponse) ProtoMessage() {}
+
+func (x *UploadKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_rpc_upload_kyc_document_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_rpc_upload_kyc_document_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadKycDocumentResponse) GetDocument() *KycDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *UploadKycDocumentResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_kyc_rpc_upload_kyc_document_proto protoreflect.FileDescriptor
+
+var file_kyc_rpc_upload_kyc_document_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6b, 0x79, 0x63, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x79, 0x63, 0x2f, 0x6b, 0x79, 0x63, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x80, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe0, 0x41, 0x02, 0x92, 0xb5, 0x18, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x21, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x07, 0xe0, 0x41, 0x02, 0x98, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x66, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x79, 0x63, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x42, 0x0f, 0x5a, 0x0d, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_rpc_upload_kyc_document_proto_rawDescOnce sync.Once
+	file_kyc_rpc_upload_kyc_document_proto_rawDescData = file_kyc_rpc_upload_kyc_document_proto_rawDesc
+)
+
+func file_kyc_rpc_upload_kyc_document_proto_rawDescGZIP() []byte {
+	file_kyc_rpc_upload_kyc_document_proto_rawDescOnce.Do(func() {
+		file_kyc_rpc_upload_kyc_document_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_rpc_upload_kyc_document_proto_rawDescData)
+	})
+	return file_kyc_rpc_upload_kyc_document_proto_rawDescData
+}
+
+var file_kyc_rpc_upload_kyc_document_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kyc_rpc_upload_kyc_document_proto_goTypes = []any{
+	(*UploadKycDocumentRequest)(nil),  // 0: pb.UploadKycDocumentRequest
+	(*UploadKycDocumentResponse)(nil), // 1: pb.UploadKycDocumentResponse
+	(*KycDocument)(nil),               // 2: pb.KycDocument
+	(*User)(nil),                      // 3: pb.User
+}
+var file_kyc_rpc_upload_kyc_document_proto_depIdxs = []int32{
+	2, // 0: pb.UploadKycDocumentResponse.document:type_name -> pb.KycDocument
+	3, // 1: pb.UploadKycDocumentResponse.user:type_name -> pb.User
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_kyc_rpc_upload_kyc_document_proto_init() }
+func file_kyc_rpc_upload_kyc_document_proto_init() {
+	if File_kyc_rpc_upload_kyc_document_proto != nil {
+		return
+	}
+	file_audit_proto_init()
+	file_kyc_kyc_document_proto_init()
+	file_users_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_rpc_upload_kyc_document_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kyc_rpc_upload_kyc_document_proto_goTypes,
+		DependencyIndexes: file_kyc_rpc_upload_kyc_document_proto_depIdxs,
+		MessageInfos:      file_kyc_rpc_upload_kyc_document_proto_msgTypes,
+	}.Build()
+	File_kyc_rpc_upload_kyc_document_proto = out.File
+	file_kyc_rpc_upload_kyc_document_proto_rawDesc = nil
+	file_kyc_rpc_upload_kyc_document_proto_goTypes = nil
+	file_kyc_rpc_upload_kyc_document_proto_depIdxs = nil
+}
@@ -20,17 +20,82 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Simplebank_CreateUser_FullMethodName     = "/pb.Simplebank/CreateUser"
-	Simplebank_UpdateUser_FullMethodName     = "/pb.Simplebank/UpdateUser"
-	Simplebank_LoginUser_FullMethodName      = "/pb.Simplebank/LoginUser"
-	Simplebank_RenewAccess_FullMethodName    = "/pb.Simplebank/RenewAccess"
-	Simplebank_VerifyEmail_FullMethodName    = "/pb.Simplebank/VerifyEmail"
-	Simplebank_CreateAccount_FullMethodName  = "/pb.Simplebank/CreateAccount"
-	Simplebank_ListTransfers_FullMethodName  = "/pb.Simplebank/ListTransfers"
-	Simplebank_CreateTransfer_FullMethodName = "/pb.Simplebank/CreateTransfer"
-	Simplebank_ListAccounts_FullMethodName   = "/pb.Simplebank/ListAccounts"
-	Simplebank_GetAccount_FullMethodName     = "/pb.Simplebank/GetAccount"
-	Simplebank_DeleteAccount_FullMethodName  = "/pb.Simplebank/DeleteAccount"
+	Simplebank_CreateUser_FullMethodName              = "/pb.Simplebank/CreateUser"
+	Simplebank_UpdateUser_FullMethodName              = "/pb.Simplebank/UpdateUser"
+	Simplebank_LoginUser_FullMethodName               = "/pb.Simplebank/LoginUser"
+	Simplebank_RenewAccess_FullMethodName             = "/pb.Simplebank/RenewAccess"
+	Simplebank_VerifyEmail_FullMethodName             = "/pb.Simplebank/VerifyEmail"
+	Simplebank_CreateAccount_FullMethodName           = "/pb.Simplebank/CreateAccount"
+	Simplebank_ListTransfers_FullMethodName           = "/pb.Simplebank/ListTransfers"
+	Simplebank_CreateTransfer_FullMethodName          = "/pb.Simplebank/CreateTransfer"
+	Simplebank_ListAccounts_FullMethodName            = "/pb.Simplebank/ListAccounts"
+	Simplebank_GetAccount_FullMethodName              = "/pb.Simplebank/GetAccount"
+	Simplebank_DeleteAccount_FullMethodName           = "/pb.Simplebank/DeleteAccount"
+	Simplebank_RequestMoney_FullMethodName            = "/pb.Simplebank/RequestMoney"
+	Simplebank_GetPaymentRequest_FullMethodName       = "/pb.Simplebank/GetPaymentRequest"
+	Simplebank_ListPaymentRequests_FullMethodName     = "/pb.Simplebank/ListPaymentRequests"
+	Simplebank_AcceptPaymentRequest_FullMethodName    = "/pb.Simplebank/AcceptPaymentRequest"
+	Simplebank_DeclinePaymentRequest_FullMethodName   = "/pb.Simplebank/DeclinePaymentRequest"
+	Simplebank_SplitBill_FullMethodName               = "/pb.Simplebank/SplitBill"
+	Simplebank_GetBillSplit_FullMethodName            = "/pb.Simplebank/GetBillSplit"
+	Simplebank_ListBillSplits_FullMethodName          = "/pb.Simplebank/ListBillSplits"
+	Simplebank_RemindBillSplit_FullMethodName         = "/pb.Simplebank/RemindBillSplit"
+	Simplebank_CreateMandate_FullMethodName           = "/pb.Simplebank/CreateMandate"
+	Simplebank_ListMandates_FullMethodName            = "/pb.Simplebank/ListMandates"
+	Simplebank_RevokeMandate_FullMethodName           = "/pb.Simplebank/RevokeMandate"
+	Simplebank_CollectDirectDebit_FullMethodName      = "/pb.Simplebank/CollectDirectDebit"
+	Simplebank_ListDirectDebits_FullMethodName        = "/pb.Simplebank/ListDirectDebits"
+	Simplebank_ChargebackDirectDebit_FullMethodName   = "/pb.Simplebank/ChargebackDirectDebit"
+	Simplebank_SetTransferCategory_FullMethodName     = "/pb.Simplebank/SetTransferCategory"
+	Simplebank_CreateCategoryRule_FullMethodName      = "/pb.Simplebank/CreateCategoryRule"
+	Simplebank_ListCategoryRules_FullMethodName       = "/pb.Simplebank/ListCategoryRules"
+	Simplebank_DeleteCategoryRule_FullMethodName      = "/pb.Simplebank/DeleteCategoryRule"
+	Simplebank_GetSpendingSummary_FullMethodName      = "/pb.Simplebank/GetSpendingSummary"
+	Simplebank_VerifyLoginMfa_FullMethodName          = "/pb.Simplebank/VerifyLoginMfa"
+	Simplebank_EnrollTotp_FullMethodName              = "/pb.Simplebank/EnrollTotp"
+	Simplebank_ConfirmTotp_FullMethodName             = "/pb.Simplebank/ConfirmTotp"
+	Simplebank_DisableTotp_FullMethodName             = "/pb.Simplebank/DisableTotp"
+	Simplebank_RequestPasswordReset_FullMethodName    = "/pb.Simplebank/RequestPasswordReset"
+	Simplebank_ResetPassword_FullMethodName           = "/pb.Simplebank/ResetPassword"
+	Simplebank_Logout_FullMethodName                  = "/pb.Simplebank/Logout"
+	Simplebank_ListSessions_FullMethodName            = "/pb.Simplebank/ListSessions"
+	Simplebank_RevokeSession_FullMethodName           = "/pb.Simplebank/RevokeSession"
+	Simplebank_RevokeAllOtherSessions_FullMethodName  = "/pb.Simplebank/RevokeAllOtherSessions"
+	Simplebank_BlockUserSessions_FullMethodName       = "/pb.Simplebank/BlockUserSessions"
+	Simplebank_UnlockUser_FullMethodName              = "/pb.Simplebank/UnlockUser"
+	Simplebank_ListLoginAttempts_FullMethodName       = "/pb.Simplebank/ListLoginAttempts"
+	Simplebank_ListRoles_FullMethodName               = "/pb.Simplebank/ListRoles"
+	Simplebank_AssignUserRole_FullMethodName          = "/pb.Simplebank/AssignUserRole"
+	Simplebank_CreateServiceClient_FullMethodName     = "/pb.Simplebank/CreateServiceClient"
+	Simplebank_ListServiceClients_FullMethodName      = "/pb.Simplebank/ListServiceClients"
+	Simplebank_RotateServiceClientKey_FullMethodName  = "/pb.Simplebank/RotateServiceClientKey"
+	Simplebank_DisableServiceClient_FullMethodName    = "/pb.Simplebank/DisableServiceClient"
+	Simplebank_IssueClientToken_FullMethodName        = "/pb.Simplebank/IssueClientToken"
+	Simplebank_ImpersonateUser_FullMethodName         = "/pb.Simplebank/ImpersonateUser"
+	Simplebank_ListImpersonations_FullMethodName      = "/pb.Simplebank/ListImpersonations"
+	Simplebank_ListAuditEvents_FullMethodName         = "/pb.Simplebank/ListAuditEvents"
+	Simplebank_ResendVerificationEmail_FullMethodName = "/pb.Simplebank/ResendVerificationEmail"
+	Simplebank_ExportMyData_FullMethodName            = "/pb.Simplebank/ExportMyData"
+	Simplebank_RequestAccountErasure_FullMethodName   = "/pb.Simplebank/RequestAccountErasure"
+	Simplebank_UploadKycDocument_FullMethodName       = "/pb.Simplebank/UploadKycDocument"
+	Simplebank_ListKycDocuments_FullMethodName        = "/pb.Simplebank/ListKycDocuments"
+	Simplebank_ListPendingKycDocuments_FullMethodName = "/pb.Simplebank/ListPendingKycDocuments"
+	Simplebank_DownloadKycDocument_FullMethodName     = "/pb.Simplebank/DownloadKycDocument"
+	Simplebank_ReviewKycDocument_FullMethodName       = "/pb.Simplebank/ReviewKycDocument"
+	Simplebank_SuspendUser_FullMethodName             = "/pb.Simplebank/SuspendUser"
+	Simplebank_DeactivateUser_FullMethodName          = "/pb.Simplebank/DeactivateUser"
+	Simplebank_ReactivateUser_FullMethodName          = "/pb.Simplebank/ReactivateUser"
+	Simplebank_ListUserStatusChanges_FullMethodName   = "/pb.Simplebank/ListUserStatusChanges"
+	Simplebank_SearchUsers_FullMethodName             = "/pb.Simplebank/SearchUsers"
+	Simplebank_GetUserDetails_FullMethodName          = "/pb.Simplebank/GetUserDetails"
+	Simplebank_InviteUser_FullMethodName              = "/pb.Simplebank/InviteUser"
+	Simplebank_ListFraudCases_FullMethodName          = "/pb.Simplebank/ListFraudCases"
+	Simplebank_ResolveFraudCase_FullMethodName        = "/pb.Simplebank/ResolveFraudCase"
+	Simplebank_ListScreeningCases_FullMethodName      = "/pb.Simplebank/ListScreeningCases"
+	Simplebank_ResolveScreeningCase_FullMethodName    = "/pb.Simplebank/ResolveScreeningCase"
+	Simplebank_ListAmlReports_FullMethodName          = "/pb.Simplebank/ListAmlReports"
+	Simplebank_GetAmlReport_FullMethodName            = "/pb.Simplebank/GetAmlReport"
+	Simplebank_ExportAmlReports_FullMethodName        = "/pb.Simplebank/ExportAmlReports"
 )
 
 // SimplebankClient is the client API for Simplebank service.
@@ -48,6 +113,71 @@ type SimplebankClient interface {
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestMoney(ctx context.Context, in *RequestMoneyRequest, opts ...grpc.CallOption) (*RequestMoneyResponse, error)
+	GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*PaymentRequest, error)
+	ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*PaymentRequest, error)
+	SplitBill(ctx context.Context, in *SplitBillRequest, opts ...grpc.CallOption) (*BillSplit, error)
+	GetBillSplit(ctx context.Context, in *GetBillSplitRequest, opts ...grpc.CallOption) (*BillSplit, error)
+	ListBillSplits(ctx context.Context, in *ListBillSplitsRequest, opts ...grpc.CallOption) (*ListBillSplitsResponse, error)
+	RemindBillSplit(ctx context.Context, in *RemindBillSplitRequest, opts ...grpc.CallOption) (*RemindBillSplitResponse, error)
+	CreateMandate(ctx context.Context, in *CreateMandateRequest, opts ...grpc.CallOption) (*Mandate, error)
+	ListMandates(ctx context.Context, in *ListMandatesRequest, opts ...grpc.CallOption) (*ListMandatesResponse, error)
+	RevokeMandate(ctx context.Context, in *RevokeMandateRequest, opts ...grpc.CallOption) (*Mandate, error)
+	CollectDirectDebit(ctx context.Context, in *CollectDirectDebitRequest, opts ...grpc.CallOption) (*CollectDirectDebitResponse, error)
+	ListDirectDebits(ctx context.Context, in *ListDirectDebitsRequest, opts ...grpc.CallOption) (*ListDirectDebitsResponse, error)
+	ChargebackDirectDebit(ctx context.Context, in *ChargebackDirectDebitRequest, opts ...grpc.CallOption) (*ChargebackDirectDebitResponse, error)
+	SetTransferCategory(ctx context.Context, in *SetTransferCategoryRequest, opts ...grpc.CallOption) (*Transfer, error)
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error)
+	ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error)
+	VerifyLoginMfa(ctx context.Context, in *VerifyLoginMfaRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error)
+	DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error)
+	BlockUserSessions(ctx context.Context, in *BlockUserSessionsRequest, opts ...grpc.CallOption) (*BlockUserSessionsResponse, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsRequest, opts ...grpc.CallOption) (*ListLoginAttemptsResponse, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	AssignUserRole(ctx context.Context, in *AssignUserRoleRequest, opts ...grpc.CallOption) (*User, error)
+	CreateServiceClient(ctx context.Context, in *CreateServiceClientRequest, opts ...grpc.CallOption) (*CreateServiceClientResponse, error)
+	ListServiceClients(ctx context.Context, in *ListServiceClientsRequest, opts ...grpc.CallOption) (*ListServiceClientsResponse, error)
+	RotateServiceClientKey(ctx context.Context, in *RotateServiceClientKeyRequest, opts ...grpc.CallOption) (*RotateServiceClientKeyResponse, error)
+	DisableServiceClient(ctx context.Context, in *DisableServiceClientRequest, opts ...grpc.CallOption) (*ServiceClient, error)
+	IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error)
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error)
+	ListImpersonations(ctx context.Context, in *ListImpersonationsRequest, opts ...grpc.CallOption) (*ListImpersonationsResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*DataRequest, error)
+	RequestAccountErasure(ctx context.Context, in *RequestAccountErasureRequest, opts ...grpc.CallOption) (*RequestAccountErasureResponse, error)
+	UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*UploadKycDocumentResponse, error)
+	ListKycDocuments(ctx context.Context, in *ListKycDocumentsRequest, opts ...grpc.CallOption) (*ListKycDocumentsResponse, error)
+	ListPendingKycDocuments(ctx context.Context, in *ListPendingKycDocumentsRequest, opts ...grpc.CallOption) (*ListPendingKycDocumentsResponse, error)
+	DownloadKycDocument(ctx context.Context, in *DownloadKycDocumentRequest, opts ...grpc.CallOption) (*DownloadKycDocumentResponse, error)
+	ReviewKycDocument(ctx context.Context, in *ReviewKycDocumentRequest, opts ...grpc.CallOption) (*ReviewKycDocumentResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
+	ListUserStatusChanges(ctx context.Context, in *ListUserStatusChangesRequest, opts ...grpc.CallOption) (*ListUserStatusChangesResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	GetUserDetails(ctx context.Context, in *GetUserDetailsRequest, opts ...grpc.CallOption) (*GetUserDetailsResponse, error)
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error)
+	ResolveFraudCase(ctx context.Context, in *ResolveFraudCaseRequest, opts ...grpc.CallOption) (*ResolveFraudCaseResponse, error)
+	ListScreeningCases(ctx context.Context, in *ListScreeningCasesRequest, opts ...grpc.CallOption) (*ListScreeningCasesResponse, error)
+	ResolveScreeningCase(ctx context.Context, in *ResolveScreeningCaseRequest, opts ...grpc.CallOption) (*ResolveScreeningCaseResponse, error)
+	ListAmlReports(ctx context.Context, in *ListAmlReportsRequest, opts ...grpc.CallOption) (*ListAmlReportsResponse, error)
+	GetAmlReport(ctx context.Context, in *GetAmlReportRequest, opts ...grpc.CallOption) (*GetAmlReportResponse, error)
+	ExportAmlReports(ctx context.Context, in *ExportAmlReportsRequest, opts ...grpc.CallOption) (*ExportAmlReportsResponse, error)
 }
 
 type simplebankClient struct {
@@ -168,6 +298,656 @@ func (c *simplebankClient) DeleteAccount(ctx context.Context, in *DeleteAccountR
 	return out, nil
 }
 
+func (c *simplebankClient) RequestMoney(ctx context.Context, in *RequestMoneyRequest, opts ...grpc.CallOption) (*RequestMoneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestMoneyResponse)
+	err := c.cc.Invoke(ctx, Simplebank_RequestMoney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*PaymentRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentRequest)
+	err := c.cc.Invoke(ctx, Simplebank_GetPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentRequestsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListPaymentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, Simplebank_AcceptPaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*PaymentRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentRequest)
+	err := c.cc.Invoke(ctx, Simplebank_DeclinePaymentRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) SplitBill(ctx context.Context, in *SplitBillRequest, opts ...grpc.CallOption) (*BillSplit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillSplit)
+	err := c.cc.Invoke(ctx, Simplebank_SplitBill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) GetBillSplit(ctx context.Context, in *GetBillSplitRequest, opts ...grpc.CallOption) (*BillSplit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillSplit)
+	err := c.cc.Invoke(ctx, Simplebank_GetBillSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListBillSplits(ctx context.Context, in *ListBillSplitsRequest, opts ...grpc.CallOption) (*ListBillSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBillSplitsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListBillSplits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RemindBillSplit(ctx context.Context, in *RemindBillSplitRequest, opts ...grpc.CallOption) (*RemindBillSplitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemindBillSplitResponse)
+	err := c.cc.Invoke(ctx, Simplebank_RemindBillSplit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) CreateMandate(ctx context.Context, in *CreateMandateRequest, opts ...grpc.CallOption) (*Mandate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mandate)
+	err := c.cc.Invoke(ctx, Simplebank_CreateMandate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListMandates(ctx context.Context, in *ListMandatesRequest, opts ...grpc.CallOption) (*ListMandatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMandatesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListMandates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RevokeMandate(ctx context.Context, in *RevokeMandateRequest, opts ...grpc.CallOption) (*Mandate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Mandate)
+	err := c.cc.Invoke(ctx, Simplebank_RevokeMandate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) CollectDirectDebit(ctx context.Context, in *CollectDirectDebitRequest, opts ...grpc.CallOption) (*CollectDirectDebitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectDirectDebitResponse)
+	err := c.cc.Invoke(ctx, Simplebank_CollectDirectDebit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListDirectDebits(ctx context.Context, in *ListDirectDebitsRequest, opts ...grpc.CallOption) (*ListDirectDebitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectDebitsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListDirectDebits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ChargebackDirectDebit(ctx context.Context, in *ChargebackDirectDebitRequest, opts ...grpc.CallOption) (*ChargebackDirectDebitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargebackDirectDebitResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ChargebackDirectDebit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) SetTransferCategory(ctx context.Context, in *SetTransferCategoryRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Simplebank_SetTransferCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CategoryRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryRule)
+	err := c.cc.Invoke(ctx, Simplebank_CreateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingSummaryResponse)
+	err := c.cc.Invoke(ctx, Simplebank_GetSpendingSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) VerifyLoginMfa(ctx context.Context, in *VerifyLoginMfaRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginUserResponse)
+	err := c.cc.Invoke(ctx, Simplebank_VerifyLoginMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTotpResponse)
+	err := c.cc.Invoke(ctx, Simplebank_EnrollTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTotpResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ConfirmTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_DisableTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simplebank_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) BlockUserSessions(ctx context.Context, in *BlockUserSessionsRequest, opts ...grpc.CallOption) (*BlockUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserSessionsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_BlockUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, Simplebank_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsRequest, opts ...grpc.CallOption) (*ListLoginAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginAttemptsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListLoginAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) AssignUserRole(ctx context.Context, in *AssignUserRoleRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, Simplebank_AssignUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) CreateServiceClient(ctx context.Context, in *CreateServiceClientRequest, opts ...grpc.CallOption) (*CreateServiceClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceClientResponse)
+	err := c.cc.Invoke(ctx, Simplebank_CreateServiceClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListServiceClients(ctx context.Context, in *ListServiceClientsRequest, opts ...grpc.CallOption) (*ListServiceClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceClientsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListServiceClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RotateServiceClientKey(ctx context.Context, in *RotateServiceClientKeyRequest, opts ...grpc.CallOption) (*RotateServiceClientKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateServiceClientKeyResponse)
+	err := c.cc.Invoke(ctx, Simplebank_RotateServiceClientKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DisableServiceClient(ctx context.Context, in *DisableServiceClientRequest, opts ...grpc.CallOption) (*ServiceClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClient)
+	err := c.cc.Invoke(ctx, Simplebank_DisableServiceClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueClientTokenResponse)
+	err := c.cc.Invoke(ctx, Simplebank_IssueClientToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListImpersonations(ctx context.Context, in *ListImpersonationsRequest, opts ...grpc.CallOption) (*ListImpersonationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImpersonationsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListImpersonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ResendVerificationEmail(ctx context.Context, in *ResendVerificationEmailRequest, opts ...grpc.CallOption) (*ResendVerificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ResendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*DataRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataRequest)
+	err := c.cc.Invoke(ctx, Simplebank_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) RequestAccountErasure(ctx context.Context, in *RequestAccountErasureRequest, opts ...grpc.CallOption) (*RequestAccountErasureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccountErasureResponse)
+	err := c.cc.Invoke(ctx, Simplebank_RequestAccountErasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*UploadKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadKycDocumentResponse)
+	err := c.cc.Invoke(ctx, Simplebank_UploadKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListKycDocuments(ctx context.Context, in *ListKycDocumentsRequest, opts ...grpc.CallOption) (*ListKycDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKycDocumentsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListKycDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListPendingKycDocuments(ctx context.Context, in *ListPendingKycDocumentsRequest, opts ...grpc.CallOption) (*ListPendingKycDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingKycDocumentsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListPendingKycDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DownloadKycDocument(ctx context.Context, in *DownloadKycDocumentRequest, opts ...grpc.CallOption) (*DownloadKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadKycDocumentResponse)
+	err := c.cc.Invoke(ctx, Simplebank_DownloadKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ReviewKycDocument(ctx context.Context, in *ReviewKycDocumentRequest, opts ...grpc.CallOption) (*ReviewKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewKycDocumentResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ReviewKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, Simplebank_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, Simplebank_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListUserStatusChanges(ctx context.Context, in *ListUserStatusChangesRequest, opts ...grpc.CallOption) (*ListUserStatusChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserStatusChangesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListUserStatusChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, Simplebank_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) GetUserDetails(ctx context.Context, in *GetUserDetailsRequest, opts ...grpc.CallOption) (*GetUserDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDetailsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_GetUserDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, Simplebank_InviteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListFraudCases(ctx context.Context, in *ListFraudCasesRequest, opts ...grpc.CallOption) (*ListFraudCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudCasesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListFraudCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ResolveFraudCase(ctx context.Context, in *ResolveFraudCaseRequest, opts ...grpc.CallOption) (*ResolveFraudCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveFraudCaseResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ResolveFraudCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListScreeningCases(ctx context.Context, in *ListScreeningCasesRequest, opts ...grpc.CallOption) (*ListScreeningCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScreeningCasesResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListScreeningCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ResolveScreeningCase(ctx context.Context, in *ResolveScreeningCaseRequest, opts ...grpc.CallOption) (*ResolveScreeningCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveScreeningCaseResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ResolveScreeningCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ListAmlReports(ctx context.Context, in *ListAmlReportsRequest, opts ...grpc.CallOption) (*ListAmlReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAmlReportsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ListAmlReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) GetAmlReport(ctx context.Context, in *GetAmlReportRequest, opts ...grpc.CallOption) (*GetAmlReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAmlReportResponse)
+	err := c.cc.Invoke(ctx, Simplebank_GetAmlReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simplebankClient) ExportAmlReports(ctx context.Context, in *ExportAmlReportsRequest, opts ...grpc.CallOption) (*ExportAmlReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAmlReportsResponse)
+	err := c.cc.Invoke(ctx, Simplebank_ExportAmlReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SimplebankServer is the server API for Simplebank service.
 // All implementations must embed UnimplementedSimplebankServer
 // for forward compatibility.
@@ -183,264 +963,1694 @@ type SimplebankServer interface {
 	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
+	RequestMoney(context.Context, *RequestMoneyRequest) (*RequestMoneyResponse, error)
+	GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*PaymentRequest, error)
+	ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*PaymentRequest, error)
+	SplitBill(context.Context, *SplitBillRequest) (*BillSplit, error)
+	GetBillSplit(context.Context, *GetBillSplitRequest) (*BillSplit, error)
+	ListBillSplits(context.Context, *ListBillSplitsRequest) (*ListBillSplitsResponse, error)
+	RemindBillSplit(context.Context, *RemindBillSplitRequest) (*RemindBillSplitResponse, error)
+	CreateMandate(context.Context, *CreateMandateRequest) (*Mandate, error)
+	ListMandates(context.Context, *ListMandatesRequest) (*ListMandatesResponse, error)
+	RevokeMandate(context.Context, *RevokeMandateRequest) (*Mandate, error)
+	CollectDirectDebit(context.Context, *CollectDirectDebitRequest) (*CollectDirectDebitResponse, error)
+	ListDirectDebits(context.Context, *ListDirectDebitsRequest) (*ListDirectDebitsResponse, error)
+	ChargebackDirectDebit(context.Context, *ChargebackDirectDebitRequest) (*ChargebackDirectDebitResponse, error)
+	SetTransferCategory(context.Context, *SetTransferCategoryRequest) (*Transfer, error)
+	CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CategoryRule, error)
+	ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*emptypb.Empty, error)
+	GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
+	VerifyLoginMfa(context.Context, *VerifyLoginMfaRequest) (*LoginUserResponse, error)
+	EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error)
+	ConfirmTotp(context.Context, *ConfirmTotpRequest) (*ConfirmTotpResponse, error)
+	DisableTotp(context.Context, *DisableTotpRequest) (*emptypb.Empty, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeAllOtherSessionsResponse, error)
+	BlockUserSessions(context.Context, *BlockUserSessionsRequest) (*BlockUserSessionsResponse, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*User, error)
+	ListLoginAttempts(context.Context, *ListLoginAttemptsRequest) (*ListLoginAttemptsResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	AssignUserRole(context.Context, *AssignUserRoleRequest) (*User, error)
+	CreateServiceClient(context.Context, *CreateServiceClientRequest) (*CreateServiceClientResponse, error)
+	ListServiceClients(context.Context, *ListServiceClientsRequest) (*ListServiceClientsResponse, error)
+	RotateServiceClientKey(context.Context, *RotateServiceClientKeyRequest) (*RotateServiceClientKeyResponse, error)
+	DisableServiceClient(context.Context, *DisableServiceClientRequest) (*ServiceClient, error)
+	IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error)
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	ListImpersonations(context.Context, *ListImpersonationsRequest) (*ListImpersonationsResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error)
+	ExportMyData(context.Context, *ExportMyDataRequest) (*DataRequest, error)
+	RequestAccountErasure(context.Context, *RequestAccountErasureRequest) (*RequestAccountErasureResponse, error)
+	UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*UploadKycDocumentResponse, error)
+	ListKycDocuments(context.Context, *ListKycDocumentsRequest) (*ListKycDocumentsResponse, error)
+	ListPendingKycDocuments(context.Context, *ListPendingKycDocumentsRequest) (*ListPendingKycDocumentsResponse, error)
+	DownloadKycDocument(context.Context, *DownloadKycDocumentRequest) (*DownloadKycDocumentResponse, error)
+	ReviewKycDocument(context.Context, *ReviewKycDocumentRequest) (*ReviewKycDocumentResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*ChangeUserStatusResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*ChangeUserStatusResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ChangeUserStatusResponse, error)
+	ListUserStatusChanges(context.Context, *ListUserStatusChangesRequest) (*ListUserStatusChangesResponse, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	GetUserDetails(context.Context, *GetUserDetailsRequest) (*GetUserDetailsResponse, error)
+	InviteUser(context.Context, *InviteUserRequest) (*User, error)
+	ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error)
+	ResolveFraudCase(context.Context, *ResolveFraudCaseRequest) (*ResolveFraudCaseResponse, error)
+	ListScreeningCases(context.Context, *ListScreeningCasesRequest) (*ListScreeningCasesResponse, error)
+	ResolveScreeningCase(context.Context, *ResolveScreeningCaseRequest) (*ResolveScreeningCaseResponse, error)
+	ListAmlReports(context.Context, *ListAmlReportsRequest) (*ListAmlReportsResponse, error)
+	GetAmlReport(context.Context, *GetAmlReportRequest) (*GetAmlReportResponse, error)
+	ExportAmlReports(context.Context, *ExportAmlReportsRequest) (*ExportAmlReportsResponse, error)
+	mustEmbedUnimplementedSimplebankServer()
+}
+
+// UnimplementedSimplebankServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSimplebankServer struct{}
+
+func (UnimplementedSimplebankServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSimplebankServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSimplebankServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedSimplebankServer) RenewAccess(context.Context, *RenewAccessRequest) (*RenewAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccess not implemented")
+}
+func (UnimplementedSimplebankServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedSimplebankServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedSimplebankServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedSimplebankServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedSimplebankServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedSimplebankServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedSimplebankServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedSimplebankServer) RequestMoney(context.Context, *RequestMoneyRequest) (*RequestMoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMoney not implemented")
+}
+func (UnimplementedSimplebankServer) GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*PaymentRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentRequest not implemented")
+}
+func (UnimplementedSimplebankServer) ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentRequests not implemented")
+}
+func (UnimplementedSimplebankServer) AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPaymentRequest not implemented")
+}
+func (UnimplementedSimplebankServer) DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*PaymentRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePaymentRequest not implemented")
+}
+func (UnimplementedSimplebankServer) SplitBill(context.Context, *SplitBillRequest) (*BillSplit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitBill not implemented")
+}
+func (UnimplementedSimplebankServer) GetBillSplit(context.Context, *GetBillSplitRequest) (*BillSplit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillSplit not implemented")
+}
+func (UnimplementedSimplebankServer) ListBillSplits(context.Context, *ListBillSplitsRequest) (*ListBillSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBillSplits not implemented")
+}
+func (UnimplementedSimplebankServer) RemindBillSplit(context.Context, *RemindBillSplitRequest) (*RemindBillSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemindBillSplit not implemented")
+}
+func (UnimplementedSimplebankServer) CreateMandate(context.Context, *CreateMandateRequest) (*Mandate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMandate not implemented")
+}
+func (UnimplementedSimplebankServer) ListMandates(context.Context, *ListMandatesRequest) (*ListMandatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMandates not implemented")
+}
+func (UnimplementedSimplebankServer) RevokeMandate(context.Context, *RevokeMandateRequest) (*Mandate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMandate not implemented")
+}
+func (UnimplementedSimplebankServer) CollectDirectDebit(context.Context, *CollectDirectDebitRequest) (*CollectDirectDebitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectDirectDebit not implemented")
+}
+func (UnimplementedSimplebankServer) ListDirectDebits(context.Context, *ListDirectDebitsRequest) (*ListDirectDebitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectDebits not implemented")
+}
+func (UnimplementedSimplebankServer) ChargebackDirectDebit(context.Context, *ChargebackDirectDebitRequest) (*ChargebackDirectDebitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargebackDirectDebit not implemented")
+}
+func (UnimplementedSimplebankServer) SetTransferCategory(context.Context, *SetTransferCategoryRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferCategory not implemented")
+}
+func (UnimplementedSimplebankServer) CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CategoryRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedSimplebankServer) ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryRules not implemented")
+}
+func (UnimplementedSimplebankServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedSimplebankServer) GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingSummary not implemented")
+}
+func (UnimplementedSimplebankServer) VerifyLoginMfa(context.Context, *VerifyLoginMfaRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginMfa not implemented")
+}
+func (UnimplementedSimplebankServer) EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedSimplebankServer) ConfirmTotp(context.Context, *ConfirmTotpRequest) (*ConfirmTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedSimplebankServer) DisableTotp(context.Context, *DisableTotpRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (UnimplementedSimplebankServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedSimplebankServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedSimplebankServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedSimplebankServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSimplebankServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSimplebankServer) RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeAllOtherSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedSimplebankServer) BlockUserSessions(context.Context, *BlockUserSessionsRequest) (*BlockUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUserSessions not implemented")
+}
+func (UnimplementedSimplebankServer) UnlockUser(context.Context, *UnlockUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedSimplebankServer) ListLoginAttempts(context.Context, *ListLoginAttemptsRequest) (*ListLoginAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginAttempts not implemented")
+}
+func (UnimplementedSimplebankServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedSimplebankServer) AssignUserRole(context.Context, *AssignUserRoleRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignUserRole not implemented")
+}
+func (UnimplementedSimplebankServer) CreateServiceClient(context.Context, *CreateServiceClientRequest) (*CreateServiceClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceClient not implemented")
+}
+func (UnimplementedSimplebankServer) ListServiceClients(context.Context, *ListServiceClientsRequest) (*ListServiceClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceClients not implemented")
+}
+func (UnimplementedSimplebankServer) RotateServiceClientKey(context.Context, *RotateServiceClientKeyRequest) (*RotateServiceClientKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceClientKey not implemented")
+}
+func (UnimplementedSimplebankServer) DisableServiceClient(context.Context, *DisableServiceClientRequest) (*ServiceClient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableServiceClient not implemented")
+}
+func (UnimplementedSimplebankServer) IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueClientToken not implemented")
+}
+func (UnimplementedSimplebankServer) ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedSimplebankServer) ListImpersonations(context.Context, *ListImpersonationsRequest) (*ListImpersonationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImpersonations not implemented")
+}
+func (UnimplementedSimplebankServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedSimplebankServer) ResendVerificationEmail(context.Context, *ResendVerificationEmailRequest) (*ResendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerificationEmail not implemented")
+}
+func (UnimplementedSimplebankServer) ExportMyData(context.Context, *ExportMyDataRequest) (*DataRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedSimplebankServer) RequestAccountErasure(context.Context, *RequestAccountErasureRequest) (*RequestAccountErasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountErasure not implemented")
+}
+func (UnimplementedSimplebankServer) UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*UploadKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadKycDocument not implemented")
+}
+func (UnimplementedSimplebankServer) ListKycDocuments(context.Context, *ListKycDocumentsRequest) (*ListKycDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKycDocuments not implemented")
+}
+func (UnimplementedSimplebankServer) ListPendingKycDocuments(context.Context, *ListPendingKycDocumentsRequest) (*ListPendingKycDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingKycDocuments not implemented")
+}
+func (UnimplementedSimplebankServer) DownloadKycDocument(context.Context, *DownloadKycDocumentRequest) (*DownloadKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadKycDocument not implemented")
+}
+func (UnimplementedSimplebankServer) ReviewKycDocument(context.Context, *ReviewKycDocumentRequest) (*ReviewKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewKycDocument not implemented")
+}
+func (UnimplementedSimplebankServer) SuspendUser(context.Context, *SuspendUserRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedSimplebankServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedSimplebankServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedSimplebankServer) ListUserStatusChanges(context.Context, *ListUserStatusChangesRequest) (*ListUserStatusChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserStatusChanges not implemented")
+}
+func (UnimplementedSimplebankServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedSimplebankServer) GetUserDetails(context.Context, *GetUserDetailsRequest) (*GetUserDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetails not implemented")
+}
+func (UnimplementedSimplebankServer) InviteUser(context.Context, *InviteUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (UnimplementedSimplebankServer) ListFraudCases(context.Context, *ListFraudCasesRequest) (*ListFraudCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudCases not implemented")
+}
+func (UnimplementedSimplebankServer) ResolveFraudCase(context.Context, *ResolveFraudCaseRequest) (*ResolveFraudCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFraudCase not implemented")
+}
+func (UnimplementedSimplebankServer) ListScreeningCases(context.Context, *ListScreeningCasesRequest) (*ListScreeningCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScreeningCases not implemented")
+}
+func (UnimplementedSimplebankServer) ResolveScreeningCase(context.Context, *ResolveScreeningCaseRequest) (*ResolveScreeningCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveScreeningCase not implemented")
+}
+func (UnimplementedSimplebankServer) ListAmlReports(context.Context, *ListAmlReportsRequest) (*ListAmlReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAmlReports not implemented")
+}
+func (UnimplementedSimplebankServer) GetAmlReport(context.Context, *GetAmlReportRequest) (*GetAmlReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAmlReport not implemented")
+}
+func (UnimplementedSimplebankServer) ExportAmlReports(context.Context, *ExportAmlReportsRequest) (*ExportAmlReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAmlReports not implemented")
+}
+func (UnimplementedSimplebankServer) mustEmbedUnimplementedSimplebankServer() {}
+func (UnimplementedSimplebankServer) testEmbeddedByValue()                    {}
+
+// UnsafeSimplebankServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SimplebankServer will
+// result in compilation errors.
+type UnsafeSimplebankServer interface {
 	mustEmbedUnimplementedSimplebankServer()
 }
 
-// UnimplementedSimplebankServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSimplebankServer struct{}
+func RegisterSimplebankServer(s grpc.ServiceRegistrar, srv SimplebankServer) {
+	// If the following call pancis, it indicates UnimplementedSimplebankServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Simplebank_ServiceDesc, srv)
+}
+
+func _Simplebank_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RenewAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RenewAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RenewAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RenewAccess(ctx, req.(*RenewAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RequestMoney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMoneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RequestMoney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RequestMoney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RequestMoney(ctx, req.(*RequestMoneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_GetPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).GetPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_GetPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).GetPaymentRequest(ctx, req.(*GetPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListPaymentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListPaymentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListPaymentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListPaymentRequests(ctx, req.(*ListPaymentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_AcceptPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).AcceptPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_AcceptPaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).AcceptPaymentRequest(ctx, req.(*AcceptPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_DeclinePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DeclinePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DeclinePaymentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DeclinePaymentRequest(ctx, req.(*DeclinePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_SplitBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitBillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).SplitBill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_SplitBill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).SplitBill(ctx, req.(*SplitBillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_GetBillSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).GetBillSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_GetBillSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).GetBillSplit(ctx, req.(*GetBillSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListBillSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBillSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListBillSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListBillSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListBillSplits(ctx, req.(*ListBillSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RemindBillSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemindBillSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RemindBillSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RemindBillSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RemindBillSplit(ctx, req.(*RemindBillSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_CreateMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMandateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateMandate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateMandate(ctx, req.(*CreateMandateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListMandates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMandatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListMandates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListMandates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListMandates(ctx, req.(*ListMandatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RevokeMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMandateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RevokeMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RevokeMandate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RevokeMandate(ctx, req.(*RevokeMandateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_CollectDirectDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectDirectDebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CollectDirectDebit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CollectDirectDebit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CollectDirectDebit(ctx, req.(*CollectDirectDebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListDirectDebits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectDebitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListDirectDebits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListDirectDebits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListDirectDebits(ctx, req.(*ListDirectDebitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ChargebackDirectDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargebackDirectDebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ChargebackDirectDebit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ChargebackDirectDebit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ChargebackDirectDebit(ctx, req.(*ChargebackDirectDebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_SetTransferCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).SetTransferCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_SetTransferCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).SetTransferCategory(ctx, req.(*SetTransferCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListCategoryRules(ctx, req.(*ListCategoryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_GetSpendingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).GetSpendingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_GetSpendingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).GetSpendingSummary(ctx, req.(*GetSpendingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_VerifyLoginMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).VerifyLoginMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_VerifyLoginMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).VerifyLoginMfa(ctx, req.(*VerifyLoginMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_EnrollTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).EnrollTotp(ctx, req.(*EnrollTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ConfirmTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ConfirmTotp(ctx, req.(*ConfirmTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DisableTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DisableTotp(ctx, req.(*DisableTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_BlockUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).BlockUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_BlockUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).BlockUserSessions(ctx, req.(*BlockUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedSimplebankServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _Simplebank_ListLoginAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListLoginAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListLoginAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListLoginAttempts(ctx, req.(*ListLoginAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _Simplebank_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _Simplebank_AssignUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).AssignUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_AssignUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).AssignUserRole(ctx, req.(*AssignUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) RenewAccess(context.Context, *RenewAccessRequest) (*RenewAccessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewAccess not implemented")
+
+func _Simplebank_CreateServiceClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).CreateServiceClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_CreateServiceClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).CreateServiceClient(ctx, req.(*CreateServiceClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+
+func _Simplebank_ListServiceClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListServiceClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListServiceClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListServiceClients(ctx, req.(*ListServiceClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+
+func _Simplebank_RotateServiceClientKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceClientKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RotateServiceClientKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RotateServiceClientKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RotateServiceClientKey(ctx, req.(*RotateServiceClientKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+
+func _Simplebank_DisableServiceClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableServiceClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DisableServiceClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DisableServiceClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DisableServiceClient(ctx, req.(*DisableServiceClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+
+func _Simplebank_IssueClientToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueClientTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).IssueClientToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_IssueClientToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).IssueClientToken(ctx, req.(*IssueClientTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+
+func _Simplebank_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListImpersonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImpersonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListImpersonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListImpersonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListImpersonations(ctx, req.(*ListImpersonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ResendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ResendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ResendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ResendVerificationEmail(ctx, req.(*ResendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_RequestAccountErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).RequestAccountErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_RequestAccountErasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).RequestAccountErasure(ctx, req.(*RequestAccountErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_UploadKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).UploadKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_UploadKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).UploadKycDocument(ctx, req.(*UploadKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListKycDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKycDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListKycDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListKycDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListKycDocuments(ctx, req.(*ListKycDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_ListPendingKycDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingKycDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ListPendingKycDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ListPendingKycDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ListPendingKycDocuments(ctx, req.(*ListPendingKycDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simplebank_DownloadKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DownloadKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DownloadKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DownloadKycDocument(ctx, req.(*DownloadKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+
+func _Simplebank_ReviewKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).ReviewKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ReviewKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ReviewKycDocument(ctx, req.(*ReviewKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+
+func _Simplebank_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSimplebankServer) mustEmbedUnimplementedSimplebankServer() {}
-func (UnimplementedSimplebankServer) testEmbeddedByValue()                    {}
 
-// UnsafeSimplebankServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SimplebankServer will
-// result in compilation errors.
-type UnsafeSimplebankServer interface {
-	mustEmbedUnimplementedSimplebankServer()
+func _Simplebank_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimplebankServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSimplebankServer(s grpc.ServiceRegistrar, srv SimplebankServer) {
-	// If the following call pancis, it indicates UnimplementedSimplebankServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Simplebank_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Simplebank_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SimplebankServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simplebank_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimplebankServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Simplebank_ListUserStatusChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserStatusChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).CreateUser(ctx, in)
+		return srv.(SimplebankServer).ListUserStatusChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_CreateUser_FullMethodName,
+		FullMethod: Simplebank_ListUserStatusChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(SimplebankServer).ListUserStatusChanges(ctx, req.(*ListUserStatusChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Simplebank_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).UpdateUser(ctx, in)
+		return srv.(SimplebankServer).SearchUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_UpdateUser_FullMethodName,
+		FullMethod: Simplebank_SearchUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(SimplebankServer).SearchUsers(ctx, req.(*SearchUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _Simplebank_GetUserDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).LoginUser(ctx, in)
+		return srv.(SimplebankServer).GetUserDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_LoginUser_FullMethodName,
+		FullMethod: Simplebank_GetUserDetails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(SimplebankServer).GetUserDetails(ctx, req.(*GetUserDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_RenewAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewAccessRequest)
+func _Simplebank_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).RenewAccess(ctx, in)
+		return srv.(SimplebankServer).InviteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_RenewAccess_FullMethodName,
+		FullMethod: Simplebank_InviteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).RenewAccess(ctx, req.(*RenewAccessRequest))
+		return srv.(SimplebankServer).InviteUser(ctx, req.(*InviteUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyEmailRequest)
+func _Simplebank_ListFraudCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudCasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).VerifyEmail(ctx, in)
+		return srv.(SimplebankServer).ListFraudCases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_VerifyEmail_FullMethodName,
+		FullMethod: Simplebank_ListFraudCases_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		return srv.(SimplebankServer).ListFraudCases(ctx, req.(*ListFraudCasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _Simplebank_ResolveFraudCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFraudCaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).CreateAccount(ctx, in)
+		return srv.(SimplebankServer).ResolveFraudCase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_CreateAccount_FullMethodName,
+		FullMethod: Simplebank_ResolveFraudCase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(SimplebankServer).ResolveFraudCase(ctx, req.(*ResolveFraudCaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransfersRequest)
+func _Simplebank_ListScreeningCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScreeningCasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).ListTransfers(ctx, in)
+		return srv.(SimplebankServer).ListScreeningCases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_ListTransfers_FullMethodName,
+		FullMethod: Simplebank_ListScreeningCases_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+		return srv.(SimplebankServer).ListScreeningCases(ctx, req.(*ListScreeningCasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransferRequest)
+func _Simplebank_ResolveScreeningCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveScreeningCaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).CreateTransfer(ctx, in)
+		return srv.(SimplebankServer).ResolveScreeningCase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_CreateTransfer_FullMethodName,
+		FullMethod: Simplebank_ResolveScreeningCase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+		return srv.(SimplebankServer).ResolveScreeningCase(ctx, req.(*ResolveScreeningCaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
+func _Simplebank_ListAmlReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAmlReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).ListAccounts(ctx, in)
+		return srv.(SimplebankServer).ListAmlReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_ListAccounts_FullMethodName,
+		FullMethod: Simplebank_ListAmlReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+		return srv.(SimplebankServer).ListAmlReports(ctx, req.(*ListAmlReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _Simplebank_GetAmlReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAmlReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).GetAccount(ctx, in)
+		return srv.(SimplebankServer).GetAmlReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_GetAccount_FullMethodName,
+		FullMethod: Simplebank_GetAmlReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(SimplebankServer).GetAmlReport(ctx, req.(*GetAmlReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Simplebank_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccountRequest)
+func _Simplebank_ExportAmlReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAmlReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SimplebankServer).DeleteAccount(ctx, in)
+		return srv.(SimplebankServer).ExportAmlReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Simplebank_DeleteAccount_FullMethodName,
+		FullMethod: Simplebank_ExportAmlReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SimplebankServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+		return srv.(SimplebankServer).ExportAmlReports(ctx, req.(*ExportAmlReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -496,6 +2706,266 @@ var Simplebank_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _Simplebank_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "RequestMoney",
+			Handler:    _Simplebank_RequestMoney_Handler,
+		},
+		{
+			MethodName: "GetPaymentRequest",
+			Handler:    _Simplebank_GetPaymentRequest_Handler,
+		},
+		{
+			MethodName: "ListPaymentRequests",
+			Handler:    _Simplebank_ListPaymentRequests_Handler,
+		},
+		{
+			MethodName: "AcceptPaymentRequest",
+			Handler:    _Simplebank_AcceptPaymentRequest_Handler,
+		},
+		{
+			MethodName: "DeclinePaymentRequest",
+			Handler:    _Simplebank_DeclinePaymentRequest_Handler,
+		},
+		{
+			MethodName: "SplitBill",
+			Handler:    _Simplebank_SplitBill_Handler,
+		},
+		{
+			MethodName: "GetBillSplit",
+			Handler:    _Simplebank_GetBillSplit_Handler,
+		},
+		{
+			MethodName: "ListBillSplits",
+			Handler:    _Simplebank_ListBillSplits_Handler,
+		},
+		{
+			MethodName: "RemindBillSplit",
+			Handler:    _Simplebank_RemindBillSplit_Handler,
+		},
+		{
+			MethodName: "CreateMandate",
+			Handler:    _Simplebank_CreateMandate_Handler,
+		},
+		{
+			MethodName: "ListMandates",
+			Handler:    _Simplebank_ListMandates_Handler,
+		},
+		{
+			MethodName: "RevokeMandate",
+			Handler:    _Simplebank_RevokeMandate_Handler,
+		},
+		{
+			MethodName: "CollectDirectDebit",
+			Handler:    _Simplebank_CollectDirectDebit_Handler,
+		},
+		{
+			MethodName: "ListDirectDebits",
+			Handler:    _Simplebank_ListDirectDebits_Handler,
+		},
+		{
+			MethodName: "ChargebackDirectDebit",
+			Handler:    _Simplebank_ChargebackDirectDebit_Handler,
+		},
+		{
+			MethodName: "SetTransferCategory",
+			Handler:    _Simplebank_SetTransferCategory_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _Simplebank_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "ListCategoryRules",
+			Handler:    _Simplebank_ListCategoryRules_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _Simplebank_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetSpendingSummary",
+			Handler:    _Simplebank_GetSpendingSummary_Handler,
+		},
+		{
+			MethodName: "VerifyLoginMfa",
+			Handler:    _Simplebank_VerifyLoginMfa_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _Simplebank_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _Simplebank_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _Simplebank_DisableTotp_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Simplebank_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Simplebank_ResetPassword_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Simplebank_Logout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Simplebank_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Simplebank_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _Simplebank_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "BlockUserSessions",
+			Handler:    _Simplebank_BlockUserSessions_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _Simplebank_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ListLoginAttempts",
+			Handler:    _Simplebank_ListLoginAttempts_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _Simplebank_ListRoles_Handler,
+		},
+		{
+			MethodName: "AssignUserRole",
+			Handler:    _Simplebank_AssignUserRole_Handler,
+		},
+		{
+			MethodName: "CreateServiceClient",
+			Handler:    _Simplebank_CreateServiceClient_Handler,
+		},
+		{
+			MethodName: "ListServiceClients",
+			Handler:    _Simplebank_ListServiceClients_Handler,
+		},
+		{
+			MethodName: "RotateServiceClientKey",
+			Handler:    _Simplebank_RotateServiceClientKey_Handler,
+		},
+		{
+			MethodName: "DisableServiceClient",
+			Handler:    _Simplebank_DisableServiceClient_Handler,
+		},
+		{
+			MethodName: "IssueClientToken",
+			Handler:    _Simplebank_IssueClientToken_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _Simplebank_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "ListImpersonations",
+			Handler:    _Simplebank_ListImpersonations_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Simplebank_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ResendVerificationEmail",
+			Handler:    _Simplebank_ResendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _Simplebank_ExportMyData_Handler,
+		},
+		{
+			MethodName: "RequestAccountErasure",
+			Handler:    _Simplebank_RequestAccountErasure_Handler,
+		},
+		{
+			MethodName: "UploadKycDocument",
+			Handler:    _Simplebank_UploadKycDocument_Handler,
+		},
+		{
+			MethodName: "ListKycDocuments",
+			Handler:    _Simplebank_ListKycDocuments_Handler,
+		},
+		{
+			MethodName: "ListPendingKycDocuments",
+			Handler:    _Simplebank_ListPendingKycDocuments_Handler,
+		},
+		{
+			MethodName: "DownloadKycDocument",
+			Handler:    _Simplebank_DownloadKycDocument_Handler,
+		},
+		{
+			MethodName: "ReviewKycDocument",
+			Handler:    _Simplebank_ReviewKycDocument_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _Simplebank_SuspendUser_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _Simplebank_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _Simplebank_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "ListUserStatusChanges",
+			Handler:    _Simplebank_ListUserStatusChanges_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _Simplebank_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetUserDetails",
+			Handler:    _Simplebank_GetUserDetails_Handler,
+		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _Simplebank_InviteUser_Handler,
+		},
+		{
+			MethodName: "ListFraudCases",
+			Handler:    _Simplebank_ListFraudCases_Handler,
+		},
+		{
+			MethodName: "ResolveFraudCase",
+			Handler:    _Simplebank_ResolveFraudCase_Handler,
+		},
+		{
+			MethodName: "ListScreeningCases",
+			Handler:    _Simplebank_ListScreeningCases_Handler,
+		},
+		{
+			MethodName: "ResolveScreeningCase",
+			Handler:    _Simplebank_ResolveScreeningCase_Handler,
+		},
+		{
+			MethodName: "ListAmlReports",
+			Handler:    _Simplebank_ListAmlReports_Handler,
+		},
+		{
+			MethodName: "GetAmlReport",
+			Handler:    _Simplebank_GetAmlReport_Handler,
+		},
+		{
+			MethodName: "ExportAmlReports",
+			Handler:    _Simplebank_ExportAmlReports_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_simplebank.proto",
@@ -0,0 +1,3 @@
+// Package screening matches names against a sanctions watchlist, such as the SDN list published by OFAC,
+// using normalized names and Jaro-Winkler similarity so that spelling variants and reordered names still match.
+package screening
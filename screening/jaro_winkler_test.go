@@ -0,0 +1,39 @@
+package screening
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJaroWinkler(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"martha", "marhta", 0.9611},
+		{"dwayne", "duane", 0.84},
+		{"dixon", "dicksonx", 0.8133},
+		{"same", "same", 1},
+		{"", "", 1},
+		{"abc", "", 0},
+		{"abc", "xyz", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			require.InDelta(t, tc.expected, jaroWinkler(tc.a, tc.b), 0.0001)
+			require.InDelta(t, tc.expected, jaroWinkler(tc.b, tc.a), 0.0001)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "muller jorg peter", normalize("MÜLLER, Jörg-Peter"))
+	require.Equal(t, "al assad bashar", normalize("  AL-ASSAD,  Bashar "))
+	require.Equal(t, "", normalize(" .,- "))
+}
+
+func TestSimilarityIgnoresWordOrder(t *testing.T) {
+	require.InDelta(t, 1, similarity(normalize("BOUT, Viktor"), normalize("Viktor Bout")), 0.0001)
+}
@@ -0,0 +1,41 @@
+package screening
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalize lowercases a name, strips accents and replaces punctuation with spaces, so that "MÜLLER, Jörg-Peter"
+// and "muller jorg peter" compare equal.
+func normalize(name string) string {
+	var builder strings.Builder
+
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// accents are separate marks after decomposition
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(unicode.ToLower(r))
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// sortTokens orders the words of a normalized name, so that "LAST, First" lists match "First Last" names.
+func sortTokens(name string) string {
+	tokens := strings.Fields(name)
+	sort.Strings(tokens)
+
+	return strings.Join(tokens, " ")
+}
+
+// similarity compares two normalized names in the order given and with their words sorted, whichever is closer.
+func similarity(a, b string) float64 {
+	return max(jaroWinkler(a, b), jaroWinkler(sortTokens(a), sortTokens(b)))
+}
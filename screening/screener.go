@@ -0,0 +1,119 @@
+package screening
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultThreshold is the similarity from which a name is considered a match. It lets through spelling variants
+// like "Mohammed" for "Muhammad" while keeping unrelated names that merely share some letters out.
+const DefaultThreshold = 0.92
+
+// Match is a watchlist entry a screened name is similar to.
+type Match struct {
+	EntryID string
+	// Name is the name or alias of the entry that matched
+	Name     string
+	Programs []string
+	Score    float64
+}
+
+type candidate struct {
+	entry *Entry
+	name  string
+	// normalized is the name as it is compared
+	normalized string
+}
+
+// Screener matches names against a watchlist.
+type Screener struct {
+	candidates []candidate
+	threshold  float64
+}
+
+// NewScreener returns a screener for the entries, matching names at least as similar as the threshold.
+// DefaultThreshold is used when the threshold is not between 0 and 1.
+func NewScreener(entries []Entry, threshold float64) *Screener {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultThreshold
+	}
+
+	screener := &Screener{threshold: threshold}
+
+	for i := range entries {
+		entry := &entries[i]
+
+		for _, name := range append([]string{entry.Name}, entry.Aliases...) {
+			normalized := normalize(name)
+			if normalized == "" {
+				continue
+			}
+
+			screener.candidates = append(screener.candidates, candidate{entry: entry, name: name, normalized: normalized})
+		}
+	}
+
+	return screener
+}
+
+// LoadScreener reads the watchlist at path, nothing is matched when path is empty.
+func LoadScreener(path string, threshold float64) (*Screener, error) {
+	if path == "" {
+		return NewScreener(nil, threshold), nil
+	}
+
+	entries, err := LoadWatchlist(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewScreener(entries, threshold), nil
+}
+
+// Screen returns the entries matching the name, best match first. Every entry is reported once,
+// with the best scoring of its names.
+func (screener *Screener) Screen(name string) []Match {
+	normalized := normalize(name)
+	if normalized == "" {
+		return nil
+	}
+
+	best := make(map[string]Match)
+
+	for _, c := range screener.candidates {
+		score := similarity(normalized, c.normalized)
+		if score < screener.threshold {
+			continue
+		}
+
+		if match, ok := best[c.entry.ID]; ok && match.Score >= score {
+			continue
+		}
+
+		best[c.entry.ID] = Match{
+			EntryID:  c.entry.ID,
+			Name:     c.name,
+			Programs: c.entry.Programs,
+			Score:    score,
+		}
+	}
+
+	if len(best) == 0 {
+		return nil
+	}
+
+	matches := make([]Match, 0, len(best))
+	for _, match := range best {
+		matches = append(matches, match)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+
+		return strings.Compare(matches[i].EntryID, matches[j].EntryID) < 0
+	})
+
+	return matches
+}
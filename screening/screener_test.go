@@ -0,0 +1,110 @@
+package screening
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCSV = `ent_num,SDN_Name,SDN_Type,Program,Title,Call_Sign,Vess_type,Tonnage,GRT,Vess_flag,Vess_owner,Remarks
+7157,"BOUT, Viktor Anatolijevitch","individual","SDGT] [RUSSIA-EO14024",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"DOB 13 Jan 1967."
+36,"AEROCARIBBEAN AIRLINES",-0- ,"CUBA",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"Havana, Cuba."
+` + "\x1a\n"
+
+const testXML = `<?xml version="1.0" standalone="yes"?>
+<sdnList xmlns="https://sanctionslistservice.ofac.treas.gov/api/PublicationPreview/exports/XML">
+  <publshInformation><Publish_Date>10/01/2026</Publish_Date></publshInformation>
+  <sdnEntry>
+    <uid>7157</uid>
+    <firstName>Viktor Anatolijevitch</firstName>
+    <lastName>BOUT</lastName>
+    <sdnType>Individual</sdnType>
+    <programList><program>SDGT</program><program>RUSSIA-EO14024</program></programList>
+    <akaList>
+      <aka><uid>7191</uid><type>a.k.a.</type><category>strong</category><firstName>Victor</firstName><lastName>BUTT</lastName></aka>
+    </akaList>
+  </sdnEntry>
+</sdnList>`
+
+func TestParseCSV(t *testing.T) {
+	entries, err := ParseCSV(strings.NewReader(testCSV))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	require.Equal(t, "7157", entries[0].ID)
+	require.Equal(t, "BOUT, Viktor Anatolijevitch", entries[0].Name)
+	require.Equal(t, "individual", entries[0].Type)
+	require.Equal(t, []string{"SDGT", "RUSSIA-EO14024"}, entries[0].Programs)
+
+	require.Equal(t, "", entries[1].Type)
+	require.Equal(t, []string{"CUBA"}, entries[1].Programs)
+
+	_, err = ParseCSV(strings.NewReader("7157,\"BOUT, Viktor\"\nabc,\"BUTT, Victor\"\n"))
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestParseXML(t *testing.T) {
+	entries, err := ParseXML(strings.NewReader(testXML))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.Equal(t, "7157", entries[0].ID)
+	require.Equal(t, "Viktor Anatolijevitch BOUT", entries[0].Name)
+	require.Equal(t, []string{"SDGT", "RUSSIA-EO14024"}, entries[0].Programs)
+	require.Equal(t, []string{"Victor BUTT"}, entries[0].Aliases)
+}
+
+func TestLoadScreener(t *testing.T) {
+	dir := t.TempDir()
+
+	csvPath := filepath.Join(dir, "sdn.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte(testCSV), 0o600))
+
+	screener, err := LoadScreener(csvPath, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, screener.Screen("Viktor Anatolijevitch Bout"))
+
+	_, err = LoadScreener(filepath.Join(dir, "sdn.txt"), 0)
+	require.Error(t, err)
+
+	screener, err = LoadScreener("", 0)
+	require.NoError(t, err)
+	require.Nil(t, screener.Screen("Viktor Anatolijevitch Bout"))
+}
+
+func TestScreen(t *testing.T) {
+	entries, err := ParseXML(strings.NewReader(testXML))
+	require.NoError(t, err)
+
+	screener := NewScreener(entries, 0)
+
+	testCases := []struct {
+		name    string
+		matches bool
+	}{
+		{"Viktor Anatolijevitch Bout", true},
+		{"BOUT, Viktor Anatolijevitch", true},
+		{"Viktor Anatolievich Bout", true},
+		{"Victor Butt", true},
+		{"Viktor Bout", false},
+		{"Jane Smith", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := screener.Screen(tc.name)
+			if !tc.matches {
+				require.Empty(t, matches)
+				return
+			}
+
+			require.Len(t, matches, 1)
+			require.Equal(t, "7157", matches[0].EntryID)
+			require.GreaterOrEqual(t, matches[0].Score, DefaultThreshold)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package screening
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Entry is a sanctioned person or organisation on the watchlist.
+type Entry struct {
+	ID       string
+	Name     string
+	Type     string
+	Programs []string
+	// Aliases are other names the entry is known by, they are matched like the name
+	Aliases []string
+}
+
+// ofacEmpty marks empty fields in the OFAC CSV files.
+const ofacEmpty = "-0-"
+
+// LoadWatchlist reads the entries from a file in the format of the OFAC SDN list, either sdn.xml or sdn.csv
+// depending on the extension of the file.
+func LoadWatchlist(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open watchlist: %w", err)
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xml":
+		return ParseXML(file)
+	case ".csv":
+		return ParseCSV(file)
+	default:
+		return nil, fmt.Errorf("unsupported watchlist format %q, expected .csv or .xml", filepath.Ext(path))
+	}
+}
+
+// ParseCSV reads entries laid out like sdn.csv: the entry number, name, type and programs, followed by columns
+// that are ignored. A header row is skipped.
+func ParseCSV(reader io.Reader) ([]Entry, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+
+	var entries []Entry
+
+	for line := 1; ; line++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read watchlist: %w", err)
+		}
+
+		if len(record) < 2 {
+			// sdn.csv ends with a control character on a line of its own
+			continue
+		}
+
+		id := strings.TrimSpace(record[0])
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid entry number %q", line, id)
+		}
+
+		entry := Entry{
+			ID:   id,
+			Name: strings.TrimSpace(record[1]),
+		}
+
+		if len(record) > 2 {
+			entry.Type = ofacField(record[2])
+		}
+
+		if len(record) > 3 {
+			for _, program := range strings.FieldsFunc(ofacField(record[3]), isProgramSeparator) {
+				if program = strings.TrimSpace(program); program != "" {
+					entry.Programs = append(entry.Programs, program)
+				}
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// isProgramSeparator splits program lists, which sdn.csv writes as "SDGT] [RUSSIA-EO14024".
+func isProgramSeparator(r rune) bool {
+	return r == '[' || r == ']' || r == ';'
+}
+
+func ofacField(field string) string {
+	field = strings.TrimSpace(field)
+	if field == ofacEmpty {
+		return ""
+	}
+
+	return field
+}
+
+type sdnList struct {
+	Entries []sdnEntry `xml:"sdnEntry"`
+}
+
+type sdnEntry struct {
+	UID       string   `xml:"uid"`
+	FirstName string   `xml:"firstName"`
+	LastName  string   `xml:"lastName"`
+	SdnType   string   `xml:"sdnType"`
+	Programs  []string `xml:"programList>program"`
+	Akas      []sdnAka `xml:"akaList>aka"`
+}
+
+type sdnAka struct {
+	FirstName string `xml:"firstName"`
+	LastName  string `xml:"lastName"`
+}
+
+// ParseXML reads entries from a document laid out like sdn.xml, including their a.k.a. names.
+func ParseXML(reader io.Reader) ([]Entry, error) {
+	var list sdnList
+
+	err := xml.NewDecoder(reader).Decode(&list)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read watchlist: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(list.Entries))
+	for _, sdn := range list.Entries {
+		entry := Entry{
+			ID:       strings.TrimSpace(sdn.UID),
+			Name:     fullName(sdn.FirstName, sdn.LastName),
+			Type:     strings.TrimSpace(sdn.SdnType),
+			Programs: sdn.Programs,
+		}
+
+		for _, aka := range sdn.Akas {
+			entry.Aliases = append(entry.Aliases, fullName(aka.FirstName, aka.LastName))
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func fullName(firstName, lastName string) string {
+	return strings.TrimSpace(strings.TrimSpace(firstName) + " " + strings.TrimSpace(lastName))
+}
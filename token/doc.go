@@ -1,10 +1,20 @@
 // Package token provides functionality for creating and verifying security tokens.
 //
 // It defines a Maker interface for token management and provides two
-// concrete implementations:
+// symmetric implementations:
 //
 // - JWTMaker: Uses JSON Web Tokens (JWT) for session management (unused).
 //
 // - PasetoMaker: Uses Platform-Agnostic Security Tokens (PASETO), which is
-// more secure than JWT by default.
+// more secure than JWT by default. Used when no signing key is configured.
+//
+// and two asymmetric ones signing with the Ed25519 keys of a Keyring, which
+// supports key rotation and lets other services verify tokens with public keys:
+//
+// - PasetoPublicMaker: PASETO v4.public tokens with the key id in the footer.
+//
+// - JWTPublicMaker: EdDSA signed JWTs with the key id in the header.
+//
+// It also provides a Signer for HMAC signed tokens that reference a single
+// resource, such as shareable payment links.
 package token
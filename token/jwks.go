@@ -0,0 +1,39 @@
+package token
+
+import "encoding/base64"
+
+// JSONWebKey is an Ed25519 public key in the JSON Web Key format of RFC 8037.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JSONWebKeySet returns the public keys of the keyring, so other services can verify tokens without sharing secrets.
+func (keyring *Keyring) JSONWebKeySet() JSONWebKeySet {
+	publicKeys := keyring.PublicKeys()
+
+	keySet := JSONWebKeySet{
+		Keys: make([]JSONWebKey, len(publicKeys)),
+	}
+
+	for i, key := range publicKeys {
+		keySet.Keys[i] = JSONWebKey{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key.PublicKey),
+			KeyID:     key.ID,
+			Use:       "sig",
+			Algorithm: "EdDSA",
+		}
+	}
+
+	return keySet
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -21,18 +22,23 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 	return &JWTMaker{secretKey}, nil
 }
 
-func (maker *JWTMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, role, duration)
+func (maker *JWTMaker) CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration)
 	if err != nil {
 		return "", payload, err
 	}
 
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, NewJWTPayloadClaims(payload))
-	token, err := jwtToken.SignedString([]byte(maker.secretKey))
+	token, err := maker.IssueToken(payload)
 
 	return token, payload, err
 }
 
+func (maker *JWTMaker) IssueToken(payload *Payload) (string, error) {
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, NewJWTPayloadClaims(payload))
+
+	return jwtToken.SignedString([]byte(maker.secretKey))
+}
+
 func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 	jwtClaims := &JWTPayloadClaims{}
 
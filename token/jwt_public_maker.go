@@ -0,0 +1,74 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// JWTPublicMaker signs EdDSA JSON Web Tokens with the Ed25519 signing key of a keyring,
+// with the key id in the kid header.
+type JWTPublicMaker struct {
+	keyring *Keyring
+}
+
+func NewJWTPublicMaker(keyring *Keyring) (Maker, error) {
+	if keyring == nil {
+		return nil, fmt.Errorf("keyring is required")
+	}
+
+	return &JWTPublicMaker{keyring}, nil
+}
+
+func (maker *JWTPublicMaker) CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.IssueToken(payload)
+
+	return token, payload, err
+}
+
+func (maker *JWTPublicMaker) IssueToken(payload *Payload) (string, error) {
+	key, err := maker.keyring.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, NewJWTPayloadClaims(payload))
+	jwtToken.Header["kid"] = key.ID
+
+	return jwtToken.SignedString(key.PrivateKey)
+}
+
+func (maker *JWTPublicMaker) VerifyToken(token string) (*Payload, error) {
+	jwtClaims := &JWTPayloadClaims{}
+
+	_, err := jwt.ParseWithClaims(token, jwtClaims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, ErrInvalidToken
+		}
+
+		keyID, _ := token.Header["kid"].(string)
+		publicKey, ok := maker.keyring.VerificationKey(keyID)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+
+		return publicKey, nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+
+		return nil, ErrInvalidToken
+	}
+
+	return &jwtClaims.Payload, nil
+}
@@ -0,0 +1,95 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func TestJWTPublicMaker(t *testing.T) {
+	keyring, key := newTestKeyring(t, "current")
+	maker, err := NewJWTPublicMaker(keyring)
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := util.DepositorRole
+	sessionID := uuid.New()
+
+	token, payload, err := maker.CreateToken(username, role, sessionID, time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &JWTPayloadClaims{})
+	require.NoError(t, err)
+	require.Equal(t, key.ID, parsed.Header["kid"])
+	require.Equal(t, "EdDSA", parsed.Header["alg"])
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, role, payload.Role)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, sessionID, payload.SessionID)
+}
+
+func TestExpiredJWTPublicToken(t *testing.T) {
+	keyring, _ := newTestKeyring(t, "current")
+	maker, err := NewJWTPublicMaker(keyring)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidJWTPublicToken(t *testing.T) {
+	keyring, key := newTestKeyring(t, "current")
+	maker, err := NewJWTPublicMaker(keyring)
+	require.NoError(t, err)
+
+	payload, err := NewPayload(util.RandomOwner(), util.BankerRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	// an HMAC token keyed with the public key must not pass as signed by the private key
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, NewJWTPayloadClaims(payload))
+	hmacToken.Header["kid"] = key.ID
+	confusedToken, err := hmacToken.SignedString([]byte(key.PublicKey))
+	require.NoError(t, err)
+
+	unknownKey, err := GenerateKey("unknown")
+	require.NoError(t, err)
+	unknownToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, NewJWTPayloadClaims(payload))
+	unknownToken.Header["kid"] = unknownKey.ID
+	signedByUnknownKey, err := unknownToken.SignedString(unknownKey.PrivateKey)
+	require.NoError(t, err)
+
+	for _, invalid := range []string{util.RandomString(6), confusedToken, signedByUnknownKey} {
+		payload, err := maker.VerifyToken(invalid)
+		require.EqualError(t, err, ErrInvalidToken.Error())
+		require.Nil(t, payload)
+	}
+}
+
+func TestJWTPublicClientToken(t *testing.T) {
+	keyring, _ := newTestKeyring(t, "current")
+	maker, err := NewJWTPublicMaker(keyring)
+	require.NoError(t, err)
+
+	clientID := uuid.NewString()
+	payload, err := NewClientPayload(clientID, util.RandomOwner(), util.DepositorRole, []string{"accounts:read"}, time.Minute)
+	require.NoError(t, err)
+
+	token, err := maker.IssueToken(payload)
+	require.NoError(t, err)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, clientID, verified.ClientID)
+	require.Equal(t, []string{"accounts:read"}, verified.Scopes)
+}
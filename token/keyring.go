@@ -0,0 +1,156 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrNoSigningKey = errors.New("keyring has no signing key")
+
+// Key is an Ed25519 key identified by its key id. Keys that are only used to verify tokens have no private key.
+type Key struct {
+	ID         string
+	PublicKey  ed25519.PublicKey
+	PrivateKey ed25519.PrivateKey
+}
+
+// GenerateKey returns a new signing key with the key id.
+func GenerateKey(id string) (Key, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return Key{}, err
+	}
+
+	return Key{ID: id, PublicKey: publicKey, PrivateKey: privateKey}, nil
+}
+
+// Keyring holds the key new tokens are signed with and every key tokens are accepted from, so keys can be rotated
+// without logging everyone out: the next key is first added for verification everywhere, then made the signing key,
+// and the previous one is removed once the tokens it signed have expired. Verifying services only need the public keys.
+type Keyring struct {
+	signingKey *Key
+	keys       map[string]Key
+}
+
+// NewKeyring returns a keyring signing with the signing key, if any, and verifying tokens of the signing key and the verification keys.
+func NewKeyring(signingKey *Key, verificationKeys ...Key) (*Keyring, error) {
+	keyring := &Keyring{
+		keys: make(map[string]Key),
+	}
+
+	if signingKey != nil {
+		if signingKey.PrivateKey == nil {
+			return nil, fmt.Errorf("signing key %s has no private key", signingKey.ID)
+		}
+
+		verificationKeys = append([]Key{*signingKey}, verificationKeys...)
+		keyring.signingKey = signingKey
+	}
+
+	for _, key := range verificationKeys {
+		if key.ID == "" || strings.ContainsAny(key.ID, ":,") {
+			return nil, fmt.Errorf("invalid key id %q", key.ID)
+		}
+
+		if len(key.PublicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key size of key %s", key.ID)
+		}
+
+		if existing, ok := keyring.keys[key.ID]; ok && !existing.PublicKey.Equal(key.PublicKey) {
+			return nil, fmt.Errorf("key id %s is used by different keys", key.ID)
+		}
+
+		keyring.keys[key.ID] = Key{ID: key.ID, PublicKey: key.PublicKey}
+	}
+
+	if len(keyring.keys) == 0 {
+		return nil, fmt.Errorf("keyring has no keys")
+	}
+
+	return keyring, nil
+}
+
+// ParseKeyring reads a keyring from configuration. The signing key is formatted as "<key id>:<base64 Ed25519 seed>"
+// and can be empty for services that only verify tokens, verification keys as "<key id>:<base64 public key>".
+func ParseKeyring(signingKey string, verificationKeys []string) (*Keyring, error) {
+	var key *Key
+
+	if signingKey != "" {
+		id, seed, err := parseKey(signingKey, ed25519.SeedSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key: %w", err)
+		}
+
+		privateKey := ed25519.NewKeyFromSeed(seed)
+		key = &Key{ID: id, PublicKey: privateKey.Public().(ed25519.PublicKey), PrivateKey: privateKey}
+	}
+
+	keys := make([]Key, 0, len(verificationKeys))
+	for _, verificationKey := range verificationKeys {
+		if strings.TrimSpace(verificationKey) == "" {
+			continue
+		}
+
+		id, publicKey, err := parseKey(verificationKey, ed25519.PublicKeySize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification key: %w", err)
+		}
+
+		keys = append(keys, Key{ID: id, PublicKey: publicKey})
+	}
+
+	return NewKeyring(key, keys...)
+}
+
+func parseKey(value string, size int) (string, []byte, error) {
+	id, encodedKey, found := strings.Cut(strings.TrimSpace(value), ":")
+	if !found {
+		return "", nil, fmt.Errorf("must be formatted as <key id>:<base64 key>")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("key %s is not base64: %w", id, err)
+	}
+
+	if len(key) != size {
+		return "", nil, fmt.Errorf("key %s must be %d bytes", id, size)
+	}
+
+	return id, key, nil
+}
+
+// SigningKey returns the key new tokens are signed with.
+func (keyring *Keyring) SigningKey() (Key, error) {
+	if keyring.signingKey == nil {
+		return Key{}, ErrNoSigningKey
+	}
+
+	return *keyring.signingKey, nil
+}
+
+// VerificationKey returns the public key with the key id.
+func (keyring *Keyring) VerificationKey(id string) (ed25519.PublicKey, bool) {
+	key, ok := keyring.keys[id]
+
+	return key.PublicKey, ok
+}
+
+// PublicKeys returns every key tokens are accepted from, without private keys, ordered by key id.
+func (keyring *Keyring) PublicKeys() []Key {
+	keys := make([]Key, 0, len(keyring.keys))
+	for _, key := range keyring.keys {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	return keys
+}
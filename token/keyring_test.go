@@ -0,0 +1,94 @@
+package token
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyring(t *testing.T) {
+	current, err := GenerateKey("2026-10")
+	require.NoError(t, err)
+
+	previous, err := GenerateKey("2026-07")
+	require.NoError(t, err)
+
+	signingKey := "2026-10:" + base64.StdEncoding.EncodeToString(current.PrivateKey.Seed())
+	verificationKeys := []string{"2026-07:" + base64.StdEncoding.EncodeToString(previous.PublicKey)}
+
+	keyring, err := ParseKeyring(signingKey, verificationKeys)
+	require.NoError(t, err)
+
+	key, err := keyring.SigningKey()
+	require.NoError(t, err)
+	require.Equal(t, current.ID, key.ID)
+	require.Equal(t, current.PrivateKey, key.PrivateKey)
+
+	publicKeys := keyring.PublicKeys()
+	require.Len(t, publicKeys, 2)
+	require.Equal(t, previous.ID, publicKeys[0].ID)
+	require.Equal(t, current.ID, publicKeys[1].ID)
+	for _, publicKey := range publicKeys {
+		require.Nil(t, publicKey.PrivateKey)
+	}
+
+	// verifying services only get the public keys
+	keyring, err = ParseKeyring("", verificationKeys)
+	require.NoError(t, err)
+
+	_, err = keyring.SigningKey()
+	require.ErrorIs(t, err, ErrNoSigningKey)
+
+	_, ok := keyring.VerificationKey(previous.ID)
+	require.True(t, ok)
+}
+
+func TestParseKeyringInvalid(t *testing.T) {
+	key, err := GenerateKey("current")
+	require.NoError(t, err)
+
+	other, err := GenerateKey("current")
+	require.NoError(t, err)
+
+	seed := base64.StdEncoding.EncodeToString(key.PrivateKey.Seed())
+
+	testCases := []struct {
+		name             string
+		signingKey       string
+		verificationKeys []string
+	}{
+		{"NoKeys", "", nil},
+		{"MissingKeyID", seed, nil},
+		{"NotBase64", "current:not base64", nil},
+		{"WrongSize", "current:" + base64.StdEncoding.EncodeToString([]byte("short")), nil},
+		{"DuplicateKeyID", "current:" + seed, []string{"current:" + base64.StdEncoding.EncodeToString(other.PublicKey)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseKeyring(tc.signingKey, tc.verificationKeys)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestJSONWebKeySet(t *testing.T) {
+	key, err := GenerateKey("current")
+	require.NoError(t, err)
+
+	keyring, err := NewKeyring(&key)
+	require.NoError(t, err)
+
+	keySet := keyring.JSONWebKeySet()
+	require.Len(t, keySet.Keys, 1)
+
+	jwk := keySet.Keys[0]
+	require.Equal(t, "OKP", jwk.KeyType)
+	require.Equal(t, "Ed25519", jwk.Curve)
+	require.Equal(t, key.ID, jwk.KeyID)
+
+	publicKey, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	require.NoError(t, err)
+	require.Equal(t, []byte(key.PublicKey), publicKey)
+}
@@ -1,8 +1,26 @@
 package token
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Maker interface {
-	CreateToken(username string, role string, duration time.Duration) (string, *Payload, error)
+	CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration) (string, *Payload, error)
+	// IssueToken signs a payload built by the caller, such as the scoped payload of a service client.
+	IssueToken(payload *Payload) (string, error)
 	VerifyToken(token string) (*Payload, error)
 }
+
+// CreateRefreshToken creates a refresh token of the session with the maker.
+func CreateRefreshToken(maker Maker, username string, role string, sessionID uuid.UUID, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewRefreshPayload(username, role, sessionID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.IssueToken(payload)
+
+	return token, payload, err
+}
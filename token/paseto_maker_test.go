@@ -1,6 +1,7 @@
 package token
 
 import (
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"simplebank/util"
 	"testing"
@@ -16,8 +17,9 @@ func TestPasetoMaker(t *testing.T) {
 	issuedAt := time.Now()
 	expiredAt := issuedAt.Add(duration)
 	role := util.DepositorRole
+	sessionID := uuid.New()
 
-	token, payload, err := maker.CreateToken(username, role, duration)
+	token, payload, err := maker.CreateToken(username, role, sessionID, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -28,15 +30,32 @@ func TestPasetoMaker(t *testing.T) {
 	require.NotZero(t, payload.ID)
 	require.Equal(t, role, payload.Role)
 	require.Equal(t, username, payload.Username)
+	require.Equal(t, sessionID, payload.SessionID)
+	require.Equal(t, TypeAccess, payload.Type)
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 }
 
+func TestPasetoRefreshToken(t *testing.T) {
+	maker, err := NewPasetoMaker([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	sessionID := uuid.New()
+
+	token, _, err := CreateRefreshToken(maker, util.RandomOwner(), util.DepositorRole, sessionID, time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, TypeRefresh, payload.Type)
+	require.Equal(t, sessionID, payload.SessionID)
+}
+
 func TestExpiredPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker([]byte(util.RandomString(32)))
 	require.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -48,7 +67,7 @@ func TestExpiredPasetoToken(t *testing.T) {
 }
 
 func TestInvalidPasetoToken(t *testing.T) {
-	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
 	require.NoError(t, err)
 
 	maker, err := NewPasetoMaker([]byte(util.RandomString(32)))
@@ -59,3 +78,24 @@ func TestInvalidPasetoToken(t *testing.T) {
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, payload)
 }
+
+func TestPasetoClientToken(t *testing.T) {
+	maker, err := NewPasetoMaker([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	clientID := uuid.NewString()
+	scopes := []string{"accounts:read", "transfers:create"}
+
+	payload, err := NewClientPayload(clientID, util.RandomOwner(), util.DepositorRole, scopes, time.Minute)
+	require.NoError(t, err)
+
+	token, err := maker.IssueToken(payload)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, clientID, verified.ClientID)
+	require.Equal(t, scopes, verified.Scopes)
+	require.Equal(t, uuid.Nil, verified.SessionID)
+}
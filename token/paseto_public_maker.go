@@ -0,0 +1,140 @@
+package token
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const pasetoPublicHeader = "v4.public."
+
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// PasetoPublicMaker signs PASETO v4.public tokens with the Ed25519 signing key of a keyring.
+// The key id is carried in the footer, which is authenticated along with the payload.
+type PasetoPublicMaker struct {
+	keyring *Keyring
+}
+
+func NewPasetoPublicMaker(keyring *Keyring) (Maker, error) {
+	if keyring == nil {
+		return nil, fmt.Errorf("keyring is required")
+	}
+
+	return &PasetoPublicMaker{keyring}, nil
+}
+
+func (maker *PasetoPublicMaker) CreateToken(username string, role string, sessionID uuid.UUID, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := maker.IssueToken(payload)
+
+	return token, payload, err
+}
+
+func (maker *PasetoPublicMaker) IssueToken(payload *Payload) (string, error) {
+	key, err := maker.keyring.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	footer, err := json.Marshal(pasetoFooter{KeyID: key.ID})
+	if err != nil {
+		return "", err
+	}
+
+	signature := ed25519.Sign(key.PrivateKey, preAuthEncode([]byte(pasetoPublicHeader), message, footer, nil))
+
+	token := pasetoPublicHeader +
+		base64.RawURLEncoding.EncodeToString(append(message, signature...)) + "." +
+		base64.RawURLEncoding.EncodeToString(footer)
+
+	return token, nil
+}
+
+func (maker *PasetoPublicMaker) VerifyToken(token string) (*Payload, error) {
+	if !strings.HasPrefix(token, pasetoPublicHeader) {
+		return nil, ErrInvalidToken
+	}
+
+	encodedBody, encodedFooter, found := strings.Cut(strings.TrimPrefix(token, pasetoPublicHeader), ".")
+	if !found {
+		return nil, ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(encodedBody)
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, ErrInvalidToken
+	}
+
+	footer, err := base64.RawURLEncoding.DecodeString(encodedFooter)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	// The footer is only trusted to pick the key, the signature covers it as well
+	var decodedFooter pasetoFooter
+	if err = json.Unmarshal(footer, &decodedFooter); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, ok := maker.keyring.VerificationKey(decodedFooter.KeyID)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	message := body[:len(body)-ed25519.SignatureSize]
+	signature := body[len(body)-ed25519.SignatureSize:]
+
+	if !ed25519.Verify(publicKey, preAuthEncode([]byte(pasetoPublicHeader), message, footer, nil), signature) {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	if err = json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// preAuthEncode is the PASETO pre-authentication encoding, which makes the boundaries of the signed pieces unambiguous.
+func preAuthEncode(pieces ...[]byte) []byte {
+	var buffer bytes.Buffer
+
+	writeLength := func(n int) {
+		var length [8]byte
+		binary.LittleEndian.PutUint64(length[:], uint64(n)&^(1<<63))
+		buffer.Write(length[:])
+	}
+
+	writeLength(len(pieces))
+	for _, piece := range pieces {
+		writeLength(len(piece))
+		buffer.Write(piece)
+	}
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,159 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"simplebank/util"
+)
+
+func newTestKeyring(t *testing.T, id string) (*Keyring, Key) {
+	key, err := GenerateKey(id)
+	require.NoError(t, err)
+
+	keyring, err := NewKeyring(&key)
+	require.NoError(t, err)
+
+	return keyring, key
+}
+
+func TestPasetoPublicMaker(t *testing.T) {
+	keyring, _ := newTestKeyring(t, "current")
+	maker, err := NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	duration := time.Minute
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+	role := util.DepositorRole
+	sessionID := uuid.New()
+
+	token, payload, err := maker.CreateToken(username, role, sessionID, duration)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, "v4.public."))
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotZero(t, payload.ID)
+	require.Equal(t, role, payload.Role)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, sessionID, payload.SessionID)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+}
+
+func TestPasetoPublicMakerKeyRotation(t *testing.T) {
+	previousKeyring, previousKey := newTestKeyring(t, "previous")
+	previousMaker, err := NewPasetoPublicMaker(previousKeyring)
+	require.NoError(t, err)
+
+	token, _, err := previousMaker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	currentKey, err := GenerateKey("current")
+	require.NoError(t, err)
+
+	// tokens of the previous key stay valid while it is kept for verification
+	keyring, err := NewKeyring(&currentKey, Key{ID: previousKey.ID, PublicKey: previousKey.PublicKey})
+	require.NoError(t, err)
+	maker, err := NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+
+	keyring, err = NewKeyring(&currentKey)
+	require.NoError(t, err)
+	maker, err = NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+}
+
+func TestPasetoPublicMakerVerificationOnly(t *testing.T) {
+	signingKeyring, key := newTestKeyring(t, "current")
+	signingMaker, err := NewPasetoPublicMaker(signingKeyring)
+	require.NoError(t, err)
+
+	keyring, err := NewKeyring(nil, Key{ID: key.ID, PublicKey: key.PublicKey})
+	require.NoError(t, err)
+	maker, err := NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	_, _, err = maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
+	require.ErrorIs(t, err, ErrNoSigningKey)
+
+	token, _, err := signingMaker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	keyring, _ := newTestKeyring(t, "current")
+	maker, err := NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidPasetoPublicToken(t *testing.T) {
+	keyring, _ := newTestKeyring(t, "current")
+	maker, err := NewPasetoPublicMaker(keyring)
+	require.NoError(t, err)
+
+	otherKeyring, _ := newTestKeyring(t, "current")
+	otherMaker, err := NewPasetoPublicMaker(otherKeyring)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	forged, _, err := otherMaker.CreateToken(util.RandomOwner(), util.BankerRole, uuid.New(), time.Minute)
+	require.NoError(t, err)
+
+	body, footer, _ := strings.Cut(strings.TrimPrefix(token, "v4.public."), ".")
+	unknownKeyFooter := base64.RawURLEncoding.EncodeToString([]byte(`{"kid":"unknown"}`))
+
+	for _, invalid := range []string{
+		util.RandomString(6),
+		"v2.public." + body + "." + footer,
+		"v4.public." + body,
+		"v4.public." + body + "." + unknownKeyFooter,
+		"v4.public." + body[:len(body)-4] + "AAAA." + footer,
+		forged,
+	} {
+		payload, err := maker.VerifyToken(invalid)
+		require.EqualError(t, err, ErrInvalidToken.Error())
+		require.Nil(t, payload)
+	}
+}
+
+// TestPasetoPublicTestVector checks the signature against test vector 4-S-1 of the PASETO specification.
+func TestPasetoPublicTestVector(t *testing.T) {
+	secretKey, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774" +
+		"1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2")
+	require.NoError(t, err)
+
+	message := []byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`)
+	expected := "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9" +
+		"bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA"
+
+	signature := ed25519.Sign(secretKey, preAuthEncode([]byte(pasetoPublicHeader), message, nil, nil))
+	require.Equal(t, expected, pasetoPublicHeader+base64.RawURLEncoding.EncodeToString(append(message, signature...)))
+}
@@ -13,12 +13,30 @@ var (
 	ErrInvalidToken = errors.New("invalid token")
 )
 
+// Types of tokens, a token is only accepted where its type is expected.
+const (
+	// TypeAccess tokens authenticate calls
+	TypeAccess = "access"
+	// TypeRefresh tokens only renew the access tokens of their session
+	TypeRefresh = "refresh"
+)
+
 type Payload struct {
-	ID        uuid.UUID `json:"id"`
-	Username  string    `json:"username"`
-	Role      string    `json:"role"`
-	IssuedAt  time.Time `json:"issued_at"`
-	ExpiredAt time.Time `json:"expired_at"`
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Role     string    `json:"role"`
+	// Type tells access tokens from refresh tokens, which are otherwise built the same way
+	Type string `json:"token_type"`
+	// SessionID is the login session the token belongs to, tokens stop working once the session is blocked
+	SessionID uuid.UUID `json:"session_id"`
+	// ClientID is set for tokens of service clients, which act for their owner within their scopes and have no session
+	ClientID string   `json:"client_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+	// Impersonator is set for tokens of employees acting as a user, their SessionID is the impersonation
+	Impersonator string    `json:"impersonator,omitempty"`
+	ReadOnly     bool      `json:"read_only,omitempty"`
+	IssuedAt     time.Time `json:"issued_at"`
+	ExpiredAt    time.Time `json:"expired_at"`
 }
 
 type JWTPayloadClaims struct {
@@ -41,7 +59,7 @@ func NewJWTPayloadClaims(payload *Payload) *JWTPayloadClaims {
 	}
 }
 
-func NewPayload(username string, role string, duration time.Duration) (*Payload, error) {
+func NewPayload(username string, role string, sessionID uuid.UUID, duration time.Duration) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -49,8 +67,10 @@ func NewPayload(username string, role string, duration time.Duration) (*Payload,
 
 	payload := &Payload{
 		ID:        tokenID,
+		Type:      TypeAccess,
 		Username:  username,
 		Role:      role,
+		SessionID: sessionID,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -58,6 +78,45 @@ func NewPayload(username string, role string, duration time.Duration) (*Payload,
 	return payload, nil
 }
 
+// NewRefreshPayload returns the payload of a refresh token of the session, which cannot be used as an access token.
+func NewRefreshPayload(username string, role string, sessionID uuid.UUID, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(username, role, sessionID, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Type = TypeRefresh
+
+	return payload, nil
+}
+
+// NewClientPayload returns the payload of a token for a service client acting for its owner, limited to the scopes.
+func NewClientPayload(clientID string, username string, role string, scopes []string, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(username, role, uuid.Nil, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.ClientID = clientID
+	payload.Scopes = scopes
+
+	return payload, nil
+}
+
+// NewImpersonationPayload returns the payload of a token for the impersonator acting as the user, which
+// belongs to the impersonation instead of a session.
+func NewImpersonationPayload(impersonationID uuid.UUID, impersonator string, username string, role string, readOnly bool, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(username, role, impersonationID, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Impersonator = impersonator
+	payload.ReadOnly = readOnly
+
+	return payload, nil
+}
+
 // Valid checks if the payload's expiration time has passed and returns an error if the token is expired.
 func (payload *Payload) Valid() error {
 	if time.Now().After(payload.ExpiredAt) {
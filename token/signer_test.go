@@ -0,0 +1,51 @@
+package token
+
+import (
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	id := util.RandomInt(1, 1000)
+	token := signer.Sign("payment_request", id, time.Now().Add(time.Minute))
+	require.NotEmpty(t, token)
+
+	verifiedId, err := signer.Verify("payment_request", token)
+	require.NoError(t, err)
+	require.Equal(t, id, verifiedId)
+}
+
+func TestExpiredSignedToken(t *testing.T) {
+	signer, err := NewSigner([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	token := signer.Sign("payment_request", 1, time.Now().Add(-time.Minute))
+
+	_, err = signer.Verify("payment_request", token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+}
+
+func TestInvalidSignedToken(t *testing.T) {
+	signer, err := NewSigner([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	otherSigner, err := NewSigner([]byte(util.RandomString(32)))
+	require.NoError(t, err)
+
+	token := signer.Sign("payment_request", 1, time.Now().Add(time.Minute))
+
+	_, err = signer.Verify("password_reset", token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+
+	_, err = otherSigner.Verify("payment_request", token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+
+	_, err = signer.Verify("payment_request", util.RandomString(6))
+	require.EqualError(t, err, ErrInvalidToken.Error())
+}
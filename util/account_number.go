@@ -0,0 +1,96 @@
+package util
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// AccountNumberLength is the total length of a generated account number:
+	// country code (2), check digits (2), bank code (4) and account digits (14).
+	AccountNumberLength = 22
+	accountDigitsLength = 14
+	bankCodeLength      = 4
+)
+
+// Country and bank code of the account numbers backfilled by migration 000007. Migrations cannot read the
+// configuration, so these are fixed whatever BANK_COUNTRY_CODE and BANK_CODE are set to. Account numbers are only
+// validated by their shape and check digits, so backfilled numbers stay valid when the configuration changes.
+const (
+	BackfillCountryCode = "GB"
+	BackfillBankCode    = "SMPL"
+)
+
+// GenerateAccountNumber returns a random IBAN-style account number for the given country and bank code,
+// e.g. GB29SMPL01234567890123. The check digits are computed with the ISO 7064 mod-97 algorithm.
+func GenerateAccountNumber(countryCode, bankCode string) (string, error) {
+	countryCode = strings.ToUpper(countryCode)
+	bankCode = strings.ToUpper(bankCode)
+
+	if len(countryCode) != 2 || !isUpperAlpha(countryCode) {
+		return "", fmt.Errorf("country code must be 2 letters")
+	}
+
+	if len(bankCode) != bankCodeLength || !isUpperAlphanumeric(bankCode) {
+		return "", fmt.Errorf("bank code must be %d letters or digits", bankCodeLength)
+	}
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(accountDigitsLength), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate account digits: %w", err)
+	}
+
+	bban := fmt.Sprintf("%s%0*s", bankCode, accountDigitsLength, n.String())
+	checkDigits := 98 - mod97(bban+countryCode+"00")
+
+	return fmt.Sprintf("%s%02d%s", countryCode, checkDigits, bban), nil
+}
+
+// IsValidAccountNumberChecksum reports whether the account number passes the mod-97 check digit validation.
+func IsValidAccountNumberChecksum(number string) bool {
+	if len(number) < 5 || !isUpperAlphanumeric(number) {
+		return false
+	}
+
+	return mod97(number[4:]+number[:4]) == 1
+}
+
+// mod97 computes the remainder of the numeric representation of value divided by 97,
+// where letters are replaced by two digits (A = 10, B = 11, ..., Z = 35).
+func mod97(value string) int {
+	remainder := 0
+
+	for _, c := range value {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		}
+	}
+
+	return remainder
+}
+
+func isUpperAlpha(value string) bool {
+	for _, c := range value {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUpperAlphanumeric(value string) bool {
+	for _, c := range value {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
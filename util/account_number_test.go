@@ -0,0 +1,36 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAccountNumber(t *testing.T) {
+	number1, err := GenerateAccountNumber("gb", "smpl")
+	require.NoError(t, err)
+	require.Len(t, number1, AccountNumberLength)
+	require.Equal(t, "GB", number1[:2])
+	require.Equal(t, "SMPL", number1[4:8])
+	require.True(t, IsValidAccountNumberChecksum(number1))
+
+	number2, err := GenerateAccountNumber("GB", "SMPL")
+	require.NoError(t, err)
+	require.NotEqual(t, number1, number2)
+
+	_, err = GenerateAccountNumber("GBR", "SMPL")
+	require.Error(t, err)
+
+	_, err = GenerateAccountNumber("GB", "SMP")
+	require.Error(t, err)
+}
+
+func TestIsValidAccountNumberChecksum(t *testing.T) {
+	// well-known example IBAN
+	require.True(t, IsValidAccountNumberChecksum("GB82WEST12345698765432"))
+
+	require.False(t, IsValidAccountNumberChecksum("GB83WEST12345698765432"))
+	require.False(t, IsValidAccountNumberChecksum("GB82WEST12345698765423"))
+	require.False(t, IsValidAccountNumberChecksum("gb82west12345698765432"))
+	require.False(t, IsValidAccountNumberChecksum("GB8"))
+}
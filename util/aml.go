@@ -0,0 +1,9 @@
+package util
+
+// Kinds of anti-money-laundering reports.
+const (
+	// AmlReportLargeTransaction reports a single transfer of at least the report threshold
+	AmlReportLargeTransaction = "large_transaction"
+	// AmlReportStructuring reports many transfers just under the report threshold, made to stay below it
+	AmlReportStructuring = "structuring"
+)
@@ -0,0 +1,94 @@
+package util
+
+import "fmt"
+
+const (
+	SplitEven   = "even"
+	SplitShares = "shares"
+	SplitExact  = "exact"
+)
+
+// SplitEvenly divides the amount into the given number of parts. Parts differ by at most one
+// smallest currency unit, with the remainder going to the first parts.
+func SplitEvenly(amount int64, parts int) ([]int64, error) {
+	if parts <= 0 {
+		return nil, fmt.Errorf("must split into at least one part")
+	}
+
+	shares := make([]int64, parts)
+	for i := range shares {
+		shares[i] = 1
+	}
+
+	return SplitByShares(amount, shares)
+}
+
+// SplitByShares divides the amount proportionally to the shares. Amounts are rounded down and
+// the remaining units go to the parts with the largest remainders, earlier parts winning ties,
+// so the result always adds up to the amount.
+func SplitByShares(amount int64, shares []int64) ([]int64, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("must split into at least one part")
+	}
+
+	var totalShares int64
+	for _, share := range shares {
+		if share <= 0 {
+			return nil, fmt.Errorf("shares must be positive")
+		}
+
+		totalShares += share
+	}
+
+	amounts := make([]int64, len(shares))
+	remainders := make([]int64, len(shares))
+	leftover := amount
+
+	for i, share := range shares {
+		amounts[i] = amount * share / totalShares
+		remainders[i] = amount * share % totalShares
+		leftover -= amounts[i]
+	}
+
+	for ; leftover > 0; leftover-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+
+		amounts[largest]++
+		remainders[largest] = -1
+	}
+
+	for _, a := range amounts {
+		if a <= 0 {
+			return nil, fmt.Errorf("amount is too small to split")
+		}
+	}
+
+	return amounts, nil
+}
+
+// CheckExactSplit ensures exact amounts are positive and add up to the total amount.
+func CheckExactSplit(amount int64, amounts []int64) error {
+	var total int64
+	for _, a := range amounts {
+		if a <= 0 {
+			return fmt.Errorf("amounts must be positive")
+		}
+
+		total += a
+	}
+
+	if total != amount {
+		return fmt.Errorf("amounts add up to %d instead of %d", total, amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitEvenly(t *testing.T) {
+	amounts, err := SplitEvenly(100, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{34, 33, 33}, amounts)
+
+	amounts, err = SplitEvenly(90, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{30, 30, 30}, amounts)
+
+	_, err = SplitEvenly(2, 3)
+	require.Error(t, err)
+
+	_, err = SplitEvenly(100, 0)
+	require.Error(t, err)
+}
+
+func TestSplitByShares(t *testing.T) {
+	amounts, err := SplitByShares(1000, []int64{1, 2, 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{200, 400, 400}, amounts)
+
+	// 1001 * 1/3 = 333.67, 1001 * 2/3 = 667.33
+	amounts, err = SplitByShares(1001, []int64{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{334, 667}, amounts)
+
+	amount := RandomInt(100, 10000)
+	amounts, err = SplitByShares(amount, []int64{3, 1, 4, 1, 5})
+	require.NoError(t, err)
+
+	var total int64
+	for _, a := range amounts {
+		total += a
+	}
+	require.Equal(t, amount, total)
+
+	_, err = SplitByShares(1000, []int64{1, 0})
+	require.Error(t, err)
+
+	_, err = SplitByShares(0, []int64{1})
+	require.Error(t, err)
+}
+
+func TestCheckExactSplit(t *testing.T) {
+	require.NoError(t, CheckExactSplit(100, []int64{50, 25, 25}))
+	require.Error(t, CheckExactSplit(100, []int64{50, 25}))
+	require.Error(t, CheckExactSplit(100, []int64{150, -50}))
+}
@@ -0,0 +1,123 @@
+package util
+
+import "strings"
+
+// Spending categories of outgoing transfers
+const (
+	CategoryGroceries     = "groceries"
+	CategoryDining        = "dining"
+	CategoryTransport     = "transport"
+	CategoryUtilities     = "utilities"
+	CategoryHousing       = "housing"
+	CategoryEntertainment = "entertainment"
+	CategoryShopping      = "shopping"
+	CategoryHealth        = "health"
+	CategoryOther         = "other"
+)
+
+// CategoryRule assigns a category to transfers whose description contains the keyword and/or
+// that are sent to the counterparty account. Empty fields match any transfer.
+type CategoryRule struct {
+	Keyword               string
+	CounterpartyAccountID int64
+	Category              string
+}
+
+// defaultCategoryRules apply when none of the user's own rules match
+var defaultCategoryRules = []CategoryRule{
+	{Keyword: "tesco", Category: CategoryGroceries},
+	{Keyword: "sainsbury", Category: CategoryGroceries},
+	{Keyword: "aldi", Category: CategoryGroceries},
+	{Keyword: "lidl", Category: CategoryGroceries},
+	{Keyword: "grocer", Category: CategoryGroceries},
+	{Keyword: "supermarket", Category: CategoryGroceries},
+	{Keyword: "restaurant", Category: CategoryDining},
+	{Keyword: "cafe", Category: CategoryDining},
+	{Keyword: "coffee", Category: CategoryDining},
+	{Keyword: "pizza", Category: CategoryDining},
+	{Keyword: "dinner", Category: CategoryDining},
+	{Keyword: "lunch", Category: CategoryDining},
+	{Keyword: "uber", Category: CategoryTransport},
+	{Keyword: "taxi", Category: CategoryTransport},
+	{Keyword: "train", Category: CategoryTransport},
+	{Keyword: "bus", Category: CategoryTransport},
+	{Keyword: "fuel", Category: CategoryTransport},
+	{Keyword: "parking", Category: CategoryTransport},
+	{Keyword: "electric", Category: CategoryUtilities},
+	{Keyword: "energy", Category: CategoryUtilities},
+	{Keyword: "water", Category: CategoryUtilities},
+	{Keyword: "broadband", Category: CategoryUtilities},
+	{Keyword: "phone", Category: CategoryUtilities},
+	{Keyword: "rent", Category: CategoryHousing},
+	{Keyword: "mortgage", Category: CategoryHousing},
+	{Keyword: "council tax", Category: CategoryHousing},
+	{Keyword: "cinema", Category: CategoryEntertainment},
+	{Keyword: "netflix", Category: CategoryEntertainment},
+	{Keyword: "spotify", Category: CategoryEntertainment},
+	{Keyword: "tickets", Category: CategoryEntertainment},
+	{Keyword: "amazon", Category: CategoryShopping},
+	{Keyword: "clothes", Category: CategoryShopping},
+	{Keyword: "pharmacy", Category: CategoryHealth},
+	{Keyword: "dentist", Category: CategoryHealth},
+	{Keyword: "gym", Category: CategoryHealth},
+}
+
+// IsSupportedCategory returns true if the spending category is supported
+func IsSupportedCategory(category string) bool {
+	switch category {
+	case CategoryGroceries, CategoryDining, CategoryTransport, CategoryUtilities, CategoryHousing,
+		CategoryEntertainment, CategoryShopping, CategoryHealth, CategoryOther:
+		return true
+	}
+
+	return false
+}
+
+// Categorize returns the category of a transfer. The user's rules for the counterparty take precedence over
+// their keyword rules, which take precedence over the default keyword rules. Within a group the first match wins.
+func Categorize(description string, counterpartyAccountID int64, rules []CategoryRule) string {
+	description = strings.ToLower(description)
+
+	matches := func(rule CategoryRule) bool {
+		if rule.CounterpartyAccountID != 0 && rule.CounterpartyAccountID != counterpartyAccountID {
+			return false
+		}
+
+		return rule.Keyword == "" || containsWordPrefix(description, strings.ToLower(rule.Keyword))
+	}
+
+	for _, rule := range rules {
+		if rule.CounterpartyAccountID != 0 && matches(rule) {
+			return rule.Category
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.CounterpartyAccountID == 0 && rule.Keyword != "" && matches(rule) {
+			return rule.Category
+		}
+	}
+
+	for _, rule := range defaultCategoryRules {
+		if matches(rule) {
+			return rule.Category
+		}
+	}
+
+	return CategoryOther
+}
+
+// containsWordPrefix reports whether a word in value starts with prefix, so "rent" matches "rental" but not "parent".
+func containsWordPrefix(value, prefix string) bool {
+	for i := 0; i+len(prefix) <= len(value); i++ {
+		if strings.HasPrefix(value[i:], prefix) && (i == 0 || !isLowerAlphanumeric(value[i-1])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLowerAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategorize(t *testing.T) {
+	rules := []CategoryRule{
+		{Keyword: "Tesco", CounterpartyAccountID: 7, Category: CategoryHousing},
+		{CounterpartyAccountID: 5, Category: CategoryUtilities},
+		{Keyword: "pizza", Category: CategoryEntertainment},
+	}
+
+	// Default keyword rules
+	require.Equal(t, CategoryGroceries, Categorize("TESCO EXTRA", 1, nil))
+	require.Equal(t, CategoryDining, Categorize("Pizza night", 1, nil))
+	require.Equal(t, CategoryOther, Categorize("", 1, nil))
+	require.Equal(t, CategoryOther, Categorize("birthday present", 1, nil))
+	require.Equal(t, CategoryHousing, Categorize("June rent", 1, nil))
+	require.Equal(t, CategoryOther, Categorize("for parents", 1, nil))
+
+	// User rules
+	require.Equal(t, CategoryEntertainment, Categorize("Pizza night", 1, rules))
+	require.Equal(t, CategoryUtilities, Categorize("Pizza night", 5, rules))
+	require.Equal(t, CategoryHousing, Categorize("tesco", 7, rules))
+	require.Equal(t, CategoryGroceries, Categorize("tesco", 1, rules))
+}
+
+func TestIsSupportedCategory(t *testing.T) {
+	require.True(t, IsSupportedCategory(CategoryGroceries))
+	require.False(t, IsSupportedCategory("gambling"))
+}
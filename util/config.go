@@ -6,23 +6,69 @@ import (
 	"github.com/spf13/viper"
 )
 
+// Formats of access and refresh tokens signed with the token keyring.
+const (
+	TokenFormatPaseto = "paseto"
+	TokenFormatJWT    = "jwt"
+)
+
 type Config struct {
-	CI                   bool          `mapstructure:"CI"`
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBUri                string        `mapstructure:"DB_URI"`
-	RedisUri             string        `mapstructure:"REDIS_URI"`
-	MigrationUri         string        `mapstructure:"MIGRATION_URI"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	CertificatePath      string        `mapstructure:"CERTIFICATE_PATH"`
-	KeyPath              string        `mapstructure:"KEY_PATH"`
+	CI                        bool          `mapstructure:"CI"`
+	Environment               string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                  string        `mapstructure:"DB_DRIVER"`
+	DBUri                     string        `mapstructure:"DB_URI"`
+	RedisUri                  string        `mapstructure:"REDIS_URI"`
+	MigrationUri              string        `mapstructure:"MIGRATION_URI"`
+	HTTPServerAddress         string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress         string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenSymmetricKey         string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenFormat               string        `mapstructure:"TOKEN_FORMAT"`
+	TokenSigningKey           string        `mapstructure:"TOKEN_SIGNING_KEY"`
+	TokenVerificationKeys     []string      `mapstructure:"TOKEN_VERIFICATION_KEYS"`
+	AccessTokenDuration       time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration      time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	MfaChallengeDuration      time.Duration `mapstructure:"MFA_CHALLENGE_DURATION"`
+	MfaStepUpAmount           int64         `mapstructure:"MFA_STEP_UP_AMOUNT"`
+	LoginFailureWindow        time.Duration `mapstructure:"LOGIN_FAILURE_WINDOW"`
+	LoginMaxFailures          int64         `mapstructure:"LOGIN_MAX_FAILURES"`
+	LoginMaxFailuresPerIP     int64         `mapstructure:"LOGIN_MAX_FAILURES_PER_IP"`
+	LoginRetryDelay           time.Duration `mapstructure:"LOGIN_RETRY_DELAY"`
+	LoginLockoutDuration      time.Duration `mapstructure:"LOGIN_LOCKOUT_DURATION"`
+	ClientTokenDuration       time.Duration `mapstructure:"CLIENT_TOKEN_DURATION"`
+	ClientKeyRotationOverlap  time.Duration `mapstructure:"CLIENT_KEY_ROTATION_OVERLAP"`
+	ImpersonationDuration     time.Duration `mapstructure:"IMPERSONATION_DURATION"`
+	PasswordHashAlgorithm     string        `mapstructure:"PASSWORD_HASH_ALGORITHM"`
+	Argon2idMemory            uint32        `mapstructure:"ARGON2ID_MEMORY"`
+	Argon2idIterations        uint32        `mapstructure:"ARGON2ID_ITERATIONS"`
+	Argon2idParallelism       uint8         `mapstructure:"ARGON2ID_PARALLELISM"`
+	BcryptCost                int           `mapstructure:"BCRYPT_COST"`
+	PasswordMinLength         int           `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMinCharClasses    int           `mapstructure:"PASSWORD_MIN_CHAR_CLASSES"`
+	BreachedPasswordsPath     string        `mapstructure:"BREACHED_PASSWORDS_PATH"`
+	DataExportInterval        time.Duration `mapstructure:"DATA_EXPORT_INTERVAL"`
+	BlobStorePath             string        `mapstructure:"BLOB_STORE_PATH"`
+	RequireVerifiedEmail      bool          `mapstructure:"REQUIRE_VERIFIED_EMAIL"`
+	VerifyEmailResendInterval time.Duration `mapstructure:"VERIFY_EMAIL_RESEND_INTERVAL"`
+	EmailSenderName           string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderPassword       string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	EmailSenderAddress        string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	PublicBaseURL             string        `mapstructure:"PUBLIC_BASE_URL"`
+	CertificatePath           string        `mapstructure:"CERTIFICATE_PATH"`
+	KeyPath                   string        `mapstructure:"KEY_PATH"`
+	BankCountryCode           string        `mapstructure:"BANK_COUNTRY_CODE"`
+	BankCode                  string        `mapstructure:"BANK_CODE"`
+	LinkSigningKey            string        `mapstructure:"LINK_SIGNING_KEY"`
+	PaymentRequestDuration    time.Duration `mapstructure:"PAYMENT_REQUEST_DURATION"`
+	BillSplitReminderInterval time.Duration `mapstructure:"BILL_SPLIT_REMINDER_INTERVAL"`
+	ChargebackWindow          time.Duration `mapstructure:"CHARGEBACK_WINDOW"`
+	FraudRulesPath            string        `mapstructure:"FRAUD_RULES_PATH"`
+	WatchlistPath             string        `mapstructure:"WATCHLIST_PATH"`
+	ScreeningThreshold        float64       `mapstructure:"SCREENING_THRESHOLD"`
+	AmlScanInterval           time.Duration `mapstructure:"AML_SCAN_INTERVAL"`
+	AmlReportThreshold        int64         `mapstructure:"AML_REPORT_THRESHOLD"`
+	AmlStructuringFloor       int64         `mapstructure:"AML_STRUCTURING_FLOOR"`
+	AmlStructuringWindow      time.Duration `mapstructure:"AML_STRUCTURING_WINDOW"`
+	AmlStructuringMinCount    int64         `mapstructure:"AML_STRUCTURING_MIN_COUNT"`
 }
 
 // LoadConfig loads application configuration from the specified path using the Viper library and environment variables.
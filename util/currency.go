@@ -1,5 +1,7 @@
 package util
 
+import "fmt"
+
 const (
 	USD = "USD"
 	EUR = "EUR"
@@ -15,3 +17,14 @@ func IsSupportedCurrency(currency string) bool {
 
 	return false
 }
+
+// FormatAmount formats an amount in the smallest currency unit as a human-readable string, e.g. 1050 GBP as "10.50 GBP".
+func FormatAmount(amount int64, currency string) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/100, amount%100, currency)
+}
@@ -0,0 +1,7 @@
+package util
+
+// Kinds of data subject requests.
+const (
+	DataRequestExport  = "export"
+	DataRequestErasure = "erasure"
+)
@@ -0,0 +1,8 @@
+package util
+
+// Statuses of fraud cases, transfers held back by a fraud rule.
+const (
+	FraudCaseOpen     = "open"
+	FraudCaseApproved = "approved"
+	FraudCaseRejected = "rejected"
+)
@@ -0,0 +1,56 @@
+package util
+
+// KYC statuses of users.
+const (
+	KycStatusNone          = "none"
+	KycStatusPendingReview = "pending_review"
+	KycStatusApproved      = "approved"
+	KycStatusRejected      = "rejected"
+)
+
+// Kinds of KYC documents.
+const (
+	KycDocumentIdentity       = "identity"
+	KycDocumentProofOfAddress = "proof_of_address"
+)
+
+// Review statuses of KYC documents.
+const (
+	KycDocumentPending  = "pending"
+	KycDocumentApproved = "approved"
+	KycDocumentRejected = "rejected"
+)
+
+// KycTier holds the limits of a KYC tier. Amounts are in the smallest currency unit of any currency.
+type KycTier struct {
+	MaxAccounts        int64
+	MaxTransferAmount  int64
+	DailyTransferLimit int64
+}
+
+// KycTiers are indexed by tier. Tier 0 users have not been identified and cannot hold accounts,
+// an approved identity document gives tier 1 and a proof of address on top of it gives tier 2.
+var KycTiers = []KycTier{
+	{},
+	{MaxAccounts: 2, MaxTransferAmount: 100_000, DailyTransferLimit: 250_000},
+	{MaxAccounts: 10, MaxTransferAmount: 1_000_000, DailyTransferLimit: 5_000_000},
+}
+
+// GetKycTier returns the limits of the tier, tiers out of range have no limits set and allow nothing.
+func GetKycTier(tier int32) KycTier {
+	if tier < 0 || int(tier) >= len(KycTiers) {
+		return KycTier{}
+	}
+
+	return KycTiers[tier]
+}
+
+// IsSupportedKycDocumentKind returns false if the kind of document is not accepted
+func IsSupportedKycDocumentKind(kind string) bool {
+	switch kind {
+	case KycDocumentIdentity, KycDocumentProofOfAddress:
+		return true
+	}
+
+	return false
+}
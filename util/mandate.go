@@ -0,0 +1,46 @@
+package util
+
+import "time"
+
+const (
+	MandateActive  = "active"
+	MandateRevoked = "revoked"
+)
+
+const (
+	DirectDebitCollected   = "collected"
+	DirectDebitChargedBack = "charged_back"
+)
+
+// Collection frequencies of a mandate
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+	FrequencyYearly  = "yearly"
+)
+
+// IsSupportedFrequency returns true if the mandate collection frequency is supported
+func IsSupportedFrequency(frequency string) bool {
+	switch frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return true
+	}
+
+	return false
+}
+
+// NextCollectionAt returns the earliest time a mandate with the given frequency can be collected again.
+// Months and years follow the calendar, so a collection on 31 January 2024 allows the next one on 2 March.
+func NextCollectionAt(frequency string, lastCollectedAt time.Time) time.Time {
+	switch frequency {
+	case FrequencyDaily:
+		return lastCollectedAt.AddDate(0, 0, 1)
+	case FrequencyWeekly:
+		return lastCollectedAt.AddDate(0, 0, 7)
+	case FrequencyMonthly:
+		return lastCollectedAt.AddDate(0, 1, 0)
+	default:
+		return lastCollectedAt.AddDate(1, 0, 0)
+	}
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextCollectionAt(t *testing.T) {
+	last := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC), NextCollectionAt(FrequencyDaily, last))
+	require.Equal(t, time.Date(2024, time.February, 7, 12, 0, 0, 0, time.UTC), NextCollectionAt(FrequencyWeekly, last))
+	require.Equal(t, time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC), NextCollectionAt(FrequencyMonthly, last))
+	require.Equal(t, time.Date(2025, time.January, 31, 12, 0, 0, 0, time.UTC), NextCollectionAt(FrequencyYearly, last))
+}
+
+func TestIsSupportedFrequency(t *testing.T) {
+	require.True(t, IsSupportedFrequency(FrequencyMonthly))
+	require.False(t, IsSupportedFrequency("hourly"))
+}
@@ -1,19 +1,226 @@
 package util
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Algorithms that new passwords can be hashed with.
+const (
+	PasswordHashArgon2id = "argon2id"
+	PasswordHashBcrypt   = "bcrypt"
+)
+
+// Default argon2id parameters, following the OWASP recommendation of 19 MiB of memory and two passes.
+const (
+	Argon2idDefaultMemory      uint32 = 19 * 1024
+	Argon2idDefaultIterations  uint32 = 2
+	Argon2idDefaultParallelism uint8  = 1
+)
+
+const (
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+)
+
+// bcryptMaxPasswordLength is the number of bytes of a password bcrypt can take.
+const bcryptMaxPasswordLength = 72
+
+var (
+	ErrMismatchedPassword      = errors.New("password does not match the hash")
+	ErrUnsupportedPasswordHash = errors.New("unsupported password hash format")
+)
+
+// PasswordHasher hashes new passwords. Hashes are PHC strings that carry their algorithm and parameters,
+// so CheckPassword can verify them even after the hasher has been reconfigured.
+type PasswordHasher interface {
+	// Hash returns the PHC string of the password.
+	Hash(password string) (string, error)
+	// NeedsRehash reports whether the hash was made with another algorithm or other parameters than the hasher uses.
+	NeedsRehash(hashedPassword string) bool
+}
+
+var defaultPasswordHasher PasswordHasher = NewArgon2idHasher(Argon2idDefaultMemory, Argon2idDefaultIterations, Argon2idDefaultParallelism)
+
+// HashPassword hashes the password with argon2id using the default parameters.
 func HashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return defaultPasswordHasher.Hash(password)
+}
+
+// CheckPassword compares the password with a hash of any supported format.
+func CheckPassword(password, hashedPassword string) error {
+	switch {
+	case strings.HasPrefix(hashedPassword, "$argon2id$"):
+		return checkArgon2idPassword(password, hashedPassword)
+	case isBcryptHash(hashedPassword):
+		err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), bcryptPassword(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrMismatchedPassword
+		}
+
+		return err
+	default:
+		return ErrUnsupportedPasswordHash
+	}
+}
+
+type Argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// NewArgon2idHasher creates a hasher using argon2id with the given memory in KiB, number of passes and lanes.
+func NewArgon2idHasher(memory uint32, iterations uint32, parallelism uint8) *Argon2idHasher {
+	return &Argon2idHasher{
+		memory:      memory,
+		iterations:  iterations,
+		parallelism: parallelism,
+	}
+}
+
+func (hasher *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, hasher.iterations, hasher.memory, hasher.parallelism, argon2idKeyLength)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		hasher.memory,
+		hasher.iterations,
+		hasher.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (hasher *Argon2idHasher) NeedsRehash(hashedPassword string) bool {
+	params, _, key, err := parseArgon2idHash(hashedPassword)
+	if err != nil {
+		return true
+	}
+
+	return params.version != argon2.Version ||
+		params.memory != hasher.memory ||
+		params.iterations != hasher.iterations ||
+		params.parallelism != hasher.parallelism ||
+		len(key) != argon2idKeyLength
+}
+
+type argon2idParams struct {
+	version     int
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// parseArgon2idHash splits a PHC string of the form $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>.
+func parseArgon2idHash(hashedPassword string) (params argon2idParams, salt []byte, key []byte, err error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != PasswordHashArgon2id {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &params.version); err != nil {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnsupportedPasswordHash
+	}
+
+	return params, salt, key, nil
+}
+
+func checkArgon2idPassword(password, hashedPassword string) error {
+	params, salt, key, err := parseArgon2idHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+
+	if params.version != argon2.Version {
+		return ErrUnsupportedPasswordHash
+	}
+
+	actualKey := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(actualKey, key) != 1 {
+		return ErrMismatchedPassword
+	}
+
+	return nil
+}
+
+// BcryptHasher hashes passwords with bcrypt, which is only kept for deployments that still need it.
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a hasher using bcrypt with the given cost.
+func NewBcryptHasher(cost int) (*BcryptHasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	return &BcryptHasher{cost: cost}, nil
+}
+
+func (hasher *BcryptHasher) Hash(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword(bcryptPassword(password), hasher.cost)
 	if err != nil {
-		return "", fmt.Errorf("failed to hashedPassword password: %w", err)
+		return "", fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	return string(hashedPassword), nil
 }
 
-func CheckPassword(password, hashedPassword string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+func (hasher *BcryptHasher) NeedsRehash(hashedPassword string) bool {
+	if !isBcryptHash(hashedPassword) {
+		return true
+	}
+
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+
+	return err != nil || cost != hasher.cost
+}
+
+// bcryptPassword returns what bcrypt hashes for the password. Passwords longer than bcrypt can take are
+// pre-hashed with SHA-256 so that all of their bytes count, shorter ones are kept as they are so that
+// existing hashes stay valid. bcrypt refused long passwords before, so no hash depends on them.
+func bcryptPassword(password string) []byte {
+	if len(password) <= bcryptMaxPasswordLength {
+		return []byte(password)
+	}
+
+	sum := sha256.Sum256([]byte(password))
+
+	// Encoded as bcrypt stops at the first NUL byte
+	return []byte(base64.RawStdEncoding.EncodeToString(sum[:]))
+}
+
+func isBcryptHash(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, "$2a$") ||
+		strings.HasPrefix(hashedPassword, "$2b$") ||
+		strings.HasPrefix(hashedPassword, "$2y$")
 }
@@ -1,9 +1,11 @@
 package util
 
 import (
+	"strings"
+	"testing"
+
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
-	"testing"
 )
 
 func TestPassword(t *testing.T) {
@@ -12,16 +14,85 @@ func TestPassword(t *testing.T) {
 	hashedPassword1, err := HashPassword(password)
 	require.NoError(t, err)
 	require.NotEmpty(t, hashedPassword1)
+	require.True(t, strings.HasPrefix(hashedPassword1, "$argon2id$v=19$m=19456,t=2,p=1$"))
 
 	err = CheckPassword(password, hashedPassword1)
 	require.NoError(t, err)
 
 	wrongPassword := RandomString(6)
 	err = CheckPassword(wrongPassword, hashedPassword1)
-	require.EqualError(t, err, bcrypt.ErrMismatchedHashAndPassword.Error())
+	require.ErrorIs(t, err, ErrMismatchedPassword)
 
 	hashedPassword2, err := HashPassword(password)
 	require.NoError(t, err)
 	require.NotEmpty(t, hashedPassword2)
 	require.NotEqual(t, hashedPassword1, hashedPassword2)
 }
+
+func TestLegacyBcryptPassword(t *testing.T) {
+	password := RandomString(6)
+
+	legacyHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	require.NoError(t, CheckPassword(password, string(legacyHash)))
+	require.ErrorIs(t, CheckPassword(RandomString(6), string(legacyHash)), ErrMismatchedPassword)
+
+	hasher := NewArgon2idHasher(Argon2idDefaultMemory, Argon2idDefaultIterations, Argon2idDefaultParallelism)
+	require.True(t, hasher.NeedsRehash(string(legacyHash)))
+
+	bcryptHasher, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+	require.False(t, bcryptHasher.NeedsRehash(string(legacyHash)))
+
+	bcryptHasher, err = NewBcryptHasher(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+	require.True(t, bcryptHasher.NeedsRehash(string(legacyHash)))
+}
+
+func TestBcryptLongPassword(t *testing.T) {
+	hasher, err := NewBcryptHasher(bcrypt.MinCost)
+	require.NoError(t, err)
+
+	// Longer than bcrypt can take, passwords only differing after 72 bytes must not match
+	password := RandomString(100)
+
+	hashedPassword, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.NoError(t, CheckPassword(password, hashedPassword))
+	require.ErrorIs(t, CheckPassword(password[:99]+"!", hashedPassword), ErrMismatchedPassword)
+	require.ErrorIs(t, CheckPassword(password[:72], hashedPassword), ErrMismatchedPassword)
+
+	shortPassword := RandomString(72)
+
+	hashedPassword, err = hasher.Hash(shortPassword)
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(shortPassword)))
+}
+
+func TestArgon2idNeedsRehash(t *testing.T) {
+	password := RandomString(6)
+
+	weakHasher := NewArgon2idHasher(8*1024, 1, 1)
+	hashedPassword, err := weakHasher.Hash(password)
+	require.NoError(t, err)
+	require.NoError(t, CheckPassword(password, hashedPassword))
+	require.False(t, weakHasher.NeedsRehash(hashedPassword))
+
+	hasher := NewArgon2idHasher(Argon2idDefaultMemory, Argon2idDefaultIterations, Argon2idDefaultParallelism)
+	require.True(t, hasher.NeedsRehash(hashedPassword))
+}
+
+func TestCheckPasswordUnsupportedHash(t *testing.T) {
+	testCases := []string{
+		"",
+		"plaintext",
+		"$argon2i$v=19$m=19456,t=2,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=1$c2FsdA",
+		"$argon2id$v=16$m=19456,t=2,p=1$c2FsdA$a2V5",
+	}
+
+	for _, hashedPassword := range testCases {
+		require.ErrorIs(t, CheckPassword("secret", hashedPassword), ErrUnsupportedPasswordHash, hashedPassword)
+	}
+}
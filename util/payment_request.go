@@ -0,0 +1,10 @@
+package util
+
+const (
+	PaymentRequestPending   = "pending"
+	PaymentRequestAccepted  = "accepted"
+	PaymentRequestDeclined  = "declined"
+	PaymentRequestCancelled = "cancelled"
+	// PaymentRequestExpired is never stored, pending requests past their expiry are reported as expired
+	PaymentRequestExpired = "expired"
+)
@@ -0,0 +1,16 @@
+package util
+
+// Permissions are granted to roles in the role_permissions table. The ones below are checked by handlers,
+// the permissions required to call each RPC are declared by its auth_policy in service_simplebank.proto.
+const (
+	AccountsReadAnyPermission   = "accounts:read:any"
+	AccountsDeleteAnyPermission = "accounts:delete:any"
+	TransfersReadAnyPermission  = "transfers:read:any"
+	PaymentsReadAnyPermission   = "payments:read:any"
+	DebitsReadAnyPermission     = "debits:read:any"
+	UsersUpdatePermission       = "users:update"
+	// UsersImpersonateWritePermission lets impersonators make changes, impersonation is read only without it
+	UsersImpersonateWritePermission = "users:impersonate:write"
+	// ServiceClientsManagePermission cannot be granted to service clients, which could otherwise create more of themselves
+	ServiceClientsManagePermission = "service_clients:manage"
+)
@@ -1,14 +1,10 @@
 package util
 
 const (
-	DepositorRole = "depositor"
-	BankerRole    = "banker"
+	DepositorRole  = "depositor"
+	BankerRole     = "banker"
+	AuditorRole    = "auditor"
+	SupportRole    = "support"
+	ComplianceRole = "compliance"
+	AdminRole      = "admin"
 )
-
-func IsBanker(role string) bool {
-	return role == BankerRole
-}
-
-func IsDepositor(role string) bool {
-	return role == DepositorRole
-}
@@ -0,0 +1,15 @@
+package util
+
+// What caused a name to be screened against the sanctions watchlist.
+const (
+	ScreeningTriggerSignup     = "signup"
+	ScreeningTriggerNameChange = "name_change"
+	ScreeningTriggerPayee      = "payee"
+)
+
+// Statuses of screening cases, users are restricted while they have open or confirmed cases.
+const (
+	ScreeningCaseOpen      = "open"
+	ScreeningCaseCleared   = "cleared"
+	ScreeningCaseConfirmed = "confirmed"
+)
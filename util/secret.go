@@ -0,0 +1,31 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// secureTokenSize is the number of random bytes in a generated token, 256 bits cannot be guessed.
+const secureTokenSize = 32
+
+// GenerateSecureToken returns a URL-safe token from a cryptographically secure random source,
+// for secrets that are sent to users such as password reset links.
+func GenerateSecureToken() (string, error) {
+	token := make([]byte, secureTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash of a high-entropy secret such as a recovery code.
+// Unlike passwords these secrets are random, so a fast hash is sufficient and allows lookups by hash.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecureToken(t *testing.T) {
+	token1, err := GenerateSecureToken()
+	require.NoError(t, err)
+	require.Len(t, token1, 43)
+
+	token2, err := GenerateSecureToken()
+	require.NoError(t, err)
+	require.NotEqual(t, token1, token2)
+}
+
+func TestHashToken(t *testing.T) {
+	// SHA-256 of the empty string
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", HashToken(""))
+
+	token := RandomString(32)
+	require.Len(t, HashToken(token), 64)
+	require.Equal(t, HashToken(token), HashToken(token))
+	require.NotEqual(t, HashToken(token), HashToken(token+"x"))
+}
@@ -0,0 +1,8 @@
+package util
+
+// User statuses, only active users can log in and use their tokens.
+const (
+	UserStatusActive      = "active"
+	UserStatusSuspended   = "suspended"
+	UserStatusDeactivated = "deactivated"
+)
@@ -0,0 +1,143 @@
+package val
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxPasswordLength bounds the work of hashing a password
+	MaxPasswordLength = 100
+
+	defaultPasswordMinLength      = 6
+	defaultPasswordMinCharClasses = 1
+)
+
+// builtinBreachedPasswords holds some of the most common passwords found in breaches, one per line
+//
+//go:embed breached_passwords.txt
+var builtinBreachedPasswords string
+
+// PasswordPolicy is what ValidatePassword requires of new passwords.
+type PasswordPolicy struct {
+	MinLength int
+	// MinCharClasses is how many of lowercase letters, uppercase letters, digits and symbols a password has to use
+	MinCharClasses int
+	breached       map[string]struct{}
+}
+
+var passwordPolicy = DefaultPasswordPolicy()
+
+// DefaultPasswordPolicy returns the policy used until SetPasswordPolicy is called.
+func DefaultPasswordPolicy() PasswordPolicy {
+	policy := PasswordPolicy{
+		MinLength:      defaultPasswordMinLength,
+		MinCharClasses: defaultPasswordMinCharClasses,
+		breached:       make(map[string]struct{}),
+	}
+	policy.addBreachedPasswords(strings.NewReader(builtinBreachedPasswords))
+
+	return policy
+}
+
+// NewPasswordPolicy creates a policy on top of the defaults. Zero values keep the default, and the passwords
+// in the file at breachedPasswordsPath, if any, are rejected in addition to the built-in list.
+func NewPasswordPolicy(minLength int, minCharClasses int, breachedPasswordsPath string) (PasswordPolicy, error) {
+	policy := DefaultPasswordPolicy()
+
+	if minLength > 0 {
+		if minLength > MaxPasswordLength {
+			return policy, fmt.Errorf("minimum password length must be at most %d", MaxPasswordLength)
+		}
+
+		policy.MinLength = minLength
+	}
+
+	if minCharClasses > 0 {
+		if minCharClasses > 4 {
+			return policy, fmt.Errorf("a password can use at most 4 character classes")
+		}
+
+		policy.MinCharClasses = minCharClasses
+	}
+
+	if breachedPasswordsPath != "" {
+		file, err := os.Open(breachedPasswordsPath)
+		if err != nil {
+			return policy, fmt.Errorf("cannot open breached passwords: %w", err)
+		}
+		defer file.Close()
+
+		err = policy.addBreachedPasswords(file)
+		if err != nil {
+			return policy, fmt.Errorf("cannot read breached passwords: %w", err)
+		}
+	}
+
+	return policy, nil
+}
+
+// SetPasswordPolicy replaces the policy ValidatePassword enforces. It is meant to be called once on startup.
+func SetPasswordPolicy(policy PasswordPolicy) {
+	passwordPolicy = policy
+}
+
+func (policy *PasswordPolicy) addBreachedPasswords(reader io.Reader) error {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		password := strings.TrimSpace(scanner.Text())
+		if password != "" {
+			policy.breached[strings.ToLower(password)] = struct{}{}
+		}
+	}
+
+	return scanner.Err()
+}
+
+// IsBreached reports whether the password is on the breached password list, ignoring case.
+func (policy PasswordPolicy) IsBreached(password string) bool {
+	_, ok := policy.breached[strings.ToLower(password)]
+
+	return ok
+}
+
+// Validate checks the password against the length, character class and breached password rules.
+func (policy PasswordPolicy) Validate(password string) error {
+	if err := ValidateString(password, policy.MinLength, MaxPasswordLength); err != nil {
+		return err
+	}
+
+	if charClasses(password) < policy.MinCharClasses {
+		return fmt.Errorf("must contain at least %d of lowercase letters, uppercase letters, digits and symbols", policy.MinCharClasses)
+	}
+
+	if policy.IsBreached(password) {
+		return fmt.Errorf("is too common, it appears in lists of breached passwords")
+	}
+
+	return nil
+}
+
+// charClasses counts which of lowercase letters, uppercase letters, digits and symbols the password uses.
+func charClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+
+	return lower + upper + digit + symbol
+}
@@ -0,0 +1,55 @@
+package val
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	breachedPath := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(breachedPath, []byte("Correct-Horse-1\n\n"), 0o600))
+
+	policy, err := NewPasswordPolicy(10, 3, breachedPath)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		password string
+		valid    bool
+	}{
+		{"OK", "Tr0ubadour-and", true},
+		{"TooShort", "Ab1-", false},
+		{"TooLong", "Ab1-" + string(make([]byte, MaxPasswordLength)), false},
+		{"TooFewCharClasses", "troubadourand", false},
+		{"BuiltinBreached", "Password123", false},
+		{"BreachedFromFile", "correct-horse-1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Validate(tc.password)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNewPasswordPolicyDefaults(t *testing.T) {
+	policy, err := NewPasswordPolicy(0, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, DefaultPasswordPolicy().MinLength, policy.MinLength)
+	require.Equal(t, DefaultPasswordPolicy().MinCharClasses, policy.MinCharClasses)
+	require.True(t, policy.IsBreached("QWERTY"))
+
+	_, err = NewPasswordPolicy(0, 5, "")
+	require.Error(t, err)
+
+	_, err = NewPasswordPolicy(0, 0, filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
@@ -5,11 +5,16 @@ import (
 	"net/mail"
 	"regexp"
 	"simplebank/util"
+	"strings"
+	"unicode/utf8"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername      = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName      = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidAccountNumber = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{4}[0-9]{14}$`).MatchString
+	isValidTOTPCode      = regexp.MustCompile(`^[0-9]+$`).MatchString
+	isValidRole          = regexp.MustCompile(`^[a-z_]+$`).MatchString
 )
 
 // ValidateString checks if the length of the input string is within the specified minimum and maximum range.
@@ -37,9 +42,9 @@ func ValidateUsername(value string) error {
 	return nil
 }
 
-// ValidatePassword ensures the password is between 6 and 100 characters.
+// ValidatePassword ensures a new password satisfies the password policy.
 func ValidatePassword(value string) error {
-	return ValidateString(value, 6, 100)
+	return passwordPolicy.Validate(value)
 }
 
 // ValidateEmail ensures the email address is between 6 and 100 characters and is a valid email address.
@@ -90,3 +95,91 @@ func ValidateCurrency(value string) error {
 
 	return nil
 }
+
+// ValidateAccountNumber ensures the input is a well-formed account number with valid check digits.
+func ValidateAccountNumber(value string) error {
+	if !isValidAccountNumber(value) {
+		return fmt.Errorf("must be %d characters: country code, check digits, bank code and account digits", util.AccountNumberLength)
+	}
+
+	if !util.IsValidAccountNumberChecksum(value) {
+		return fmt.Errorf("invalid check digits")
+	}
+
+	return nil
+}
+
+// ValidateAccountId ensures the input is a positive integer.
+func ValidateAccountId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+// ValidateId ensures the input is a positive integer.
+func ValidateId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+// ValidateAmount ensures the input is a positive amount of money.
+func ValidateAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive amount")
+	}
+
+	return nil
+}
+
+// ValidateDescription ensures the input fits into a transfer description, which is at most 18 characters.
+func ValidateDescription(value string) error {
+	return ValidateString(value, 1, 18)
+}
+
+// ValidateKeyword ensures the input fits into the keyword of a category rule, which is at most 18 characters
+// and not blank.
+func ValidateKeyword(value string) error {
+	n := utf8.RuneCountInString(value)
+	if n < 1 || n > 18 {
+		return fmt.Errorf("length must be between 1 and 18 characters")
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("must not be blank")
+	}
+
+	return nil
+}
+
+// ValidateTOTPCode ensures the input is a code from an authenticator app.
+func ValidateTOTPCode(value string) error {
+	if len(value) != util.TOTPDigits || !isValidTOTPCode(value) {
+		return fmt.Errorf("must be %d digits", util.TOTPDigits)
+	}
+
+	return nil
+}
+
+// ValidateRecoveryCode ensures the input has the length of a recovery code.
+func ValidateRecoveryCode(value string) error {
+	return ValidateString(value, 10, 20)
+}
+
+// ValidateRole ensures the input is shaped like the name of a role, whether the role exists is up to the database.
+func ValidateRole(value string) error {
+	err := ValidateString(value, 1, 50)
+	if err != nil {
+		return err
+	}
+
+	if !isValidRole(value) {
+		return fmt.Errorf("must contain lowercase letters and underscores")
+	}
+
+	return nil
+}
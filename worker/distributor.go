@@ -8,6 +8,12 @@ import (
 
 type TaskDistributor interface {
 	DistributeSendVerifyEmailTask(ctx context.Context, payload *PayloadSendVerifyEmail, opts ...asynq.Option) error
+	DistributeSendPaymentRequestEmailTask(ctx context.Context, payload *PayloadSendPaymentRequestEmail, opts ...asynq.Option) error
+	DistributeSendPasswordResetEmailTask(ctx context.Context, payload *PayloadSendPasswordResetEmail, opts ...asynq.Option) error
+	DistributeSendAccountLockedEmailTask(ctx context.Context, payload *PayloadSendAccountLockedEmail, opts ...asynq.Option) error
+	DistributeExportUserDataTask(ctx context.Context, payload *PayloadExportUserData, opts ...asynq.Option) error
+	DistributeSendKycStatusEmailTask(ctx context.Context, payload *PayloadSendKycStatusEmail, opts ...asynq.Option) error
+	DistributeSendInvitationEmailTask(ctx context.Context, payload *PayloadSendInvitationEmail, opts ...asynq.Option) error
 }
 
 type RedisTaskDistributor struct {
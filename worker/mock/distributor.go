@@ -42,6 +42,120 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeExportUserDataTask mocks base method.
+func (m *MockTaskDistributor) DistributeExportUserDataTask(ctx context.Context, payload *worker.PayloadExportUserData, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeExportUserDataTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeExportUserDataTask indicates an expected call of DistributeExportUserDataTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeExportUserDataTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeExportUserDataTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeExportUserDataTask), varargs...)
+}
+
+// DistributeSendAccountLockedEmailTask mocks base method.
+func (m *MockTaskDistributor) DistributeSendAccountLockedEmailTask(ctx context.Context, payload *worker.PayloadSendAccountLockedEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeSendAccountLockedEmailTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeSendAccountLockedEmailTask indicates an expected call of DistributeSendAccountLockedEmailTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeSendAccountLockedEmailTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeSendAccountLockedEmailTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeSendAccountLockedEmailTask), varargs...)
+}
+
+// DistributeSendInvitationEmailTask mocks base method.
+func (m *MockTaskDistributor) DistributeSendInvitationEmailTask(ctx context.Context, payload *worker.PayloadSendInvitationEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeSendInvitationEmailTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeSendInvitationEmailTask indicates an expected call of DistributeSendInvitationEmailTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeSendInvitationEmailTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeSendInvitationEmailTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeSendInvitationEmailTask), varargs...)
+}
+
+// DistributeSendKycStatusEmailTask mocks base method.
+func (m *MockTaskDistributor) DistributeSendKycStatusEmailTask(ctx context.Context, payload *worker.PayloadSendKycStatusEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeSendKycStatusEmailTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeSendKycStatusEmailTask indicates an expected call of DistributeSendKycStatusEmailTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeSendKycStatusEmailTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeSendKycStatusEmailTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeSendKycStatusEmailTask), varargs...)
+}
+
+// DistributeSendPasswordResetEmailTask mocks base method.
+func (m *MockTaskDistributor) DistributeSendPasswordResetEmailTask(ctx context.Context, payload *worker.PayloadSendPasswordResetEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeSendPasswordResetEmailTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeSendPasswordResetEmailTask indicates an expected call of DistributeSendPasswordResetEmailTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeSendPasswordResetEmailTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeSendPasswordResetEmailTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeSendPasswordResetEmailTask), varargs...)
+}
+
+// DistributeSendPaymentRequestEmailTask mocks base method.
+func (m *MockTaskDistributor) DistributeSendPaymentRequestEmailTask(ctx context.Context, payload *worker.PayloadSendPaymentRequestEmail, opts ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, payload}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeSendPaymentRequestEmailTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeSendPaymentRequestEmailTask indicates an expected call of DistributeSendPaymentRequestEmailTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeSendPaymentRequestEmailTask(ctx, payload any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, payload}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeSendPaymentRequestEmailTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeSendPaymentRequestEmailTask), varargs...)
+}
+
 // DistributeSendVerifyEmailTask mocks base method.
 func (m *MockTaskDistributor) DistributeSendVerifyEmailTask(ctx context.Context, payload *worker.PayloadSendVerifyEmail, opts ...asynq.Option) error {
 	m.ctrl.T.Helper()
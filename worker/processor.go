@@ -4,6 +4,7 @@ import (
 	"context"
 	db "simplebank/db/sqlc"
 	"simplebank/mail"
+	"strings"
 
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
@@ -18,16 +19,25 @@ type TaskProcessor interface {
 	Start() error
 	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPasswordResetEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAccountLockedEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendKycStatusEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendInvitationEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskScanTransfers(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  db.Store
 	mailer mail.EmailSender
+	// publicBaseURL is where users reach the server, the links in emails are relative to it
+	publicBaseURL string
 }
 
 // NewRedisTaskProcessor initializes and returns a TaskProcessor backed by Redis with task queue configuration.
-func NewRedisTaskProcessor(options asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(options asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender, publicBaseURL string) TaskProcessor {
 	server := asynq.NewServer(options, asynq.Config{
 		Queues: map[string]int{
 			QueueCritical: 10,
@@ -44,15 +54,28 @@ func NewRedisTaskProcessor(options asynq.RedisClientOpt, store db.Store, mailer
 	})
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:        server,
+		store:         store,
+		mailer:        mailer,
+		publicBaseURL: strings.TrimSuffix(publicBaseURL, "/"),
 	}
 }
 
+// publicURL returns the link to the path, which starts with a slash, as users reach it.
+func (processor *RedisTaskProcessor) publicURL(path string) string {
+	return processor.publicBaseURL + path
+}
+
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendPaymentRequestEmail, processor.ProcessTaskSendPaymentRequestEmail)
+	mux.HandleFunc(TaskSendPasswordResetEmail, processor.ProcessTaskSendPasswordResetEmail)
+	mux.HandleFunc(TaskSendAccountLockedEmail, processor.ProcessTaskSendAccountLockedEmail)
+	mux.HandleFunc(TaskExportUserData, processor.ProcessTaskExportUserData)
+	mux.HandleFunc(TaskSendKycStatusEmail, processor.ProcessTaskSendKycStatusEmail)
+	mux.HandleFunc(TaskSendInvitationEmail, processor.ProcessTaskSendInvitationEmail)
+	mux.HandleFunc(TaskScanTransfers, processor.ProcessTaskScanTransfers)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"fmt"
+	"simplebank/util"
+
+	"github.com/hibiken/asynq"
+)
+
+// NewTaskScheduler returns a scheduler enqueueing the periodic tasks. Periodic tasks are unique for their interval,
+// so running a scheduler in every instance of the server does not process them more often.
+func NewTaskScheduler(options asynq.RedisClientOpt, config util.Config) (*asynq.Scheduler, error) {
+	scheduler := asynq.NewScheduler(options, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+	})
+
+	if config.AmlScanInterval > 0 {
+		task, err := NewScanTransfersTask(&PayloadScanTransfers{
+			ReportThreshold:     config.AmlReportThreshold,
+			StructuringFloor:    config.AmlStructuringFloor,
+			StructuringWindow:   config.AmlStructuringWindow,
+			StructuringMinCount: config.AmlStructuringMinCount,
+		}, asynq.Queue(QueueDefault), asynq.Unique(config.AmlScanInterval))
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = scheduler.Register(fmt.Sprintf("@every %s", config.AmlScanInterval), task)
+		if err != nil {
+			return nil, fmt.Errorf("cannot schedule transfer scan: %w", err)
+		}
+	}
+
+	return scheduler, nil
+}
@@ -0,0 +1,273 @@
+package worker
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	db "simplebank/db/sqlc"
+	"simplebank/mail"
+	"simplebank/pb"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const TaskExportUserData = "task:export_user_data"
+
+// auditEventsPageSize is how many audit events are read at a time while exporting
+const auditEventsPageSize = 500
+
+var exportMarshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+type PayloadExportUserData struct {
+	DataRequestID int64 `json:"data_request_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeExportUserDataTask(ctx context.Context, payload *PayloadExportUserData, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskExportUserData, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("task enqueued")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskExportUserData(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadExportUserData{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	dataRequest, err := processor.store.GetDataRequest(ctx, payload.DataRequestID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get data request. %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get data request: %w", err)
+	}
+
+	if dataRequest.CompletedAt.Valid {
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, dataRequest.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user.ErasedAt.Valid {
+		return fmt.Errorf("user has been erased. %w", asynq.SkipRetry)
+	}
+
+	// The address may have changed since the export was requested, the archive only goes to a verified one
+	if !user.IsEmailVerified {
+		return fmt.Errorf("email address is not verified. %w", asynq.SkipRetry)
+	}
+
+	// The archive holds personal data, so it only lives on disk until it has been sent
+	dir, err := os.MkdirTemp("", "data-export-")
+	if err != nil {
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, fmt.Sprintf("simplebank-%s.zip", user.Username))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create export archive: %w", err)
+	}
+
+	err = WriteDataExport(ctx, processor.store, user, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write export archive: %w", err)
+	}
+
+	subject := "Your Simple Bank data"
+	data := mail.EmailData{
+		FullName: user.FullName,
+		Template: mail.NotificationTemplate,
+		Message: "As requested, attached is a copy of the personal data we hold about you: " +
+			"your profile, accounts, transfers, sessions and the activity recorded in our audit log.",
+	}
+
+	to := []string{user.Email}
+	err = processor.mailer.SendEmail(subject, data, to, nil, nil, []string{path})
+	if err != nil {
+		return fmt.Errorf("failed to send data export email: %w", err)
+	}
+
+	_, err = processor.store.CompleteDataRequest(ctx, dataRequest.ID)
+	if err != nil {
+		return fmt.Errorf("failed to complete data request: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
+
+// WriteDataExport writes a ZIP archive with a JSON file for each kind of personal data held about the user.
+func WriteDataExport(ctx context.Context, store db.Store, user db.User, w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	err := writeMessage(archive, "profile.json", user.ToResponse())
+	if err != nil {
+		return err
+	}
+
+	accounts, err := store.ListAccountsByOwner(ctx, user.Username)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	accountIDs := make([]int64, len(accounts))
+	accountResponses := make([]*pb.Account, len(accounts))
+	for i, account := range accounts {
+		accountIDs[i] = account.ID
+		accountResponses[i] = account.ToResponse()
+	}
+
+	err = writeMessages(archive, "accounts.json", accountResponses)
+	if err != nil {
+		return err
+	}
+
+	transfers, err := store.ListTransfersByAccounts(ctx, accountIDs)
+	if err != nil {
+		return fmt.Errorf("failed to list transfers: %w", err)
+	}
+
+	transferResponses := make([]*pb.Transfer, len(transfers))
+	for i, transfer := range transfers {
+		transferResponses[i] = transfer.ToResponse()
+	}
+
+	err = writeMessages(archive, "transfers.json", transferResponses)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := store.ListSessionsByUsername(ctx, user.Username)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	sessionResponses := make([]*pb.Session, len(sessions))
+	for i, session := range sessions {
+		sessionResponses[i] = session.ToResponse(uuid.Nil)
+	}
+
+	err = writeMessages(archive, "sessions.json", sessionResponses)
+	if err != nil {
+		return err
+	}
+
+	auditEvents, err := listUserAuditEvents(ctx, store, user.Username)
+	if err != nil {
+		return err
+	}
+
+	err = writeMessages(archive, "audit_events.json", auditEvents)
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// listUserAuditEvents pages through the audit events of calls made by or on behalf of the user.
+func listUserAuditEvents(ctx context.Context, store db.Store, username string) ([]*pb.AuditEvent, error) {
+	responses := []*pb.AuditEvent{}
+	arg := db.ListAuditEventsParams{
+		Actor:    pgtype.Text{String: username, Valid: true},
+		PageSize: auditEventsPageSize,
+	}
+
+	for {
+		events, err := store.ListAuditEvents(ctx, arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list audit events: %w", err)
+		}
+
+		for _, event := range events {
+			// The actor filter also matches calls the user made while impersonating someone else,
+			// which are about the personal data of that other user
+			if event.Username.String == username {
+				responses = append(responses, event.ToResponse())
+			}
+		}
+
+		if len(events) < auditEventsPageSize {
+			return responses, nil
+		}
+
+		arg.BeforeID = pgtype.Int8{Int64: events[len(events)-1].ID, Valid: true}
+	}
+}
+
+// writeMessage adds a file holding the message as JSON to the archive.
+func writeMessage(archive *zip.Writer, name string, message proto.Message) error {
+	content, err := exportMarshalOptions.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	return writeJSON(archive, name, json.RawMessage(content))
+}
+
+// writeMessages adds a file holding a JSON array of the messages to the archive.
+func writeMessages[T proto.Message](archive *zip.Writer, name string, messages []T) error {
+	content := make([]json.RawMessage, len(messages))
+	for i, message := range messages {
+		item, err := exportMarshalOptions.Marshal(message)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", name, err)
+		}
+
+		content[i] = item
+	}
+
+	return writeJSON(archive, name, content)
+}
+
+func writeJSON(archive *zip.Writer, name string, content any) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(content)
+}
@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWriteDataExport(t *testing.T) {
+	user := db.User{
+		Username:  util.RandomOwner(),
+		FullName:  util.RandomOwner(),
+		Email:     util.RandomEmail(),
+		Role:      util.DepositorRole,
+		CreatedAt: time.Now(),
+	}
+	account := db.Account{ID: 1, Owner: user.Username, Currency: util.USD, Number: "GB00SMPL00000000000001", CreatedAt: time.Now()}
+	transfer := db.Transfer{ID: 7, FromAccountID: account.ID, ToAccountID: 2, Amount: 10, CreatedAt: time.Now()}
+	session := db.Session{ID: uuid.New(), Username: user.Username, UserAgent: "curl", ClientIp: "127.0.0.1"}
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		ListAccountsByOwner(gomock.Any(), gomock.Eq(user.Username)).
+		Times(1).
+		Return([]db.Account{account}, nil)
+
+	store.EXPECT().
+		ListTransfersByAccounts(gomock.Any(), gomock.Eq([]int64{account.ID})).
+		Times(1).
+		Return([]db.Transfer{transfer}, nil)
+
+	store.EXPECT().
+		ListSessionsByUsername(gomock.Any(), gomock.Eq(user.Username)).
+		Times(1).
+		Return([]db.Session{session}, nil)
+
+	// calls made while impersonating others are left out
+	store.EXPECT().
+		ListAuditEvents(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.AuditEvent{
+			{ID: 2, Username: pgtype.Text{String: util.RandomOwner(), Valid: true}, Impersonator: pgtype.Text{String: user.Username, Valid: true}, Method: "/pb.Simplebank/GetAccount"},
+			{ID: 1, Username: pgtype.Text{String: user.Username, Valid: true}, Method: "/pb.Simplebank/UpdateUser"},
+		}, nil)
+
+	var buffer bytes.Buffer
+	err := WriteDataExport(context.Background(), store, user, &buffer)
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+
+	require.Len(t, files, 5)
+
+	var profile map[string]any
+	require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	require.Equal(t, user.Email, profile["email"])
+
+	var list []map[string]any
+	require.NoError(t, json.Unmarshal(files["accounts.json"], &list))
+	require.Len(t, list, 1)
+	require.Equal(t, account.Number, list[0]["number"])
+
+	require.NoError(t, json.Unmarshal(files["transfers.json"], &list))
+	require.Len(t, list, 1)
+
+	require.NoError(t, json.Unmarshal(files["sessions.json"], &list))
+	require.Len(t, list, 1)
+	require.Equal(t, session.ClientIp, list[0]["client_ip"])
+
+	require.NoError(t, json.Unmarshal(files["audit_events.json"], &list))
+	require.Len(t, list, 1)
+	require.Equal(t, "/pb.Simplebank/UpdateUser", list[0]["method"])
+}
@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskScanTransfers = "task:scan_transfers"
+
+// scanOverlap is how far before the watermark large transfers are scanned again, as transfers made just before a
+// scan started may only be committed after it.
+const scanOverlap = 5 * time.Minute
+
+// PayloadScanTransfers holds the anti-money-laundering thresholds, amounts are in minor units of any currency.
+type PayloadScanTransfers struct {
+	// ReportThreshold is the amount from which a transfer is reported as a large transaction
+	ReportThreshold int64 `json:"report_threshold"`
+	// StructuringFloor is the amount from which transfers below the threshold count towards structuring
+	StructuringFloor int64 `json:"structuring_floor"`
+	// StructuringWindow is how far back transfers are scanned
+	StructuringWindow time.Duration `json:"structuring_window"`
+	// StructuringMinCount is how many transfers just under the threshold within the window are reported
+	StructuringMinCount int64 `json:"structuring_min_count"`
+}
+
+// NewScanTransfersTask returns the task scanning transfers for money laundering, it is enqueued periodically.
+func NewScanTransfersTask(payload *PayloadScanTransfers, opts ...asynq.Option) (*asynq.Task, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	return asynq.NewTask(TaskScanTransfers, jsonPayload, opts...), nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskScanTransfers(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadScanTransfers{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	reports, err := scanTransfers(ctx, processor.store, payload, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("reports", reports).
+		Msg("processed task")
+
+	return nil
+}
+
+// scanTransfers reports the transfers that are not covered by a report of the same kind yet, so overlapping runs
+// do not duplicate reports. Large transfers are scanned from the last successful scan when it is older than the
+// window, so missed runs do not lose reports either. It returns the number of reports created.
+func scanTransfers(ctx context.Context, store db.Store, payload PayloadScanTransfers, now time.Time) (int, error) {
+	since := now.Add(-payload.StructuringWindow)
+	reports := 0
+
+	largeSince := since
+	scannedUntil, err := store.GetAmlScanWatermark(ctx)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return reports, fmt.Errorf("failed to get scan watermark: %w", err)
+	}
+
+	if err == nil && scannedUntil.Add(-scanOverlap).Before(largeSince) {
+		largeSince = scannedUntil.Add(-scanOverlap)
+	}
+
+	largeTransfers, err := store.ListUnreportedLargeTransfers(ctx, db.ListUnreportedLargeTransfersParams{
+		MinAmount: payload.ReportThreshold,
+		Since:     largeSince,
+	})
+	if err != nil {
+		return reports, fmt.Errorf("failed to list large transfers: %w", err)
+	}
+
+	for _, transfer := range largeTransfers {
+		_, err = store.CreateAmlReport(ctx, db.CreateAmlReportParams{
+			Kind:        util.AmlReportLargeTransaction,
+			Username:    transfer.Owner,
+			Currency:    transfer.Currency,
+			TotalAmount: transfer.Amount,
+			TransferIds: []int64{transfer.ID},
+			WindowStart: transfer.CreatedAt,
+			WindowEnd:   transfer.CreatedAt,
+		})
+		if err != nil {
+			return reports, fmt.Errorf("failed to create large transaction report: %w", err)
+		}
+		reports++
+	}
+
+	candidates, err := store.ListStructuringCandidates(ctx, db.ListStructuringCandidatesParams{
+		MinAmount:    payload.StructuringFloor,
+		MaxAmount:    payload.ReportThreshold,
+		Since:        since,
+		MinTransfers: payload.StructuringMinCount,
+	})
+	if err != nil {
+		return reports, fmt.Errorf("failed to list structuring candidates: %w", err)
+	}
+
+	for _, candidate := range candidates {
+		_, err = store.CreateAmlReport(ctx, db.CreateAmlReportParams{
+			Kind:        util.AmlReportStructuring,
+			Username:    candidate.Owner,
+			Currency:    candidate.Currency,
+			TotalAmount: candidate.TotalAmount,
+			TransferIds: candidate.TransferIds,
+			WindowStart: candidate.WindowStart,
+			WindowEnd:   candidate.WindowEnd,
+		})
+		if err != nil {
+			return reports, fmt.Errorf("failed to create structuring report: %w", err)
+		}
+		reports++
+	}
+
+	err = store.SetAmlScanWatermark(ctx, now)
+	if err != nil {
+		return reports, fmt.Errorf("failed to set scan watermark: %w", err)
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,219 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	mockdb "simplebank/db/mock"
+	db "simplebank/db/sqlc"
+	"simplebank/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestScanTransfers(t *testing.T) {
+	now := time.Now()
+	payload := PayloadScanTransfers{
+		ReportThreshold:     1000000,
+		StructuringFloor:    800000,
+		StructuringWindow:   72 * time.Hour,
+		StructuringMinCount: 3,
+	}
+	owner := util.RandomOwner()
+
+	largeTransfer := db.ListUnreportedLargeTransfersRow{
+		ID:        10,
+		Amount:    1500000,
+		CreatedAt: now.Add(-time.Hour),
+		Owner:     owner,
+		Currency:  util.USD,
+	}
+	candidate := db.ListStructuringCandidatesRow{
+		Owner:       owner,
+		Currency:    util.EUR,
+		TransferIds: []int64{11, 12, 13},
+		TotalAmount: 2700000,
+		WindowStart: now.Add(-48 * time.Hour),
+		WindowEnd:   now.Add(-2 * time.Hour),
+	}
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	// the last scan is within the window
+	store.EXPECT().
+		GetAmlScanWatermark(gomock.Any()).
+		Times(1).
+		Return(now.Add(-time.Hour), nil)
+
+	store.EXPECT().
+		ListUnreportedLargeTransfers(gomock.Any(), gomock.Eq(db.ListUnreportedLargeTransfersParams{
+			MinAmount: payload.ReportThreshold,
+			Since:     now.Add(-payload.StructuringWindow),
+		})).
+		Times(1).
+		Return([]db.ListUnreportedLargeTransfersRow{largeTransfer}, nil)
+
+	store.EXPECT().
+		ListStructuringCandidates(gomock.Any(), gomock.Eq(db.ListStructuringCandidatesParams{
+			MinAmount:    payload.StructuringFloor,
+			MaxAmount:    payload.ReportThreshold,
+			Since:        now.Add(-payload.StructuringWindow),
+			MinTransfers: payload.StructuringMinCount,
+		})).
+		Times(1).
+		Return([]db.ListStructuringCandidatesRow{candidate}, nil)
+
+	store.EXPECT().
+		CreateAmlReport(gomock.Any(), gomock.Eq(db.CreateAmlReportParams{
+			Kind:        util.AmlReportLargeTransaction,
+			Username:    owner,
+			Currency:    util.USD,
+			TotalAmount: largeTransfer.Amount,
+			TransferIds: []int64{largeTransfer.ID},
+			WindowStart: largeTransfer.CreatedAt,
+			WindowEnd:   largeTransfer.CreatedAt,
+		})).
+		Times(1).
+		Return(db.AmlReport{ID: 1}, nil)
+
+	store.EXPECT().
+		CreateAmlReport(gomock.Any(), gomock.Eq(db.CreateAmlReportParams{
+			Kind:        util.AmlReportStructuring,
+			Username:    owner,
+			Currency:    util.EUR,
+			TotalAmount: candidate.TotalAmount,
+			TransferIds: candidate.TransferIds,
+			WindowStart: candidate.WindowStart,
+			WindowEnd:   candidate.WindowEnd,
+		})).
+		Times(1).
+		Return(db.AmlReport{ID: 2}, nil)
+
+	store.EXPECT().
+		SetAmlScanWatermark(gomock.Any(), gomock.Eq(now)).
+		Times(1).
+		Return(nil)
+
+	reports, err := scanTransfers(context.Background(), store, payload, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, reports)
+}
+
+func TestScanTransfersNothingToReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	// the first scan
+	store.EXPECT().
+		GetAmlScanWatermark(gomock.Any()).
+		Times(1).
+		Return(time.Time{}, db.ErrRecordNotFound)
+
+	store.EXPECT().
+		ListUnreportedLargeTransfers(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.ListUnreportedLargeTransfersRow{}, nil)
+
+	store.EXPECT().
+		ListStructuringCandidates(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.ListStructuringCandidatesRow{}, nil)
+
+	store.EXPECT().
+		CreateAmlReport(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	store.EXPECT().
+		SetAmlScanWatermark(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	reports, err := scanTransfers(context.Background(), store, PayloadScanTransfers{ReportThreshold: 1000000}, time.Now())
+	require.NoError(t, err)
+	require.Zero(t, reports)
+}
+
+func TestScanTransfersAfterMissedRuns(t *testing.T) {
+	now := time.Now()
+	payload := PayloadScanTransfers{
+		ReportThreshold:     1000000,
+		StructuringFloor:    800000,
+		StructuringWindow:   72 * time.Hour,
+		StructuringMinCount: 3,
+	}
+	// the scans of the last week did not run
+	scannedUntil := now.Add(-7 * 24 * time.Hour)
+
+	largeTransfer := db.ListUnreportedLargeTransfersRow{
+		ID:        10,
+		Amount:    1500000,
+		CreatedAt: now.Add(-5 * 24 * time.Hour),
+		Owner:     util.RandomOwner(),
+		Currency:  util.USD,
+	}
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetAmlScanWatermark(gomock.Any()).
+		Times(1).
+		Return(scannedUntil, nil)
+
+	store.EXPECT().
+		ListUnreportedLargeTransfers(gomock.Any(), gomock.Eq(db.ListUnreportedLargeTransfersParams{
+			MinAmount: payload.ReportThreshold,
+			Since:     scannedUntil.Add(-scanOverlap),
+		})).
+		Times(1).
+		Return([]db.ListUnreportedLargeTransfersRow{largeTransfer}, nil)
+
+	store.EXPECT().
+		ListStructuringCandidates(gomock.Any(), gomock.Eq(db.ListStructuringCandidatesParams{
+			MinAmount:    payload.StructuringFloor,
+			MaxAmount:    payload.ReportThreshold,
+			Since:        now.Add(-payload.StructuringWindow),
+			MinTransfers: payload.StructuringMinCount,
+		})).
+		Times(1).
+		Return([]db.ListStructuringCandidatesRow{}, nil)
+
+	store.EXPECT().
+		CreateAmlReport(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.AmlReport{ID: 1}, nil)
+
+	store.EXPECT().
+		SetAmlScanWatermark(gomock.Any(), gomock.Eq(now)).
+		Times(1).
+		Return(nil)
+
+	reports, err := scanTransfers(context.Background(), store, payload, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, reports)
+}
+
+func TestScanTransfersKeepsWatermarkOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetAmlScanWatermark(gomock.Any()).
+		Times(1).
+		Return(time.Now().Add(-time.Hour), nil)
+
+	store.EXPECT().
+		ListUnreportedLargeTransfers(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil, sql.ErrConnDone)
+
+	store.EXPECT().
+		SetAmlScanWatermark(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	_, err := scanTransfers(context.Background(), store, PayloadScanTransfers{ReportThreshold: 1000000}, time.Now())
+	require.Error(t, err)
+}
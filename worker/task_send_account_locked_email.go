@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "simplebank/db/sqlc"
+	"simplebank/mail"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendAccountLockedEmail = "task:send_account_locked_email"
+
+type PayloadSendAccountLockedEmail struct {
+	Username    string    `json:"username"`
+	ClientIP    string    `json:"client_ip"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeSendAccountLockedEmailTask(ctx context.Context, payload *PayloadSendAccountLockedEmail, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendAccountLockedEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("task enqueued")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendAccountLockedEmail(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadSendAccountLockedEmail{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get user. %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	subject := "Your Simple Bank account has been locked"
+	message := fmt.Sprintf("After repeated failed login attempts from %s, logging in to your account is blocked until %s. "+
+		"If these attempts were not made by you, reset your password using the link below.",
+		payload.ClientIP,
+		payload.LockedUntil.Format("2 January 2006 15:04 MST"),
+	)
+
+	data := mail.EmailData{
+		FullName:  user.FullName,
+		Template:  mail.NotificationTemplate,
+		Message:   message,
+		ActionURL: processor.publicURL("/forgot_password"),
+	}
+
+	to := []string{user.Email}
+	err = processor.mailer.SendEmail(subject, data, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send account locked email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
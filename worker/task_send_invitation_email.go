@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	db "simplebank/db/sqlc"
+	"simplebank/mail"
+	"simplebank/util"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendInvitationEmail = "task:send_invitation_email"
+
+// invitationDuration is how long the link to set a password stays valid, customers may not check their email right away
+const invitationDuration = 7 * 24 * time.Hour
+
+type PayloadSendInvitationEmail struct {
+	Username string `json:"username"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeSendInvitationEmailTask(ctx context.Context, payload *PayloadSendInvitationEmail, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendInvitationEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("task enqueued")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendInvitationEmail(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadSendInvitationEmail{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get user. %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// The invitation is a password reset that lives longer, the user has no password of their own yet
+	token, err := util.GenerateSecureToken()
+	if err != nil {
+		return err
+	}
+
+	passwordReset, err := processor.store.CreatePasswordResetWithExpiry(ctx, db.CreatePasswordResetWithExpiryParams{
+		Username:    user.Username,
+		HashedToken: util.HashToken(token),
+		ExpiredAt:   time.Now().Add(invitationDuration),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+
+	subject := "Welcome to Simple Bank"
+	message := fmt.Sprintf("Your bank has opened a Simple Bank login for you with the username %s. "+
+		"Use the link below to choose your password, it can be used once and expires on %s. "+
+		"Afterwards you can get a new link with the forgotten password form.",
+		user.Username,
+		passwordReset.ExpiredAt.Format("2 January 2006 15:04 MST"),
+	)
+
+	data := mail.EmailData{
+		FullName:  user.FullName,
+		Template:  mail.NotificationTemplate,
+		Message:   message,
+		ActionURL: processor.publicURL("/reset_password?token=" + url.QueryEscape(token)),
+	}
+
+	to := []string{user.Email}
+	err = processor.mailer.SendEmail(subject, data, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
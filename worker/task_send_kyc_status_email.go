@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "simplebank/db/sqlc"
+	"simplebank/mail"
+	"simplebank/util"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendKycStatusEmail = "task:send_kyc_status_email"
+
+type PayloadSendKycStatusEmail struct {
+	DocumentID int64 `json:"document_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeSendKycStatusEmailTask(ctx context.Context, payload *PayloadSendKycStatusEmail, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendKycStatusEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("task enqueued")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendKycStatusEmail(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadSendKycStatusEmail{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	document, err := processor.store.GetKycDocument(ctx, payload.DocumentID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get document. %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get document: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, document.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	subject, message := kycStatusEmail(document, user)
+	data := mail.EmailData{
+		FullName: user.FullName,
+		Template: mail.NotificationTemplate,
+		Message:  message,
+	}
+
+	to := []string{user.Email}
+	err = processor.mailer.SendEmail(subject, data, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send kyc status email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
+
+// kycStatusEmail describes the outcome of the review of the document and what the user can do now.
+func kycStatusEmail(document db.KycDocument, user db.User) (subject string, message string) {
+	kind := strings.ReplaceAll(document.Kind, "_", " ")
+
+	if document.Status == util.KycDocumentRejected {
+		subject = "We could not verify your document"
+		message = fmt.Sprintf("We could not accept the %s document you uploaded: %s. Please upload a new one.",
+			kind,
+			document.RejectionReason.String,
+		)
+
+		return subject, message
+	}
+
+	tier := util.GetKycTier(user.KycTier)
+	subject = "Your document has been verified"
+	message = fmt.Sprintf("Your %s document has been approved. You can now hold up to %d accounts "+
+		"and send up to %s a day, at most %s per transfer.",
+		kind,
+		tier.MaxAccounts,
+		formatKycAmount(tier.DailyTransferLimit),
+		formatKycAmount(tier.MaxTransferAmount),
+	)
+
+	return subject, message
+}
+
+// formatKycAmount formats a limit in the smallest currency unit, which applies to amounts in any currency.
+func formatKycAmount(amount int64) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
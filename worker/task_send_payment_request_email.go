@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "simplebank/db/sqlc"
+	"simplebank/mail"
+	"simplebank/util"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendPaymentRequestEmail = "task:send_payment_request_email"
+
+type PayloadSendPaymentRequestEmail struct {
+	PaymentRequestID int64 `json:"payment_request_id"`
+	Reminder         bool  `json:"reminder,omitempty"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeSendPaymentRequestEmailTask(ctx context.Context, payload *PayloadSendPaymentRequestEmail, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendPaymentRequestEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("task enqueued")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error {
+	payload := PayloadSendPaymentRequestEmail{}
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload. %w", asynq.SkipRetry)
+	}
+
+	paymentRequest, err := processor.store.GetPaymentRequest(ctx, payload.PaymentRequestID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get payment request. %w", asynq.SkipRetry)
+		}
+
+		return fmt.Errorf("failed to get payment request: %w", err)
+	}
+
+	if !paymentRequest.Payer.Valid || paymentRequest.Status != util.PaymentRequestPending {
+		return fmt.Errorf("payment request has no pending payer. %w", asynq.SkipRetry)
+	}
+
+	payer, err := processor.store.GetUser(ctx, paymentRequest.Payer.String)
+	if err != nil {
+		return fmt.Errorf("failed to get payer: %w", err)
+	}
+
+	requester, err := processor.store.GetUser(ctx, paymentRequest.Requester)
+	if err != nil {
+		return fmt.Errorf("failed to get requester: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s has requested money from you", requester.FullName)
+	if payload.Reminder {
+		subject = "Reminder: " + subject
+	}
+	message := fmt.Sprintf("%s has requested %s from you. The request expires on %s.",
+		requester.FullName,
+		util.FormatAmount(paymentRequest.Amount, paymentRequest.Currency),
+		paymentRequest.ExpiresAt.Format("2 January 2006 15:04 MST"),
+	)
+	if paymentRequest.Description.Valid {
+		message = fmt.Sprintf("%s Description: %s", message, paymentRequest.Description.String)
+	}
+
+	data := mail.EmailData{
+		FullName:  payer.FullName,
+		Template:  mail.NotificationTemplate,
+		Message:   message,
+		ActionURL: processor.publicURL(fmt.Sprintf("/v1/payment_requests/%d", paymentRequest.ID)),
+	}
+
+	to := []string{payer.Email}
+	err = processor.mailer.SendEmail(subject, data, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send payment request email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", payer.Email).
+		Msg("processed task")
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	db "simplebank/db/sqlc"
 	"simplebank/mail"
 	"simplebank/util"
@@ -66,8 +67,7 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 	}
 
 	subject := "Welcome to Simple Bank"
-	// TODO proper server url
-	verifyUrl := fmt.Sprintf("http://localhost:8080/v1/users/verify?email_id=%d&secret_code=%s", verifyEmail.ID, verifyEmail.SecretCode)
+	verifyUrl := processor.publicURL(fmt.Sprintf("/v1/users/verify?email_id=%d&secret_code=%s", verifyEmail.ID, url.QueryEscape(verifyEmail.SecretCode)))
 
 	data := mail.EmailData{
 		FullName:  user.FullName,